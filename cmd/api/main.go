@@ -1,12 +1,22 @@
 package main
 
 import (
+	"context"
+	"devtracker/internal/events"
 	"devtracker/internal/handler"
+	"devtracker/internal/realtime"
 	"devtracker/internal/repository/postgres"
 	"devtracker/internal/routes"
 	"devtracker/internal/service"
 	"devtracker/pkg/db"
+	"devtracker/pkg/mail"
 	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -30,6 +40,53 @@ func main() {
     logRepository := postgres.NewLogPG(database);
     aIInsightRepository := postgres.NewAIInsightPG(database);
     milestoneRepository := postgres.NewMilestonePG(database);
+    milestoneHistoryRepository := postgres.NewMilestoneHistoryPG(database);
+    milestoneDependencyRepository := postgres.NewMilestoneDependencyPG(database);
+    boardLimitRepository := postgres.NewBoardLimitPG(database);
+    taskRepository := postgres.NewTaskPG(database);
+    sprintRepository := postgres.NewSprintPG(database);
+    scopeChangeRepository := postgres.NewScopeChangePG(database);
+    timerRepository := postgres.NewTimerPG(database);
+    goalRepository := postgres.NewGoalPG(database);
+    calendarRepository := postgres.NewCalendarPG(database);
+    projectRevisionRepository := postgres.NewProjectRevisionPG(database);
+    searchRepository := postgres.NewSearchPG(database);
+    tagRepository := postgres.NewTagPG(database);
+    clientRepository := postgres.NewClientPG(database);
+    rateRepository := postgres.NewRatePG(database);
+    invoiceRepository := postgres.NewInvoicePG(database);
+    timesheetRepository := postgres.NewTimesheetPG(database);
+    supervisorRepository := postgres.NewSupervisorPG(database);
+    commentRepository := postgres.NewCommentPG(database);
+    activityRepository := postgres.NewActivityPG(database);
+    notificationRepository := postgres.NewNotificationPG(database);
+
+    unitOfWork := postgres.NewUnitOfWorkPG(database);
+
+    // event bus in-process untuk invalidasi cache dan notifikasi
+    bus := events.NewBus()
+
+    // event realtime untuk SSE; LISTEN/NOTIFY Postgres meneruskan event ke semua instance API
+    relay := realtime.NewPGRelay(database)
+    hub := realtime.NewHub(relay, 512, 5*time.Minute)
+    hub.Subscribe(bus)
+    go relay.Run(context.Background(), hub.Deliver)
+
+    // email dikirim ke SMTP lokal (MailHog di docker-compose) kecuali SMTP_ADDR diisi;
+    // MAIL_DRIVER=file menulis file .eml ke MAIL_DROP_DIR
+    mailFrom := getEnv("MAIL_FROM", "noreply@devtracker.local")
+    var mailer mail.Mailer
+    if os.Getenv("MAIL_DRIVER") == "file" {
+        mailer = mail.NewFileDropMailer(getEnv("MAIL_DROP_DIR", "tmp/mail"), mailFrom)
+    } else {
+        smtpAddr := getEnv("SMTP_ADDR", "localhost:1025")
+        var smtpAuth smtp.Auth
+        if user := os.Getenv("SMTP_USERNAME"); user != "" {
+            host, _, _ := net.SplitHostPort(smtpAddr)
+            smtpAuth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+        }
+        mailer = mail.NewSMTPMailer(smtpAddr, mailFrom, smtpAuth)
+    }
 
 
 
@@ -38,11 +95,36 @@ func main() {
 
     // initialize service
     userService := service.NewUserService(userRepository)
-    projectService := service.NewProjectService(projectRepository)
-    reportService := service.NewReportService(reportRepository)
-    logService := service.NewLogService(logRepository)
-    aiInsightService := service.NewAIInsightService(aIInsightRepository)
-    milestoneService := service.NewMilestoneService(milestoneRepository)
+    projectService := service.NewProjectService(projectRepository, projectRevisionRepository, scopeChangeRepository, unitOfWork)
+    reportService := service.NewReportService(reportRepository, bus)
+    calendarService := service.NewCalendarService(calendarRepository, userRepository)
+    accessChecker := service.NewAccessChecker(projectRepository, supervisorRepository)
+    logService := service.NewLogService(logRepository, taskRepository, milestoneRepository, projectRepository, tagRepository, timesheetRepository, userRepository, accessChecker, unitOfWork, bus)
+    analyticsService := service.NewAnalyticsService(logRepository, projectRepository, milestoneRepository, taskRepository, scopeChangeRepository, sprintRepository, userRepository, calendarService, projectRevisionRepository, tagRepository, accessChecker)
+    goalService := service.NewGoalService(goalRepository, logRepository, userRepository, projectRepository, bus)
+    aiInsightService := service.NewAIInsightService(aIInsightRepository, analyticsService, goalService, bus)
+    milestoneService := service.NewMilestoneService(milestoneRepository, milestoneHistoryRepository, milestoneDependencyRepository, boardLimitRepository, scopeChangeRepository, projectRepository, accessChecker, unitOfWork, bus)
+    taskService := service.NewTaskService(taskRepository, milestoneRepository, projectRepository, accessChecker)
+    scheduleService := service.NewScheduleService(projectRepository, milestoneRepository, milestoneDependencyRepository, calendarService, accessChecker)
+    timerService := service.NewTimerService(timerRepository, logService, unitOfWork, bus)
+    dashboardService := service.NewDashboardService(userRepository, projectRepository, milestoneRepository, taskRepository, aIInsightRepository, logRepository, milestoneHistoryRepository, timerRepository, goalService, bus)
+    plannerService := service.NewPlannerService(projectRepository, milestoneRepository, taskRepository, calendarService)
+    searchService := service.NewSearchService(searchRepository)
+    tagService := service.NewTagService(tagRepository)
+    billingService := service.NewBillingService(clientRepository, rateRepository, invoiceRepository, projectRepository)
+    timesheetService := service.NewTimesheetService(timesheetRepository, logRepository, projectRepository, userRepository, accessChecker, unitOfWork)
+    supervisionService := service.NewSupervisionService(supervisorRepository, projectRepository, userRepository, milestoneRepository, aIInsightRepository, accessChecker, bus)
+    commentService := service.NewCommentService(commentRepository, milestoneRepository, logRepository, aIInsightRepository, userRepository, accessChecker, unitOfWork, bus)
+    activityService := service.NewActivityService(activityRepository, accessChecker)
+    notificationService := service.NewNotificationService(notificationRepository, userRepository, projectRepository, milestoneRepository, logRepository, aIInsightRepository, supervisorRepository, commentRepository, accessChecker,
+        service.NewEmailChannel(mailer),
+        service.NewWebhookChannel(),
+    )
+    notificationService.Subscribe(bus)
+    streamService := service.NewStreamService(hub, projectRepository, supervisorRepository, accessChecker)
+    digestService := service.NewDigestService(userRepository, projectRepository, logRepository, milestoneRepository, aIInsightRepository, notificationRepository, mailer)
+    invoiceService := service.NewInvoiceService(invoiceRepository, clientRepository, rateRepository, logRepository, projectRepository, milestoneRepository, userRepository, unitOfWork)
+    sprintService := service.NewSprintService(sprintRepository, projectRepository, milestoneRepository, taskRepository, logRepository, accessChecker)
 
 
 
@@ -55,6 +137,25 @@ func main() {
     logHandler := handler.NewLogHandler(logService)
     aiInsightHandler := handler.NewAIInsightHandler(aiInsightService)
     milestoneHandler := handler.NewMilestoneHandler(milestoneService)
+    scheduleHandler := handler.NewScheduleHandler(scheduleService)
+    analyticsHandler := handler.NewAnalyticsHandler(analyticsService)
+    taskHandler := handler.NewTaskHandler(taskService)
+    sprintHandler := handler.NewSprintHandler(sprintService)
+    timerHandler := handler.NewTimerHandler(timerService)
+    dashboardHandler := handler.NewDashboardHandler(dashboardService)
+    goalHandler := handler.NewGoalHandler(goalService)
+    calendarHandler := handler.NewCalendarHandler(calendarService)
+    plannerHandler := handler.NewPlannerHandler(plannerService)
+    searchHandler := handler.NewSearchHandler(searchService)
+    tagHandler := handler.NewTagHandler(tagService)
+    billingHandler := handler.NewBillingHandler(billingService, invoiceService)
+    timesheetHandler := handler.NewTimesheetHandler(timesheetService)
+    supervisionHandler := handler.NewSupervisionHandler(supervisionService, milestoneService, logService, aiInsightService)
+    commentHandler := handler.NewCommentHandler(commentService)
+    activityHandler := handler.NewActivityHandler(activityService)
+    notificationHandler := handler.NewNotificationHandler(notificationService)
+    digestHandler := handler.NewDigestHandler(digestService)
+    streamHandler := handler.NewStreamHandler(streamService)
 
 
 
@@ -66,10 +167,42 @@ func main() {
         Log: logHandler,
         AIInsight: aiInsightHandler,
         Milestone: milestoneHandler,
+        Schedule: scheduleHandler,
+        Analytics: analyticsHandler,
+        Task: taskHandler,
+        Sprint: sprintHandler,
+        Timer: timerHandler,
+        Dashboard: dashboardHandler,
+        Goal: goalHandler,
+        Calendar: calendarHandler,
+        Planner: plannerHandler,
+        Search: searchHandler,
+        Tag: tagHandler,
+        Billing: billingHandler,
+        Timesheet: timesheetHandler,
+        Supervision: supervisionHandler,
+        Comment: commentHandler,
+        Activity: activityHandler,
+        Notification: notificationHandler,
+        Digest: digestHandler,
+        Stream: streamHandler,
 	}
 
 	routes.SetupRoutes(app, handlers)
 
+    // purge trash di background
+    retentionDays, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+    if err != nil || retentionDays <= 0 {
+        retentionDays = 30
+    }
+    go projectService.RunTrashPurger(context.Background(), time.Duration(retentionDays)*24*time.Hour, time.Hour)
+
+    // pengingat DueDate milestone dan hari tanpa log
+    go notificationService.RunReminders(context.Background(), time.Hour)
+
+    // digest mingguan: dicek tiap 15 menit agar jam kirim per timezone tepat
+    go digestService.RunScheduler(context.Background(), 15*time.Minute)
+
 
 	log.Println("API routes registered successfully")
 
@@ -113,4 +246,11 @@ func main() {
     log.Printf("🗄️ Database test: http://localhost%s/test-db", port)
     
     log.Fatal(app.Listen(port))
-}
\ No newline at end of file
+}
+
+func getEnv(key, fallback string) string {
+    if value := os.Getenv(key); value != "" {
+        return value
+    }
+    return fallback
+}
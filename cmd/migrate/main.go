@@ -0,0 +1,39 @@
+package main
+
+import (
+	"devtracker/pkg/db"
+	"flag"
+	"log"
+)
+
+// migrate menjalankan AutoMigrate lalu file SQL di scripts/migrations yang belum tercatat.
+//
+//	go run cmd/migrate/main.go
+//	go run cmd/migrate/main.go -mark-applied 0002_unique_project_name
+func main() {
+	dir := flag.String("dir", "scripts/migrations", "directory containing *.sql migrations")
+	markApplied := flag.String("mark-applied", "", "record a migration version as applied without running it")
+	flag.Parse()
+
+	database := db.NewConnection()
+
+	sqlDB, err := database.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database instance: %v", err)
+	}
+	defer sqlDB.Close()
+
+	if *markApplied != "" {
+		if err := db.MarkMigrationApplied(database, *markApplied); err != nil {
+			log.Fatalf("Failed to mark migration: %v", err)
+		}
+		log.Printf("Marked migration %s as applied", *markApplied)
+		return
+	}
+
+	if err := db.RunMigrations(database, *dir); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	log.Println("Database schema is up to date")
+}
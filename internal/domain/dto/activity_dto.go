@@ -0,0 +1,15 @@
+package dto
+
+// ProjectActivityResponse adalah bentuk seragam untuk semua jenis aktivitas di feed project.
+type ProjectActivityResponse struct {
+	Type        string  `json:"type"`
+	ID          string  `json:"id"`
+	ProjectID   string  `json:"project_id"`
+	OccurredAt  string  `json:"occurred_at"`
+	ActorID     *string `json:"actor_id,omitempty"`
+	MilestoneID *string `json:"milestone_id,omitempty"`
+	Title       string  `json:"title"`
+	Minutes     *int    `json:"minutes,omitempty"`
+	FromStatus  string  `json:"from_status,omitempty"`
+	ToStatus    string  `json:"to_status,omitempty"`
+}
@@ -13,6 +13,7 @@ type AIInsightResponse struct {
 	ProjectID string `json:"project_id"`
 	InsightType string `json:"insight_type"`
 	Content string `json:"content"`
+	Status string `json:"status,omitempty"`
 	GeneratedAt time.Time `json:"generated_at"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
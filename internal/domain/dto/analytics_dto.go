@@ -0,0 +1,128 @@
+package dto
+
+import "devtracker/internal/domain/model"
+
+type MilestoneEstimateResponse struct {
+	MilestoneID     string                `json:"milestone_id"`
+	Name            string                `json:"name"`
+	Status          model.MilestoneStatus `json:"status"`
+	Weight          float64               `json:"weight"`
+	EstimatedHours  *float64              `json:"estimated_hours"`
+	ActualHours     float64               `json:"actual_hours"`
+	VarianceHours   *float64              `json:"variance_hours"`
+	VariancePercent *float64              `json:"variance_percent"`
+}
+
+type EstimateAccuracyResponse struct {
+	SampleSize          int     `json:"sample_size"`
+	MeanAbsPercentError float64 `json:"mean_abs_percent_error"`
+	OverrunRatio        float64 `json:"overrun_ratio"`
+	Overruns            int     `json:"overruns"`
+	Underruns           int     `json:"underruns"`
+	WithinTolerance     int     `json:"within_tolerance"`
+}
+
+type EstimateReportResponse struct {
+	ProjectID  string                      `json:"project_id"`
+	Milestones []MilestoneEstimateResponse `json:"milestones"`
+	Accuracy   EstimateAccuracyResponse    `json:"accuracy"`
+	Breakdown  BreakdownResponse           `json:"breakdown"`
+}
+
+type ScopeChangeMarkerResponse struct {
+	Date  string  `json:"date"`
+	Kind  string  `json:"kind"`
+	Name  string  `json:"name"`
+	Delta float64 `json:"delta"`
+}
+
+type BurndownPointResponse struct {
+	Date        string  `json:"date"`
+	Remaining   float64 `json:"remaining"`
+	LoggedHours float64 `json:"logged_hours"`
+}
+
+type BurnupPointResponse struct {
+	Date        string  `json:"date"`
+	Scope       float64 `json:"scope"`
+	Completed   float64 `json:"completed"`
+	LoggedHours float64 `json:"logged_hours"`
+}
+
+type IdealPointResponse struct {
+	Date  string  `json:"date"`
+	Value float64 `json:"value"`
+}
+
+type BurndownResponse struct {
+	ProjectID    string                      `json:"project_id"`
+	SprintID     *string                     `json:"sprint_id,omitempty"`
+	Start        string                      `json:"start"`
+	Deadline     string                      `json:"deadline,omitempty"`
+	Points       []BurndownPointResponse     `json:"points"`
+	Ideal        []IdealPointResponse        `json:"ideal"`
+	ScopeChanges []ScopeChangeMarkerResponse `json:"scope_changes"`
+	Breakdown    BreakdownResponse           `json:"breakdown"`
+}
+
+type BurnupResponse struct {
+	ProjectID    string                      `json:"project_id"`
+	SprintID     *string                     `json:"sprint_id,omitempty"`
+	Start        string                      `json:"start"`
+	Deadline     string                      `json:"deadline,omitempty"`
+	Points       []BurnupPointResponse       `json:"points"`
+	Ideal        []IdealPointResponse        `json:"ideal"`
+	ScopeChanges []ScopeChangeMarkerResponse `json:"scope_changes"`
+	Breakdown    BreakdownResponse           `json:"breakdown"`
+}
+
+type HeatmapDayResponse struct {
+	Date    string `json:"date"`
+	Minutes int    `json:"minutes"`
+	Level   int    `json:"level"`
+}
+
+type WeekdayMinutesResponse struct {
+	Weekday string `json:"weekday"`
+	Minutes int    `json:"minutes"`
+}
+
+type HourMinutesResponse struct {
+	Hour    int `json:"hour"`
+	Minutes int `json:"minutes"`
+}
+
+type ProjectShareResponse struct {
+	ProjectID   string  `json:"project_id"`
+	ProjectName string  `json:"project_name"`
+	Minutes     int     `json:"minutes"`
+	Percent     float64 `json:"percent"`
+}
+
+type HeatmapResponse struct {
+	Year          int                      `json:"year"`
+	Timezone      string                   `json:"timezone"`
+	TotalMinutes  int                      `json:"total_minutes"`
+	ActiveDays    int                      `json:"active_days"`
+	CurrentStreak int                      `json:"current_streak"`
+	LongestStreak int                      `json:"longest_streak"`
+	Days          []HeatmapDayResponse     `json:"days"`
+	ByWeekday     []WeekdayMinutesResponse `json:"by_weekday"`
+	ByHour        []HourMinutesResponse    `json:"by_hour"`
+	Projects      []ProjectShareResponse   `json:"projects"`
+	Breakdown     BreakdownResponse        `json:"breakdown"`
+}
+
+type BreakdownItemResponse struct {
+	Key     string  `json:"key"`
+	Minutes int     `json:"minutes"`
+	Percent float64 `json:"percent"`
+}
+
+// BreakdownResponse: pembagian waktu per kategori dan tag dari log yang lolos filter ?tag=&category=.
+type BreakdownResponse struct {
+	TotalMinutes    int                     `json:"total_minutes"`
+	ByCategory      []BreakdownItemResponse `json:"by_category"`
+	ByTag           []BreakdownItemResponse `json:"by_tag"`
+	UntaggedMinutes int                     `json:"untagged_minutes"`
+}
@@ -0,0 +1,86 @@
+package dto
+
+// Nominal uang di request/response dalam satuan utama (mis. 150000.50), disimpan sebagai cents.
+
+type ClientRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Address  string `json:"address"`
+	Currency string `json:"currency"` // kosong = IDR
+}
+
+type ClientResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Currency  string `json:"currency"`
+	CreatedAt string `json:"created_at"`
+}
+
+type SetProjectClientRequest struct {
+	ClientID string `json:"client_id"` // kosong = lepas dari client
+}
+
+type CreateRateRequest struct {
+	ProjectID     string  `json:"project_id"` // kosong = semua project
+	UserID        string  `json:"user_id"`    // kosong = semua user
+	Rate          float64 `json:"rate"`
+	EffectiveFrom string  `json:"effective_from"` // YYYY-MM-DD
+}
+
+type RateResponse struct {
+	ID            string  `json:"id"`
+	ProjectID     *string `json:"project_id,omitempty"`
+	UserID        *string `json:"user_id,omitempty"`
+	Rate          float64 `json:"rate"`
+	EffectiveFrom string  `json:"effective_from"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+type CreateInvoiceRequest struct {
+	ProjectID       string  `json:"project_id"`
+	PeriodStart     string  `json:"period_start"` // YYYY-MM-DD
+	PeriodEnd       string  `json:"period_end"`   // YYYY-MM-DD, inklusif
+	RoundingMinutes int     `json:"rounding_minutes"`
+	RoundingMode    string  `json:"rounding_mode"` // up (default), nearest, down
+	TaxRate         float64 `json:"tax_rate"`      // persen
+	DueDate         string  `json:"due_date"`
+	Notes           string  `json:"notes"`
+}
+
+type InvoiceLineResponse struct {
+	Position      int     `json:"position"`
+	MilestoneID   *string `json:"milestone_id,omitempty"`
+	Description   string  `json:"description"`
+	LogCount      int     `json:"log_count"`
+	Minutes       int     `json:"minutes"`
+	BilledMinutes int     `json:"billed_minutes"`
+	Rate          float64 `json:"rate"`
+	Amount        float64 `json:"amount"`
+}
+
+type InvoiceResponse struct {
+	ID              string                `json:"id"`
+	Number          string                `json:"number"`
+	Status          string                `json:"status"`
+	ClientID        string                `json:"client_id"`
+	ClientName      string                `json:"client_name"`
+	ProjectID       string                `json:"project_id"`
+	ProjectName     string                `json:"project_name"`
+	PeriodStart     string                `json:"period_start"`
+	PeriodEnd       string                `json:"period_end"`
+	Currency        string                `json:"currency"`
+	RoundingMinutes int                   `json:"rounding_minutes"`
+	RoundingMode    string                `json:"rounding_mode"`
+	TaxRate         float64               `json:"tax_rate"`
+	Subtotal        float64               `json:"subtotal"`
+	Tax             float64               `json:"tax"`
+	Total           float64               `json:"total"`
+	Notes           string                `json:"notes,omitempty"`
+	DueDate         string                `json:"due_date,omitempty"`
+	SentAt          string                `json:"sent_at,omitempty"`
+	PaidAt          string                `json:"paid_at,omitempty"`
+	CreatedAt       string                `json:"created_at"`
+	Lines           []InvoiceLineResponse `json:"lines,omitempty"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+type UpdateCalendarRequest struct {
+	WorkDays   []string `json:"work_days" validate:"required,min=1"`
+	DailyHours float64  `json:"daily_hours" validate:"required,gt=0,lte=24"`
+}
+
+type CalendarResponse struct {
+	WorkDays   []string `json:"work_days"`
+	DailyHours float64  `json:"daily_hours"`
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" validate:"required,datetime=2006-01-02"`
+	Name string `json:"name"`
+}
+
+type HolidayResponse struct {
+	ID     string `json:"id"`
+	Date   string `json:"date"`
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+type HolidayImportResponse struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
+
+type CreateTimeOffRequest struct {
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Reason    string `json:"reason"`
+}
+
+type TimeOffResponse struct {
+	ID        string `json:"id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Reason    string `json:"reason"`
+}
+
+type AvailabilityResponse struct {
+	From        string  `json:"from"`
+	To          string  `json:"to"`
+	WorkingDays int     `json:"working_days"`
+	Hours       float64 `json:"hours"`
+}
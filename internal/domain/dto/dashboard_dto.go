@@ -0,0 +1,43 @@
+package dto
+
+type DashboardProjectResponse struct {
+	ID                  string  `json:"id"`
+	Name                string  `json:"name"`
+	Deadline            string  `json:"deadline,omitempty"`
+	ProgressPercent     float64 `json:"progress_percent"`
+	MilestonesTotal     int     `json:"milestones_total"`
+	MilestonesCompleted int     `json:"milestones_completed"`
+	InsightStatus       *string `json:"insight_status"`
+	InsightAt           string  `json:"insight_at,omitempty"`
+}
+
+type DashboardMilestoneResponse struct {
+	ID          string `json:"id"`
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	DueDate     string `json:"due_date"`
+}
+
+type ActivityResponse struct {
+	Kind        string `json:"kind"`
+	At          string `json:"at"`
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	EntityID    string `json:"entity_id"`
+	Summary     string `json:"summary"`
+	Minutes     int    `json:"minutes,omitempty"`
+}
+
+type DashboardResponse struct {
+	Projects       []DashboardProjectResponse   `json:"projects"`
+	DueSoon        []DashboardMilestoneResponse `json:"due_soon"`
+	Overdue        []DashboardMilestoneResponse `json:"overdue"`
+	HoursThisWeek  float64                      `json:"hours_this_week"`
+	HoursLastWeek  float64                      `json:"hours_last_week"`
+	RunningTimer   *TimerResponse               `json:"running_timer"`
+	Goals          []GoalProgressResponse       `json:"goals"`
+	RecentActivity []ActivityResponse           `json:"recent_activity"`
+	GeneratedAt    string                       `json:"generated_at"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+type DigestPreviewResponse struct {
+	WeekStart string `json:"week_start"` // Senin pengiriman; periode yang diringkas adalah minggu sebelumnya
+	Subject   string `json:"subject"`
+	Text      string `json:"text"`
+	HTML      string `json:"html"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+type CreateGoalRequest struct {
+	ProjectID   *string `json:"project_id" validate:"omitempty,uuid"`
+	Period      string  `json:"period" validate:"required,oneof=day week month"`
+	TargetHours float64 `json:"target_hours" validate:"required,gt=0"`
+	StartDate   string  `json:"start_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndDate     *string `json:"end_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type UpdateGoalRequest struct {
+	Period      *string  `json:"period" validate:"omitempty,oneof=day week month"`
+	TargetHours *float64 `json:"target_hours" validate:"omitempty,gt=0"`
+	StartDate   *string  `json:"start_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndDate     *string  `json:"end_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type GoalResponse struct {
+	ID          string  `json:"id"`
+	ProjectID   *string `json:"project_id,omitempty"`
+	Period      string  `json:"period"`
+	TargetHours float64 `json:"target_hours"`
+	StartDate   string  `json:"start_date"`
+	EndDate     string  `json:"end_date,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type GoalPeriodResponse struct {
+	Start       string  `json:"start"`
+	End         string  `json:"end"`
+	LoggedHours float64 `json:"logged_hours"`
+	TargetHours float64 `json:"target_hours"`
+	Percent     float64 `json:"percent"`
+	Hit         bool    `json:"hit"`
+	InProgress  bool    `json:"in_progress"`
+}
+
+type GoalProgressResponse struct {
+	Goal    GoalResponse        `json:"goal"`
+	Active  bool                `json:"active"`
+	Current *GoalPeriodResponse `json:"current"`
+}
+
+type GoalHistoryResponse struct {
+	Goal    GoalResponse         `json:"goal"`
+	Hits    int                  `json:"hits"`
+	Misses  int                  `json:"misses"`
+	Periods []GoalPeriodResponse `json:"periods"`
+}
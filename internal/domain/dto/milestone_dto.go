@@ -5,8 +5,10 @@ import "devtracker/internal/domain/model"
 type CreateMilestoneRequest struct {
 	Name      string `json:"name" validate:"required"`
 	OrderIdx int    `json:"order_idx" validate:"omitempty"`
-	Status model.MilestoneStatus `json:"status" validate:"omitempty,oneof=pending in_progress done"`
+	Status model.MilestoneStatus `json:"status" validate:"omitempty,oneof=pending in_progress"`
 	DueDate   string `json:"due_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EstimatedHours *float64 `json:"estimated_hours" validate:"omitempty,min=0"`
+	Weight    *float64 `json:"weight" validate:"omitempty,gt=0"`
 
 }
 
@@ -17,10 +19,14 @@ type MilestoneResponse struct {
 	OrderIdx   int    `json:"order_idx"`
 	Name       string `json:"name"`
 	Status     model.MilestoneStatus `json:"status"`
+	BlockedReason string `json:"blocked_reason,omitempty"`
 	DueDate    string `json:"due_date,omitempty"`
 	CompletedAt string `json:"completed_at,omitempty"`
+	EstimatedHours *float64 `json:"estimated_hours,omitempty"`
+	Weight     *float64 `json:"weight,omitempty"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at,omitempty"`
+	AllowedActions []model.MilestoneAction `json:"allowed_actions"`
 }
 
 type UpdateMilestoneRequest struct {
@@ -30,5 +36,44 @@ type UpdateMilestoneRequest struct {
 	OrderIdx   *int    `json:"order_idx" validate:"omitempty"`
 	Status     model.MilestoneStatus `json:"status" validate:"omitempty,oneof=pending in_progress done"`
 	DueDate    *string `json:"due_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EstimatedHours *float64 `json:"estimated_hours" validate:"omitempty,min=0"`
+	Weight     *float64 `json:"weight" validate:"omitempty,gt=0"`
 	CompletedAt string `json:"completed_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 }
+
+type MilestoneTransitionRequest struct {
+	Reason string `json:"reason" validate:"omitempty"`
+}
+
+type MilestoneHistoryResponse struct {
+	ID         string                `json:"id"`
+	Action     model.MilestoneAction `json:"action"`
+	FromStatus model.MilestoneStatus `json:"from_status"`
+	ToStatus   model.MilestoneStatus `json:"to_status"`
+	Reason     string                `json:"reason,omitempty"`
+	ChangedBy  *string               `json:"changed_by,omitempty"`
+	ChangedAt  string                `json:"changed_at"`
+}
+
+type ReorderMilestonesRequest struct {
+	MilestoneIDs []string `json:"milestone_ids" validate:"required,dive,uuid"`
+}
+
+type BoardColumnResponse struct {
+	Status     model.MilestoneStatus `json:"status"`
+	WIPLimit   *int                  `json:"wip_limit"`
+	Count      int                   `json:"count"`
+	OverLimit  bool                  `json:"over_limit"`
+	Milestones []MilestoneResponse   `json:"milestones"`
+}
+
+type BoardResponse struct {
+	ProjectID string                `json:"project_id"`
+	Columns   []BoardColumnResponse `json:"columns"`
+}
+
+type UpdateWIPLimitsRequest struct {
+	Pending    *int `json:"pending" validate:"omitempty,min=1"`
+	InProgress *int `json:"in_progress" validate:"omitempty,min=1"`
+	Done       *int `json:"done" validate:"omitempty,min=1"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+type NotificationResponse struct {
+	ID        string  `json:"id"`
+	Kind      string  `json:"kind"`
+	Title     string  `json:"title"`
+	Body      string  `json:"body"`
+	ProjectID *string `json:"project_id,omitempty"`
+	EntityID  *string `json:"entity_id,omitempty"`
+	Read      bool    `json:"read"`
+	ReadAt    string  `json:"read_at,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type UnreadCountResponse struct {
+	Unread int64 `json:"unread"`
+}
+
+// NotificationPreferenceRequest: field yang tidak dikirim tidak diubah.
+type NotificationPreferenceRequest struct {
+	Email       *bool     `json:"email"`
+	WebhookURL  *string   `json:"webhook_url"` // "" = matikan webhook
+	MutedKinds  *[]string `json:"muted_kinds"`
+	DueSoonDays *int      `json:"due_soon_days"`
+	IdleDays    *int      `json:"idle_days"` // 0 = tanpa pengingat
+
+	DigestEnabled *bool `json:"digest_enabled"`
+	DigestHour    *int  `json:"digest_hour"` // jam lokal pengiriman digest hari Senin, 0-23
+}
+
+type NotificationPreferenceResponse struct {
+	InApp       bool     `json:"in_app"` // selalu true; jenis tertentu bisa di-mute
+	Email       bool     `json:"email"`
+	WebhookURL  string   `json:"webhook_url"`
+	MutedKinds  []string `json:"muted_kinds"`
+	DueSoonDays int      `json:"due_soon_days"`
+	IdleDays    int      `json:"idle_days"`
+
+	DigestEnabled  bool   `json:"digest_enabled"`
+	DigestHour     int    `json:"digest_hour"`
+	DigestSentWeek string `json:"digest_sent_week,omitempty"`
+
+	// hanya diisi sekali, saat webhook diaktifkan; simpan untuk memverifikasi X-DevTracker-Signature
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+}
+
+type WebhookSecretResponse struct {
+	WebhookSecret string `json:"webhook_secret"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// PageResponse membungkus hasil endpoint list. NextCursor null berarti halaman terakhir;
+// kirim kembali nilainya sebagai ?cursor= untuk mengambil halaman berikutnya.
+type PageResponse[T any] struct {
+	Data       []T     `json:"data"`
+	NextCursor *string `json:"next_cursor"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+type PlanProjectResponse struct {
+	ProjectID       string  `json:"project_id"`
+	Name            string  `json:"name"`
+	Deadline        string  `json:"deadline,omitempty"`
+	RemainingHours  float64 `json:"remaining_hours"`
+	PlannedHours    float64 `json:"planned_hours"`
+	HoursByDeadline float64 `json:"hours_by_deadline"`
+	ShortfallHours  float64 `json:"shortfall_hours"`
+	ProjectedFinish string  `json:"projected_finish,omitempty"`
+	SlipDays        int     `json:"slip_days"`
+	Feasible        bool    `json:"feasible"`
+}
+
+type PlanAllocationResponse struct {
+	ProjectID string  `json:"project_id"`
+	Hours     float64 `json:"hours"`
+}
+
+type PlanWeekResponse struct {
+	WeekStart     string                   `json:"week_start"`
+	CapacityHours float64                  `json:"capacity_hours"`
+	PlannedHours  float64                  `json:"planned_hours"`
+	Allocations   []PlanAllocationResponse `json:"allocations"`
+}
+
+type CapacityPlanResponse struct {
+	GeneratedAt         string                `json:"generated_at"`
+	Timezone            string                `json:"timezone"`
+	DailyHours          float64               `json:"daily_hours"`
+	TotalRemainingHours float64               `json:"total_remaining_hours"`
+	OverCommitted       bool                  `json:"over_committed"`
+	FirstSlip           *PlanProjectResponse  `json:"first_slip"`
+	Projects            []PlanProjectResponse `json:"projects"`
+	Weeks               []PlanWeekResponse    `json:"weeks"`
+}
@@ -9,6 +9,7 @@ type UpdateProjectRequest struct {
 	ID	   string `json:"id" validate:"required,uuid"`
 	Name     string `json:"name" validate:"omitempty"`
 	Deadline string `json:"deadline" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Reason   string `json:"reason" validate:"omitempty"`
 }
 
 
@@ -18,5 +19,43 @@ type ProjectResponse struct {
 	Deadline string `json:"deadline" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at,omitempty"`
+	ArchivedAt string `json:"archived_at,omitempty"`
+	DeletedAt  string `json:"deleted_at,omitempty"`
+	ClientID   *string `json:"client_id,omitempty"`
 }
 
+
+type ProjectRevisionResponse struct {
+	Version         int    `json:"version"`
+	Name            string `json:"name"`
+	Deadline        string `json:"deadline,omitempty"`
+	PrevName        string `json:"prev_name,omitempty"`
+	PrevDeadline    string `json:"prev_deadline,omitempty"`
+	DeadlineChanged bool   `json:"deadline_changed"`
+	Reason          string `json:"reason,omitempty"`
+	ChangedBy       string `json:"changed_by"`
+	ChangedAt       string `json:"changed_at"`
+}
+
+type ScopeChangeResponse struct {
+	MilestoneID    string   `json:"milestone_id"`
+	MilestoneName  string   `json:"milestone_name"`
+	Kind           string   `json:"kind"`
+	Weight         *float64 `json:"weight,omitempty"`
+	EstimatedHours *float64 `json:"estimated_hours,omitempty"`
+	ChangedAt      string   `json:"changed_at"`
+}
+
+type DeadlineSlippageResponse struct {
+	DeadlineChanges int    `json:"deadline_changes"`
+	Postponements   int    `json:"postponements"`
+	TotalSlipDays   int    `json:"total_slip_days"`
+	LastChangedAt   string `json:"last_changed_at,omitempty"`
+}
+
+type ProjectHistoryResponse struct {
+	ProjectID    string                    `json:"project_id"`
+	Slippage     DeadlineSlippageResponse  `json:"slippage"`
+	Revisions    []ProjectRevisionResponse `json:"revisions"`
+	ScopeChanges []ScopeChangeResponse     `json:"scope_changes"`
+}
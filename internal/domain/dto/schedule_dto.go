@@ -0,0 +1,38 @@
+package dto
+
+import "devtracker/internal/domain/model"
+
+type MilestoneScheduleResponse struct {
+	MilestoneID    string                `json:"milestone_id"`
+	Name           string                `json:"name"`
+	Status         model.MilestoneStatus `json:"status"`
+	DependsOn      []string              `json:"depends_on"`
+	DurationDays   int                   `json:"duration_days"`
+	EarliestStart  string                `json:"earliest_start"`
+	EarliestFinish string                `json:"earliest_finish"`
+	LatestStart    string                `json:"latest_start"`
+	LatestFinish   string                `json:"latest_finish"`
+	SlackDays      int                   `json:"slack_days"`
+	Critical       bool                  `json:"critical"`
+	Warnings       []string              `json:"warnings,omitempty"`
+}
+
+type ProjectScheduleResponse struct {
+	ProjectID       string                      `json:"project_id"`
+	Deadline        string                      `json:"deadline,omitempty"`
+	ProjectedFinish string                      `json:"projected_finish"`
+	Feasible        bool                        `json:"feasible"`
+	CriticalPath    []string                    `json:"critical_path"`
+	Milestones      []MilestoneScheduleResponse `json:"milestones"`
+}
+
+type AddDependencyRequest struct {
+	DependsOnID string `json:"depends_on_id" validate:"required,uuid"`
+}
+
+type MilestoneDependencyResponse struct {
+	ID          string `json:"id"`
+	MilestoneID string `json:"milestone_id"`
+	DependsOnID string `json:"depends_on_id"`
+	CreatedAt   string `json:"created_at"`
+}
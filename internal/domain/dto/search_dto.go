@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type SearchResultResponse struct {
+	Type        string    `json:"type"` // log | milestone | project
+	ID          string    `json:"id"`
+	ProjectID   string    `json:"project_id"`
+	ProjectName string    `json:"project_name"`
+	MilestoneID *string   `json:"milestone_id,omitempty"`
+	Snippet     string    `json:"snippet"` // kata yang cocok ditandai <mark></mark>
+	Rank        float64   `json:"rank"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+type SearchResponse struct {
+	Query string                 `json:"query"`
+	Data  []SearchResultResponse `json:"data"`
+}
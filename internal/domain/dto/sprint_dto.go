@@ -0,0 +1,65 @@
+package dto
+
+type CreateSprintRequest struct {
+	Name      string `json:"name" validate:"required"`
+	Goal      string `json:"goal" validate:"omitempty"`
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type UpdateSprintRequest struct {
+	Name      *string `json:"name" validate:"omitempty"`
+	Goal      *string `json:"goal" validate:"omitempty"`
+	StartDate *string `json:"start_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndDate   *string `json:"end_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type SprintItemsRequest struct {
+	MilestoneIDs []string `json:"milestone_ids" validate:"omitempty,dive,uuid"`
+	TaskIDs      []string `json:"task_ids" validate:"omitempty,dive,uuid"`
+}
+
+type CompleteSprintRequest struct {
+	// NextSprintID kosong: item yang belum selesai masuk ke sprint planned berikutnya
+	NextSprintID *string `json:"next_sprint_id" validate:"omitempty,uuid"`
+}
+
+type SprintResponse struct {
+	ID          string `json:"id"`
+	ProjectID   string `json:"project_id"`
+	Name        string `json:"name"`
+	Goal        string `json:"goal,omitempty"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	Status      string `json:"status"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+type SprintItemResponse struct {
+	ID             string  `json:"id"`
+	MilestoneID    *string `json:"milestone_id,omitempty"`
+	TaskID         *string `json:"task_id,omitempty"`
+	Title          string  `json:"title"`
+	Done           bool    `json:"done"`
+	Committed      bool    `json:"committed"`
+	Outcome        string  `json:"outcome,omitempty"`
+	CarriedFromID  *string `json:"carried_from_id,omitempty"`
+	EstimatedHours float64 `json:"estimated_hours"`
+	LoggedHours    float64 `json:"logged_hours"`
+	AddedAt        string  `json:"added_at"`
+}
+
+type SprintReportResponse struct {
+	Sprint              SprintResponse       `json:"sprint"`
+	CommittedCount      int                  `json:"committed_count"`
+	CompletedCount      int                  `json:"completed_count"`
+	AddedMidSprint      int                  `json:"added_mid_sprint"`
+	CarriedIn           int                  `json:"carried_in"`
+	CarriedOver         int                  `json:"carried_over"`
+	CommittedHours      float64              `json:"committed_hours"`
+	CompletedHours      float64              `json:"completed_hours"`
+	HoursLoggedInSprint float64              `json:"hours_logged_in_sprint"`
+	Items               []SprintItemResponse `json:"items"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+// StreamEventResponse adalah isi field data pada event SSE. Klien memuat ulang
+// entity terkait (atau daftar project) berdasarkan type dan entity_id.
+type StreamEventResponse struct {
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	ProjectID string  `json:"project_id"`
+	EntityID  string  `json:"entity_id"`
+	ActorID   *string `json:"actor_id,omitempty"`
+	At        string  `json:"at"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+type InviteSupervisorRequest struct {
+	Email string `json:"email"`
+}
+
+type SupervisorResponse struct {
+	ID           string  `json:"id"`
+	ProjectID    string  `json:"project_id"`
+	Email        string  `json:"email"`
+	SupervisorID *string `json:"supervisor_id,omitempty"`
+	Status       string  `json:"status"`
+	InvitedAt    string  `json:"invited_at"`
+	RespondedAt  string  `json:"responded_at,omitempty"`
+}
+
+type CohortProjectResponse struct {
+	Project             ProjectResponse    `json:"project"`
+	OwnerName           string             `json:"owner_name"`
+	OwnerEmail          string             `json:"owner_email"`
+	LatestStatus        string             `json:"latest_status"` // UNKNOWN jika belum ada insight
+	LatestInsight       *AIInsightResponse `json:"latest_insight,omitempty"`
+	MilestonesTotal     int                `json:"milestones_total"`
+	MilestonesCompleted int                `json:"milestones_completed"`
+}
+
+type SupervisedProjectResponse struct {
+	Project ProjectResponse `json:"project"`
+	Role    string          `json:"role"` // owner | supervisor
+}
+
+type CommentRequest struct {
+	Body     string `json:"body"`
+	ParentID string `json:"parent_id"` // kosong = komentar utama
+}
+
+type CommentResponse struct {
+	ID        string            `json:"id"`
+	ParentID  *string           `json:"parent_id,omitempty"`
+	AuthorID  string            `json:"author_id"`
+	Body      string            `json:"body"`
+	Mentions  []string          `json:"mentions"` // ID user yang di-mention
+	CreatedAt string            `json:"created_at"`
+	UpdatedAt string            `json:"updated_at"`
+	Replies   []CommentResponse `json:"replies"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type CreateTagRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type UpdateTagRequest struct {
+	Name  *string `json:"name"`
+	Color *string `json:"color"`
+}
+
+type TagResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Color        string `json:"color,omitempty"`
+	LogCount     int    `json:"log_count"`
+	TotalMinutes int    `json:"total_minutes"`
+	CreatedAt    string `json:"created_at"`
+}
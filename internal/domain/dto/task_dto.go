@@ -0,0 +1,45 @@
+package dto
+
+type CreateTaskRequest struct {
+	Title      string  `json:"title" validate:"required"`
+	AssigneeID *string `json:"assignee_id" validate:"omitempty,uuid"`
+	OrderIdx   int     `json:"order_idx" validate:"omitempty"`
+}
+
+type UpdateTaskRequest struct {
+	Title      *string `json:"title" validate:"omitempty"`
+	Done       *bool   `json:"done" validate:"omitempty"`
+	AssigneeID *string `json:"assignee_id" validate:"omitempty,uuid"`
+	OrderIdx   *int    `json:"order_idx" validate:"omitempty"`
+}
+
+type TaskResponse struct {
+	ID          string  `json:"id"`
+	MilestoneID string  `json:"milestone_id"`
+	Title       string  `json:"title"`
+	Done        bool    `json:"done"`
+	AssigneeID  *string `json:"assignee_id,omitempty"`
+	OrderIdx    int     `json:"order_idx"`
+	CompletedAt string  `json:"completed_at,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at,omitempty"`
+}
+
+type TaskSummaryResponse struct {
+	Total           int     `json:"total"`
+	Done            int     `json:"done"`
+	ProgressPercent float64 `json:"progress_percent"`
+	// SuggestComplete: semua task selesai, milestone sebaiknya dipindah ke done
+	SuggestComplete bool    `json:"suggest_complete"`
+	SuggestedAction string  `json:"suggested_action,omitempty"`
+}
+
+type TaskListResponse struct {
+	Tasks   []TaskResponse      `json:"tasks"`
+	Summary TaskSummaryResponse `json:"summary"`
+}
+
+type TaskUpdateResponse struct {
+	Task    TaskResponse        `json:"task"`
+	Summary TaskSummaryResponse `json:"summary"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+type StartTimerRequest struct {
+	ProjectID   string  `json:"project_id" validate:"required,uuid"`
+	MilestoneID *string `json:"milestone_id" validate:"omitempty,uuid"`
+	TaskID      *string `json:"task_id" validate:"omitempty,uuid"`
+	Description string  `json:"description" validate:"omitempty"`
+}
+
+type StopTimerRequest struct {
+	// Description kosong: pakai deskripsi saat timer dimulai
+	Description string `json:"description" validate:"omitempty"`
+}
+
+type TimerResponse struct {
+	ID             string  `json:"id"`
+	ProjectID      string  `json:"project_id"`
+	MilestoneID    *string `json:"milestone_id,omitempty"`
+	TaskID         *string `json:"task_id,omitempty"`
+	Description    string  `json:"description"`
+	StartedAt      string  `json:"started_at"`
+	ElapsedMinutes int     `json:"elapsed_minutes"`
+}
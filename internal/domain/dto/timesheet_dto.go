@@ -0,0 +1,51 @@
+package dto
+
+type SubmitTimesheetRequest struct {
+	ProjectID string `json:"project_id"`
+	Week      string `json:"week"` // tanggal mana pun di minggu tersebut, YYYY-MM-DD
+	Comment   string `json:"comment"`
+}
+
+type ReviewTimesheetRequest struct {
+	Comment string `json:"comment"` // wajib saat reject
+}
+
+type TimesheetCommentRequest struct {
+	Body string `json:"body"`
+}
+
+type TimesheetResponse struct {
+	ID           string  `json:"id"`
+	ProjectID    string  `json:"project_id"`
+	ProjectName  string  `json:"project_name,omitempty"`
+	UserID       string  `json:"user_id"`
+	UserName     string  `json:"user_name,omitempty"`
+	WeekStart    string  `json:"week_start"`
+	Status       string  `json:"status"`
+	LogCount     int     `json:"log_count"`
+	TotalMinutes int     `json:"total_minutes"`
+	SubmittedAt  string  `json:"submitted_at"`
+	ReviewedBy   *string `json:"reviewed_by,omitempty"`
+	ReviewedAt   string  `json:"reviewed_at,omitempty"`
+}
+
+type TimesheetCommentResponse struct {
+	ID        string `json:"id"`
+	AuthorID  string `json:"author_id"`
+	Action    string `json:"action"`
+	Body      string `json:"body,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type TimesheetWeekResponse struct {
+	ProjectID    string                     `json:"project_id"`
+	UserID       string                     `json:"user_id"`
+	WeekStart    string                     `json:"week_start"`
+	WeekEnd      string                     `json:"week_end"`
+	Status       string                     `json:"status"` // open = belum diajukan
+	Timesheet    *TimesheetResponse         `json:"timesheet,omitempty"`
+	DailyMinutes []int                      `json:"daily_minutes"` // Senin..Minggu
+	TotalMinutes int                        `json:"total_minutes"`
+	Logs         []LogResponse              `json:"logs"`
+	Comments     []TimesheetCommentResponse `json:"comments"`
+}
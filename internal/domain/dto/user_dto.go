@@ -7,6 +7,7 @@ type User struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
 	Email string `json:"email"`
+	Timezone string `json:"timezone"`
 }
 
 
@@ -15,6 +16,7 @@ func FromModelUser(user *model.User) User {
 		ID:    user.ID.String(),
 		Name:  user.Name,
 		Email: user.Email,
+		Timezone: user.Timezone,
 	}
 }
 
@@ -22,5 +24,6 @@ func FromModelUser(user *model.User) User {
 type UpdateUserRequest struct{
 	ID string `json:"id"`
 	Name string `json:"name"`
+	Timezone *string `json:"timezone"`
 }
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type InsightType string
@@ -27,4 +28,5 @@ type AIInsight struct {
     // ✅ NEW FIELD: Menyimpan Status Prediksi untuk UI
     Status      InsightStatus `gorm:"type:varchar(20);default:'UNKNOWN';not null"` 
     GeneratedAt time.Time     `gorm:"autoCreateTime"` // Waktu AI membuat analisis
+    DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
\ No newline at end of file
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Semua nominal uang disimpan dalam satuan terkecil (cents/sen) supaya pembulatan konsisten.
+
+// Client adalah pemberi kerja untuk project yang ditagihkan.
+type Client struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uniq_user_client"`
+	Name      string    `gorm:"size:150;not null;uniqueIndex:uniq_user_client"`
+	Email     string    `gorm:"size:255"`
+	Address   string    `gorm:"type:text"`
+	Currency  string    `gorm:"size:3;not null;default:'IDR'"` // ISO 4217
+	CreatedAt time.Time
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// HourlyRate berlaku mulai EffectiveFrom sampai ada rate lain dengan cakupan yang sama
+// dan tanggal yang lebih baru. Cakupan paling spesifik menang: project+user, project, user.
+type HourlyRate struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OwnerID       uuid.UUID  `gorm:"type:uuid;index;not null"` // pemilik project yang menagih
+	ProjectID     *uuid.UUID `gorm:"type:uuid;index"`          // nil = semua project milik owner
+	UserID        *uuid.UUID `gorm:"type:uuid;index"`          // nil = semua user di project
+	RateCents     int64      `gorm:"not null"`
+	EffectiveFrom time.Time  `gorm:"type:date;not null"`
+	CreatedAt     time.Time
+}
+
+// Specificity: 3 = project+user, 2 = project, 1 = user, 0 = default owner.
+func (r *HourlyRate) Specificity() int {
+	switch {
+	case r.ProjectID != nil && r.UserID != nil:
+		return 3
+	case r.ProjectID != nil:
+		return 2
+	case r.UserID != nil:
+		return 1
+	}
+	return 0
+}
+
+type InvoiceStatus string
+
+const (
+	InvoiceDraft InvoiceStatus = "draft"
+	InvoiceSent  InvoiceStatus = "sent"
+	InvoicePaid  InvoiceStatus = "paid"
+)
+
+type RoundingMode string
+
+const (
+	RoundUp      RoundingMode = "up"
+	RoundNearest RoundingMode = "nearest"
+	RoundDown    RoundingMode = "down"
+)
+
+// Invoice menagih log billable sebuah project dalam [PeriodStart, PeriodEnd] (tanggal inklusif).
+// Log yang masuk invoice dikunci (Log.InvoiceID) sampai invoice draft dihapus.
+// ProjectName dan ClientName adalah snapshot saat invoice dibuat.
+type Invoice struct {
+	ID              uuid.UUID     `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID          uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:uniq_user_invoice_number"`
+	Number          string        `gorm:"size:30;not null;uniqueIndex:uniq_user_invoice_number"`
+	ClientID        uuid.UUID     `gorm:"type:uuid;index;not null"`
+	ClientName      string        `gorm:"size:150"`
+	ProjectID       uuid.UUID     `gorm:"type:uuid;index;not null"`
+	ProjectName     string        `gorm:"size:120"`
+	PeriodStart     time.Time     `gorm:"type:date;not null"`
+	PeriodEnd       time.Time     `gorm:"type:date;not null"`
+	Status          InvoiceStatus `gorm:"type:text;not null;default:'draft';index"`
+	Currency        string        `gorm:"size:3;not null"`
+	RoundingMinutes int           `gorm:"not null;default:0"`
+	RoundingMode    RoundingMode  `gorm:"type:text;not null;default:'up'"`
+	TaxRate         float64       `gorm:"not null;default:0"` // persen, mis. 11 untuk PPN 11%
+	SubtotalCents   int64         `gorm:"not null"`
+	TaxCents        int64         `gorm:"not null"`
+	TotalCents      int64         `gorm:"not null"`
+	Notes           string        `gorm:"type:text"`
+	DueDate         *time.Time    `gorm:"type:date"`
+	SentAt          *time.Time
+	PaidAt          *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+
+	Lines []InvoiceLine `gorm:"-"`
+}
+
+// InvoiceLine mengelompokkan log per milestone dan rate.
+type InvoiceLine struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	InvoiceID     uuid.UUID  `gorm:"type:uuid;index;not null"`
+	Position      int        `gorm:"not null"`
+	MilestoneID   *uuid.UUID `gorm:"type:uuid"`
+	Description   string     `gorm:"size:200;not null"`
+	LogCount      int        `gorm:"not null"`
+	Minutes       int        `gorm:"not null"` // total durasi asli
+	BilledMinutes int        `gorm:"not null"` // setelah pembulatan per log
+	RateCents     int64      `gorm:"not null"`
+	AmountCents   int64      `gorm:"not null"`
+}
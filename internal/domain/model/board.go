@@ -0,0 +1,10 @@
+package model
+
+import "github.com/google/uuid"
+
+// BoardColumnLimit menyimpan WIP limit kolom kanban per project.
+type BoardColumnLimit struct {
+	ProjectID uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	Status    MilestoneStatus `gorm:"type:text;primaryKey"`
+	Limit     int             `gorm:"not null"`
+}
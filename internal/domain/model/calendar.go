@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkDays adalah bitmask hari kerja, bit ke-n = time.Weekday(n).
+type WorkDays int
+
+const DefaultWorkDays WorkDays = 1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday | 1<<time.Friday
+
+const DefaultDailyHours = 8.0
+
+func (w WorkDays) Includes(d time.Weekday) bool {
+	return w&(1<<d) != 0
+}
+
+// WorkCalendar menyimpan kapasitas kerja user. User tanpa baris kalender
+// memakai DefaultWorkDays dan DefaultDailyHours.
+type WorkCalendar struct {
+	UserID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	WorkDays   WorkDays  `gorm:"not null"`
+	DailyHours float64   `gorm:"not null"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+type HolidaySource string
+
+const (
+	HolidayManual HolidaySource = "manual"
+	HolidayICS    HolidaySource = "ics"
+)
+
+// Holiday adalah tanggal libur (tanpa jam) milik satu user.
+type Holiday struct {
+	ID        uuid.UUID     `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:uniq_user_holiday"`
+	Date      time.Time     `gorm:"type:date;not null;uniqueIndex:uniq_user_holiday"`
+	Name      string        `gorm:"size:200"`
+	Source    HolidaySource `gorm:"type:text;default:'manual'"`
+	CreatedAt time.Time
+}
+
+// TimeOff adalah cuti pribadi, StartDate dan EndDate inklusif.
+type TimeOff struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	StartDate time.Time `gorm:"type:date;not null"`
+	EndDate   time.Time `gorm:"type:date;not null"`
+	Reason    string    `gorm:"type:text"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GoalPeriod string
+
+const (
+	GoalDaily   GoalPeriod = "day"
+	GoalWeekly  GoalPeriod = "week"
+	GoalMonthly GoalPeriod = "month"
+)
+
+// Goal adalah target jam kerja user per periode. ProjectID kosong berarti
+// semua project user dihitung. EndDate kosong berarti goal berlaku terus.
+type Goal struct {
+	ID          uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID      uuid.UUID  `gorm:"type:uuid;index;not null"`
+	ProjectID   *uuid.UUID `gorm:"type:uuid;index"`
+	Period      GoalPeriod `gorm:"type:text;not null"`
+	TargetHours float64    `gorm:"not null"`
+	StartDate   time.Time  `gorm:"not null"`
+	EndDate     *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
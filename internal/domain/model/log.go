@@ -4,16 +4,52 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// LogCategory adalah jenis aktivitas tetap untuk setiap log.
+type LogCategory string
+
+const (
+	CategoryCoding   LogCategory = "coding"
+	CategoryMeeting  LogCategory = "meeting"
+	CategoryResearch LogCategory = "research"
+	CategoryReview   LogCategory = "review"
+	CategoryLearning LogCategory = "learning"
+	CategoryAdmin    LogCategory = "admin"
+	CategoryOther    LogCategory = "other"
+)
+
+var LogCategories = []LogCategory{
+	CategoryCoding, CategoryMeeting, CategoryResearch, CategoryReview,
+	CategoryLearning, CategoryAdmin, CategoryOther,
+}
+
+func (c LogCategory) Valid() bool {
+	for _, v := range LogCategories {
+		if c == v {
+			return true
+		}
+	}
+	return false
+}
+
 type Log struct {
 	ID              uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
 	ProjectID       uuid.UUID `gorm:"type:uuid;index;not null"`
 	MilestoneID *uuid.UUID	`gorm:"type:uuid;index;"`
+	TaskID      *uuid.UUID `gorm:"type:uuid;index"`
 	UserID          uuid.UUID `gorm:"type:uuid;index;not null"`
 	Description            string    `gorm:"type:text"`
 	DurationMinutes int       `gorm:"not null"`         
 	LoggedAt        time.Time `gorm:"index"`             
+	Category        LogCategory `gorm:"type:text;not null;default:'other';index"`
+	Billable        bool        `gorm:"not null;default:false"`
+	InvoiceID       *uuid.UUID  `gorm:"type:uuid;index"` // terisi = log terkunci oleh invoice
 	CreatedAt       time.Time
 	UpdatedAt		time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+
+	// Tags diisi oleh service dari tabel log_tags (nama tag, tanpa '#').
+	Tags []string `gorm:"-"`
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type MilestoneStatus string
@@ -12,6 +13,18 @@ const (
 	StatusPending     MilestoneStatus = "pending"
 	StatusInProgress MilestoneStatus = "in_progress"
 	StatusDone       MilestoneStatus = "done"
+	StatusBlocked    MilestoneStatus = "blocked"
+)
+
+// MilestoneAction adalah transisi status yang boleh diminta oleh user.
+type MilestoneAction string
+
+const (
+	ActionStart    MilestoneAction = "start"
+	ActionComplete MilestoneAction = "complete"
+	ActionReopen   MilestoneAction = "reopen"
+	ActionBlock    MilestoneAction = "block"
+	ActionUnblock  MilestoneAction = "unblock"
 )
 
 
@@ -21,8 +34,33 @@ type Milestone struct {
 	Name       string          `gorm:"size:150;not null"`
 	OrderIdx   int             `gorm:"default:0; "` 
 	Status     MilestoneStatus `gorm:"type:text;default:'pending'"`
+	BlockedReason string       `gorm:"type:text"`
+	EstimatedHours *float64
+	Weight     *float64 // opsional; jika kosong bobot mengikuti EstimatedHours
 	DueDate    *time.Time
 	CompletedAt *time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
-}
\ No newline at end of file
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
+
+// MilestoneStatusHistory mencatat setiap perpindahan status milestone.
+type MilestoneStatusHistory struct {
+	ID          uuid.UUID       `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	MilestoneID uuid.UUID       `gorm:"type:uuid;index;not null"`
+	Action      MilestoneAction `gorm:"type:text;not null"`
+	FromStatus  MilestoneStatus `gorm:"type:text;not null"`
+	ToStatus    MilestoneStatus `gorm:"type:text;not null"`
+	Reason      string          `gorm:"type:text"`
+	ChangedBy   *uuid.UUID      `gorm:"type:uuid"`
+	ChangedAt   time.Time       `gorm:"index"`
+}
+
+// MilestoneDependency: MilestoneID tidak boleh dimulai sebelum DependsOnID selesai.
+type MilestoneDependency struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID   uuid.UUID `gorm:"type:uuid;index;not null"`
+	MilestoneID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uniq_milestone_dependency"`
+	DependsOnID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uniq_milestone_dependency;index"`
+	CreatedAt   time.Time
+}
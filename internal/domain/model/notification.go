@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationKind string
+
+const (
+	NotifyMilestoneDueSoon   NotificationKind = "milestone_due_soon"
+	NotifyMilestoneOverdue   NotificationKind = "milestone_overdue"
+	NotifyInsightStatus      NotificationKind = "insight_status_changed"
+	NotifyInvitationReceived NotificationKind = "invitation_received"
+	NotifyInvitationAccepted NotificationKind = "invitation_accepted"
+	NotifyInvitationDeclined NotificationKind = "invitation_declined"
+	NotifyNoTimeLogged       NotificationKind = "no_time_logged"
+	NotifyCommentMention     NotificationKind = "comment_mention"
+)
+
+var NotificationKinds = []NotificationKind{
+	NotifyMilestoneDueSoon, NotifyMilestoneOverdue, NotifyInsightStatus,
+	NotifyInvitationReceived, NotifyInvitationAccepted, NotifyInvitationDeclined,
+	NotifyNoTimeLogged, NotifyCommentMention,
+}
+
+func (k NotificationKind) Valid() bool {
+	for _, v := range NotificationKinds {
+		if k == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification adalah isi notification center user (channel in-app). DedupeKey mencegah
+// pengingat terjadwal yang sama dibuat dua kali; kosong untuk notifikasi tanpa dedupe.
+type Notification struct {
+	ID        uuid.UUID        `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID        `gorm:"type:uuid;not null;index;uniqueIndex:uniq_notification_dedupe"`
+	Kind      NotificationKind `gorm:"type:text;not null"`
+	Title     string           `gorm:"size:200;not null"`
+	Body      string           `gorm:"type:text"`
+	ProjectID *uuid.UUID       `gorm:"type:uuid;index"`
+	EntityID  *uuid.UUID       `gorm:"type:uuid"` // milestone, insight, undangan, komentar, dll. sesuai Kind
+	DedupeKey *string          `gorm:"size:200;uniqueIndex:uniq_notification_dedupe"`
+	ReadAt    *time.Time
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// NotificationPreference adalah pengaturan notifikasi per user. Notifikasi in-app selalu
+// aktif kecuali jenisnya di-mute; email dan webhook adalah channel tambahan.
+// User tanpa baris memakai DefaultNotificationPreference.
+type NotificationPreference struct {
+	UserID      uuid.UUID          `gorm:"type:uuid;primaryKey"`
+	Email       bool               `gorm:"not null;default:false"`
+	WebhookURL  string             `gorm:"size:500"` // kosong = webhook nonaktif
+	MutedKinds  []NotificationKind `gorm:"serializer:json;type:text"`
+	DueSoonDays int                `gorm:"not null;default:3"` // pengingat sebelum DueDate milestone
+	IdleDays    int                `gorm:"not null;default:3"` // 0 = tanpa pengingat "belum ada log"
+
+	// Kunci HMAC X-DevTracker-Signature milik user ini, dibuat saat webhook diaktifkan dan
+	// hanya ditampilkan sekali. Tidak pernah ikut di response preferensi.
+	WebhookSecret string `gorm:"size:64"`
+
+	// Digest mingguan dikirim Senin pukul DigestHour waktu lokal user (opt-in).
+	DigestEnabled  bool       `gorm:"not null;default:false;index"`
+	DigestHour     int        `gorm:"not null;default:8"`
+	DigestSentWeek *time.Time `gorm:"type:date"` // Senin minggu digest terakhir yang dikirim
+
+	UpdatedAt time.Time
+}
+
+func DefaultNotificationPreference(userID uuid.UUID) *NotificationPreference {
+	return &NotificationPreference{UserID: userID, DueSoonDays: 3, IdleDays: 3, DigestHour: 8}
+}
+
+func (p *NotificationPreference) Muted(kind NotificationKind) bool {
+	for _, k := range p.MutedKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -4,13 +4,23 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// Project yang diarsipkan bersifat read-only; DeletedAt berarti project ada di trash
+// (soft delete, di-cascade ke milestone, task, log, insight dan report dengan timestamp yang sama).
 type Project struct {
-	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
-	Name      string    `gorm:"size:120;not null"`
-	Deadline  *time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;index;not null"`
+	Name       string    `gorm:"size:120;not null"`
+	Deadline   *time.Time
+	ClientID   *uuid.UUID `gorm:"type:uuid;index"` // terisi untuk project yang ditagihkan
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
+
+func (p *Project) Archived() bool {
+	return p.ArchivedAt != nil
 }
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectRevision adalah snapshot nama & deadline project setiap kali berubah.
+// Version 1 dibuat saat project dibuat; Prev* kosong pada versi pertama.
+type ProjectRevision struct {
+	ID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uniq_project_revision"`
+	Version      int       `gorm:"not null;uniqueIndex:uniq_project_revision"`
+	Name         string    `gorm:"size:120;not null"`
+	Deadline     *time.Time
+	PrevName     string `gorm:"size:120"`
+	PrevDeadline *time.Time
+	Reason       string    `gorm:"type:text"`
+	ChangedBy    uuid.UUID `gorm:"type:uuid"`
+	ChangedAt    time.Time `gorm:"index"`
+}
+
+// DeadlineChanged bernilai true jika revisi ini memindahkan deadline (bukan versi awal).
+func (r *ProjectRevision) DeadlineChanged() bool {
+	if r.Version <= 1 {
+		return false
+	}
+	if r.Deadline == nil || r.PrevDeadline == nil {
+		return r.Deadline != r.PrevDeadline
+	}
+	return !r.Deadline.Equal(*r.PrevDeadline)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Report struct {
@@ -11,4 +12,5 @@ type Report struct {
 	ProjectID uuid.UUID `gorm:"type:uuid;index;not null"`
 	URLPDF    string    `gorm:"size:255;not null"`
 	GeneratedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
\ No newline at end of file
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScopeChangeKind string
+
+const (
+	ScopeAdded   ScopeChangeKind = "added"
+	ScopeRemoved ScopeChangeKind = "removed"
+)
+
+// ScopeChange mencatat milestone yang ditambah/dihapus dari project. Snapshot Weight dan
+// EstimatedHours mentah, waktu dibuat dan CompletedAt disimpan supaya burndown tetap bisa
+// dihitung (dengan basis bobot project yang sama) setelah milestone dihapus.
+type ScopeChange struct {
+	ID                 uuid.UUID       `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID          uuid.UUID       `gorm:"type:uuid;index;not null"`
+	MilestoneID        uuid.UUID       `gorm:"type:uuid;index;not null"`
+	MilestoneName      string          `gorm:"size:120"`
+	Kind               ScopeChangeKind `gorm:"type:text;not null"`
+	Weight             *float64
+	EstimatedHours     *float64
+	MilestoneCreatedAt time.Time
+	CompletedAt        *time.Time
+	ChangedAt          time.Time `gorm:"index"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SprintStatus string
+
+const (
+	SprintPlanned   SprintStatus = "planned"
+	SprintActive    SprintStatus = "active"
+	SprintCompleted SprintStatus = "completed"
+)
+
+// SprintItemOutcome kosong selama item masih terbuka di sprint.
+type SprintItemOutcome string
+
+const (
+	OutcomeOpen        SprintItemOutcome = ""
+	OutcomeCompleted   SprintItemOutcome = "completed"
+	OutcomeCarriedOver SprintItemOutcome = "carried_over"
+	OutcomeReturned    SprintItemOutcome = "returned"
+)
+
+type Sprint struct {
+	ID          uuid.UUID    `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID   uuid.UUID    `gorm:"type:uuid;index;not null"`
+	Name        string       `gorm:"size:120;not null"`
+	Goal        string       `gorm:"type:text"`
+	StartDate   time.Time    `gorm:"not null"`
+	EndDate     time.Time    `gorm:"not null"`
+	Status      SprintStatus `gorm:"type:text;default:'planned';not null"`
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// SprintItem menautkan milestone atau task ke sprint. Satu item hanya boleh
+// terbuka di satu sprint; item yang di-carry over mendapat baris baru di sprint berikutnya.
+type SprintItem struct {
+	ID            uuid.UUID         `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	SprintID      uuid.UUID         `gorm:"type:uuid;index;not null"`
+	MilestoneID   *uuid.UUID        `gorm:"type:uuid;index"`
+	TaskID        *uuid.UUID        `gorm:"type:uuid;index"`
+	CarriedFromID *uuid.UUID        `gorm:"type:uuid"`
+	Outcome       SprintItemOutcome `gorm:"type:text;default:''"`
+	AddedAt       time.Time
+	ClosedAt      *time.Time
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SupervisorStatus string
+
+const (
+	SupervisorPending  SupervisorStatus = "pending"
+	SupervisorActive   SupervisorStatus = "active"
+	SupervisorDeclined SupervisorStatus = "declined"
+	SupervisorRevoked  SupervisorStatus = "revoked"
+)
+
+// ProjectSupervisor adalah undangan sekaligus hak akses read-only seorang supervisor
+// (mentor/dosen) ke project. Undangan dikirim ke email; SupervisorID terisi saat diterima.
+// Mengundang ulang email yang sama memakai baris yang sama.
+type ProjectSupervisor struct {
+	ID           uuid.UUID        `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID    uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:uniq_project_supervisor"`
+	Email        string           `gorm:"size:120;not null;uniqueIndex:uniq_project_supervisor;index"`
+	SupervisorID *uuid.UUID       `gorm:"type:uuid;index"`
+	InvitedBy    uuid.UUID        `gorm:"type:uuid;not null"`
+	Status       SupervisorStatus `gorm:"type:text;not null;default:'pending'"`
+	CreatedAt    time.Time
+	RespondedAt  *time.Time
+}
+
+type CommentTarget string
+
+const (
+	CommentOnProject   CommentTarget = "project"
+	CommentOnMilestone CommentTarget = "milestone"
+	CommentOnLog       CommentTarget = "log"
+	CommentOnInsight   CommentTarget = "insight"
+)
+
+// Comment adalah komentar berutas pada project, milestone, log atau insight. ParentID kosong
+// berarti komentar utama; balasan selalu berada di target yang sama dengan parent-nya.
+type Comment struct {
+	ID         uuid.UUID     `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID  uuid.UUID     `gorm:"type:uuid;index;not null"`
+	TargetType CommentTarget `gorm:"type:text;not null;index:idx_comment_target"`
+	TargetID   uuid.UUID     `gorm:"type:uuid;not null;index:idx_comment_target"`
+	ParentID   *uuid.UUID    `gorm:"type:uuid;index"`
+	AuthorID   uuid.UUID     `gorm:"type:uuid;not null"`
+	Body       string        `gorm:"type:text;not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+
+	// Mentions diisi oleh service dari tabel comment_mentions.
+	Mentions []uuid.UUID `gorm:"-"`
+}
+
+// CommentMention mencatat user (pemilik atau supervisor project) yang di-@mention di komentar.
+type CommentMention struct {
+	CommentID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt time.Time
+}
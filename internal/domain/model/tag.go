@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tag didefinisikan per user. Nama disimpan lowercase tanpa '#', sehingga
+// "#Auth" di deskripsi log dan tag "auth" adalah tag yang sama.
+type Tag struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uniq_user_tag"`
+	Name      string    `gorm:"size:50;not null;uniqueIndex:uniq_user_tag"`
+	Color     string    `gorm:"size:7"` // hex, mis. #22c55e
+	CreatedAt time.Time
+}
+
+type LogTag struct {
+	LogID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TagID uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Task adalah checklist kecil di bawah satu milestone.
+type Task struct {
+	ID          uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	MilestoneID uuid.UUID  `gorm:"type:uuid;index;not null"`
+	Title       string     `gorm:"size:200;not null"`
+	Done        bool       `gorm:"not null;default:false"`
+	AssigneeID  *uuid.UUID `gorm:"type:uuid;index"`
+	OrderIdx    int        `gorm:"default:0"`
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
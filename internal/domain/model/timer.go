@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Timer adalah sesi kerja yang sedang berjalan. Setiap user maksimal punya satu
+// timer; saat dihentikan timer diubah menjadi Log.
+type Timer struct {
+	ID          uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID      uuid.UUID  `gorm:"type:uuid;uniqueIndex;not null"`
+	ProjectID   uuid.UUID  `gorm:"type:uuid;index;not null"`
+	MilestoneID *uuid.UUID `gorm:"type:uuid"`
+	TaskID      *uuid.UUID `gorm:"type:uuid"`
+	Description string     `gorm:"type:text"`
+	StartedAt   time.Time  `gorm:"not null"`
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TimesheetStatus string
+
+const (
+	TimesheetSubmitted TimesheetStatus = "submitted"
+	TimesheetApproved  TimesheetStatus = "approved"
+	TimesheetRejected  TimesheetStatus = "rejected"
+)
+
+// Timesheet adalah pengajuan log satu user di satu project untuk satu minggu (Senin-Minggu
+// di timezone user). Minggu tanpa baris Timesheet berarti belum diajukan. Log di minggu
+// yang sudah submitted atau approved tidak bisa dibuat, diubah atau dihapus.
+type Timesheet struct {
+	ID           uuid.UUID       `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ProjectID    uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uniq_timesheet_week"`
+	UserID       uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uniq_timesheet_week;index"`
+	WeekStart    time.Time       `gorm:"type:date;not null;uniqueIndex:uniq_timesheet_week"`
+	Status       TimesheetStatus `gorm:"type:text;not null;index"`
+	LogCount     int             `gorm:"not null;default:0"`
+	TotalMinutes int             `gorm:"not null;default:0"` // snapshot saat submit/approve
+	SubmittedAt  time.Time       `gorm:"not null"`
+	ReviewedBy   *uuid.UUID      `gorm:"type:uuid"`
+	ReviewedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+type TimesheetAction string
+
+const (
+	TimesheetActionSubmit  TimesheetAction = "submit"
+	TimesheetActionApprove TimesheetAction = "approve"
+	TimesheetActionReject  TimesheetAction = "reject"
+	TimesheetActionComment TimesheetAction = "comment"
+)
+
+// TimesheetComment mencatat percakapan dan riwayat keputusan sebuah timesheet.
+type TimesheetComment struct {
+	ID          uuid.UUID       `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	TimesheetID uuid.UUID       `gorm:"type:uuid;index;not null"`
+	AuthorID    uuid.UUID       `gorm:"type:uuid;not null"`
+	Action      TimesheetAction `gorm:"type:text;not null"`
+	Body        string          `gorm:"type:text"`
+	CreatedAt   time.Time
+}
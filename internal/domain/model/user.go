@@ -11,6 +11,24 @@ type User struct {
 	Name         string    `gorm:"size:100;not null"`
 	Email        string    `gorm:"size:120;uniqueIndex;not null"`
 	PasswordHash string    `gorm:"size:255;not null"`
+	Timezone     string    `gorm:"size:64;default:'Asia/Jakarta';not null"` // nama IANA, dipakai untuk bucketing harian
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+}
+
+// DefaultTimezone dipakai untuk user lama yang belum mengatur timezone.
+const DefaultTimezone = "Asia/Jakarta"
+
+// Location mengembalikan timezone user, fallback ke DefaultTimezone jika tidak valid.
+func (u *User) Location() *time.Location {
+	if u.Timezone != "" {
+		if loc, err := time.LoadLocation(u.Timezone); err == nil {
+			return loc
+		}
+	}
+	loc, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
 }
\ No newline at end of file
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Type string
+
+const (
+	LogCreated Type = "log.created"
+	LogUpdated Type = "log.updated"
+	LogDeleted Type = "log.deleted"
+
+	MilestoneCreated Type = "milestone.created"
+	MilestoneUpdated Type = "milestone.updated"
+	MilestoneDeleted Type = "milestone.deleted"
+
+	TimerStarted Type = "timer.started"
+	TimerStopped Type = "timer.stopped"
+
+	GoalChanged Type = "goal.changed"
+
+	InsightCreated Type = "insight.created"
+	InsightUpdated Type = "insight.updated"
+
+	ReportCreated Type = "report.created"
+	ReportUpdated Type = "report.updated"
+	ReportDeleted Type = "report.deleted"
+
+	// EntityID untuk event supervisor adalah id undangan (ProjectSupervisor)
+	SupervisorInvited  Type = "supervisor.invited"
+	SupervisorAccepted Type = "supervisor.accepted"
+	SupervisorDeclined Type = "supervisor.declined"
+
+	CommentCreated Type = "comment.created"
+	CommentUpdated Type = "comment.updated"
+)
+
+// Event adalah perubahan domain yang sudah tersimpan. UserID adalah user yang
+// melakukan perubahan (bisa kosong), ProjectID project yang terdampak.
+type Event struct {
+	Type      Type
+	UserID    uuid.UUID
+	ProjectID uuid.UUID
+	EntityID  uuid.UUID
+	At        time.Time
+}
+
+type Handler func(Event)
+
+type Publisher interface {
+	Publish(e Event)
+}
+
+// Bus adalah pub/sub in-process. Handler dipanggil secara sinkron di goroutine
+// publisher, jadi handler harus cepat dan tidak boleh memanggil Publish kembali
+// untuk tipe event yang sama.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[Type][]Handler
+	all      []Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[Type][]Handler),
+	}
+}
+
+// Subscribe mendaftarkan handler untuk tipe event tertentu; tanpa tipe berarti semua event.
+func (b *Bus) Subscribe(h Handler, types ...Type) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(types) == 0 {
+		b.all = append(b.all, h)
+		return
+	}
+	for _, t := range types {
+		b.handlers[t] = append(b.handlers[t], h)
+	}
+}
+
+func (b *Bus) Publish(e Event) {
+	if e.At.IsZero() {
+		e.At = time.Now()
+	}
+
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers[e.Type])+len(b.all))
+	handlers = append(handlers, b.handlers[e.Type]...)
+	handlers = append(handlers, b.all...)
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		h(e)
+	}
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/repository"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ActivityHandler struct {
+	svc *service.ActivityService
+}
+
+func NewActivityHandler(svc *service.ActivityService) *ActivityHandler {
+	return &ActivityHandler{svc: svc}
+}
+
+func toActivityResponse(a *repository.Activity) dto.ProjectActivityResponse {
+	return dto.ProjectActivityResponse{
+		Type:        string(a.Type),
+		ID:          a.ID.String(),
+		ProjectID:   a.ProjectID.String(),
+		OccurredAt:  a.OccurredAt.Format(time.RFC3339),
+		ActorID:     util.UUIDPtrToStringPtr(a.ActorID),
+		MilestoneID: util.UUIDPtrToStringPtr(a.MilestoneID),
+		Title:       a.Title,
+		Minutes:     a.Minutes,
+		FromStatus:  a.FromStatus,
+		ToStatus:    a.ToStatus,
+	}
+}
+
+// GetProjectActivity mengembalikan feed aktivitas project (log, status milestone, insight, report).
+func (h *ActivityHandler) GetProjectActivity(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	q, err := parseListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	page, err := h.svc.Feed(ctx, userID, projectID, q)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toPageResponse(page, toActivityResponse))
+}
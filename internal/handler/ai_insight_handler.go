@@ -106,27 +106,32 @@ func (h *AIInsightHandler) GetInsightsByProject(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID"})
 	}
 
+	q, err := parseLegacyListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	insights, err := h.svc.GetInsightsByProject(ctx, projectID)
+	page, err := h.svc.GetInsightsByProject(ctx, projectID, q)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-	resp := make([]dto.AIInsightResponse, len(insights))
-	for i, insight := range insights {
-		resp[i] = dto.AIInsightResponse{
-			ID:          insight.ID.String(),
-			ProjectID:   insight.ProjectID.String(),
-			InsightType: string(insight.Type),
-			Content:     insight.Content,
-			GeneratedAt: insight.GeneratedAt,
-	
-		}
-	}
+	return writeList(c, page, toInsightResponse)
+}
 
-	return c.JSON(resp)
+// toInsightResponse memetakan model.AIInsight ke dto.AIInsightResponse.
+func toInsightResponse(insight *model.AIInsight) dto.AIInsightResponse {
+	return dto.AIInsightResponse{
+		ID:          insight.ID.String(),
+		ProjectID:   insight.ProjectID.String(),
+		InsightType: string(insight.Type),
+		Content:     insight.Content,
+		Status:      string(insight.Status),
+		GeneratedAt: insight.GeneratedAt,
+	}
 }
 
 func (h *AIInsightHandler) UpdateInsight(c *fiber.Ctx) error {
@@ -0,0 +1,342 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type AnalyticsHandler struct {
+	svc *service.AnalyticsService
+}
+
+func NewAnalyticsHandler(svc *service.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		svc: svc,
+	}
+}
+
+func (h *AnalyticsHandler) GetEstimateReport(c *fiber.Ctx) error {
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	report, err := h.svc.CompareEstimates(ctx, userID, projectID, filter)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.EstimateReportResponse{
+		ProjectID:  report.ProjectID.String(),
+		Milestones: make([]dto.MilestoneEstimateResponse, 0, len(report.Milestones)),
+		Accuracy: dto.EstimateAccuracyResponse{
+			SampleSize:          report.Accuracy.SampleSize,
+			MeanAbsPercentError: report.Accuracy.MeanAbsPercentError,
+			OverrunRatio:        report.Accuracy.OverrunRatio,
+			Overruns:            report.Accuracy.Overruns,
+			Underruns:           report.Accuracy.Underruns,
+			WithinTolerance:     report.Accuracy.WithinTolerance,
+		},
+		Breakdown: toBreakdownResponse(report.Breakdown),
+	}
+
+	for _, e := range report.Milestones {
+		resp.Milestones = append(resp.Milestones, dto.MilestoneEstimateResponse{
+			MilestoneID:     e.Milestone.ID.String(),
+			Name:            e.Milestone.Name,
+			Status:          e.Milestone.Status,
+			Weight:          e.Weight,
+			EstimatedHours:  e.EstimatedHours,
+			ActualHours:     e.ActualHours,
+			VarianceHours:   e.VarianceHours,
+			VariancePercent: e.VariancePercent,
+		})
+	}
+
+	return c.JSON(resp)
+}
+
+const chartDateFormat = "2006-01-02"
+
+func (h *AnalyticsHandler) GetProjectBurndown(c *fiber.Ctx) error {
+	return h.projectChart(c, toBurndownResponse)
+}
+
+func (h *AnalyticsHandler) GetProjectBurnup(c *fiber.Ctx) error {
+	return h.projectChart(c, toBurnupResponse)
+}
+
+func (h *AnalyticsHandler) GetSprintBurndown(c *fiber.Ctx) error {
+	return h.sprintChart(c, toBurndownResponse)
+}
+
+func (h *AnalyticsHandler) GetSprintBurnup(c *fiber.Ctx) error {
+	return h.sprintChart(c, toBurnupResponse)
+}
+
+func (h *AnalyticsHandler) projectChart(c *fiber.Ctx, render func(*service.BurnChart) interface{}) error {
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	chart, err := h.svc.ProjectBurnChart(ctx, userID, projectID, filter)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(render(chart))
+}
+
+func (h *AnalyticsHandler) sprintChart(c *fiber.Ctx, render func(*service.BurnChart) interface{}) error {
+	sprintID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	chart, err := h.svc.SprintBurnChart(ctx, userID, sprintID, filter)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(render(chart))
+}
+
+func toScopeMarkers(chart *service.BurnChart) []dto.ScopeChangeMarkerResponse {
+	markers := make([]dto.ScopeChangeMarkerResponse, 0, len(chart.ScopeChanges))
+	for _, m := range chart.ScopeChanges {
+		markers = append(markers, dto.ScopeChangeMarkerResponse{
+			Date:  m.Date.Format(time.RFC3339),
+			Kind:  string(m.Kind),
+			Name:  m.Name,
+			Delta: m.Delta,
+		})
+	}
+	return markers
+}
+
+func toBurndownResponse(chart *service.BurnChart) interface{} {
+	resp := dto.BurndownResponse{
+		ProjectID:    chart.ProjectID.String(),
+		SprintID:     util.UUIDPtrToStringPtr(chart.SprintID),
+		Start:        chart.Start.Format(chartDateFormat),
+		Deadline:     util.FormatPtr(chart.Deadline),
+		Points:       make([]dto.BurndownPointResponse, 0, len(chart.Points)),
+		Ideal:        make([]dto.IdealPointResponse, 0, len(chart.Ideal)),
+		ScopeChanges: toScopeMarkers(chart),
+		Breakdown:    toBreakdownResponse(chart.Breakdown),
+	}
+	for _, p := range chart.Points {
+		resp.Points = append(resp.Points, dto.BurndownPointResponse{
+			Date:        p.Date.Format(chartDateFormat),
+			Remaining:   p.Remaining,
+			LoggedHours: p.LoggedHours,
+		})
+	}
+	for _, p := range chart.Ideal {
+		resp.Ideal = append(resp.Ideal, dto.IdealPointResponse{Date: p.Date.Format(chartDateFormat), Value: p.Remaining})
+	}
+	return resp
+}
+
+func toBurnupResponse(chart *service.BurnChart) interface{} {
+	resp := dto.BurnupResponse{
+		ProjectID:    chart.ProjectID.String(),
+		SprintID:     util.UUIDPtrToStringPtr(chart.SprintID),
+		Start:        chart.Start.Format(chartDateFormat),
+		Deadline:     util.FormatPtr(chart.Deadline),
+		Points:       make([]dto.BurnupPointResponse, 0, len(chart.Points)),
+		Ideal:        make([]dto.IdealPointResponse, 0, len(chart.Ideal)),
+		ScopeChanges: toScopeMarkers(chart),
+		Breakdown:    toBreakdownResponse(chart.Breakdown),
+	}
+	for _, p := range chart.Points {
+		resp.Points = append(resp.Points, dto.BurnupPointResponse{
+			Date:        p.Date.Format(chartDateFormat),
+			Scope:       p.Scope,
+			Completed:   p.Completed,
+			LoggedHours: p.LoggedHours,
+		})
+	}
+	for _, p := range chart.Ideal {
+		resp.Ideal = append(resp.Ideal, dto.IdealPointResponse{Date: p.Date.Format(chartDateFormat), Value: p.Completed})
+	}
+	return resp
+}
+
+func (h *AnalyticsHandler) GetUserHeatmap(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	year := c.QueryInt("year", time.Now().Year())
+	if year < 1970 || year > 9999 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid year"})
+	}
+
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	heatmap, err := h.svc.UserHeatmap(ctx, userID, year, filter)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.HeatmapResponse{
+		Year:          heatmap.Year,
+		Timezone:      heatmap.Timezone,
+		TotalMinutes:  heatmap.TotalMinutes,
+		ActiveDays:    heatmap.ActiveDays,
+		CurrentStreak: heatmap.CurrentStreak,
+		LongestStreak: heatmap.LongestStreak,
+		Days:          make([]dto.HeatmapDayResponse, 0, len(heatmap.Days)),
+		ByWeekday:     make([]dto.WeekdayMinutesResponse, 0, len(heatmap.ByWeekday)),
+		ByHour:        make([]dto.HourMinutesResponse, 0, len(heatmap.ByHour)),
+		Projects:      make([]dto.ProjectShareResponse, 0, len(heatmap.Projects)),
+		Breakdown:     toBreakdownResponse(heatmap.Breakdown),
+	}
+
+	for _, d := range heatmap.Days {
+		resp.Days = append(resp.Days, dto.HeatmapDayResponse{
+			Date:    d.Date.Format(chartDateFormat),
+			Minutes: d.Minutes,
+			Level:   d.Level,
+		})
+	}
+	for weekday, minutes := range heatmap.ByWeekday {
+		resp.ByWeekday = append(resp.ByWeekday, dto.WeekdayMinutesResponse{
+			Weekday: time.Weekday(weekday).String(),
+			Minutes: minutes,
+		})
+	}
+	for hour, minutes := range heatmap.ByHour {
+		resp.ByHour = append(resp.ByHour, dto.HourMinutesResponse{Hour: hour, Minutes: minutes})
+	}
+	for _, p := range heatmap.Projects {
+		resp.Projects = append(resp.Projects, dto.ProjectShareResponse{
+			ProjectID:   p.ProjectID.String(),
+			ProjectName: p.ProjectName,
+			Minutes:     p.Minutes,
+			Percent:     p.Percent,
+		})
+	}
+
+	return c.JSON(resp)
+}
+
+// GetTimeBreakdown: GET /users/me/breakdown?from=&to=&project_id=&tag=&category=
+// Default 30 hari terakhir.
+func (h *AnalyticsHandler) GetTimeBreakdown(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+	if t, err := parseListTime(c.Query("from"), false); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid from, use YYYY-MM-DD or RFC3339"})
+	} else if t != nil {
+		from = *t
+	}
+	if t, err := parseListTime(c.Query("to"), true); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid to, use YYYY-MM-DD or RFC3339"})
+	} else if t != nil {
+		to = *t
+	}
+
+	projectID, err := parseOptionalUUID(c.Query("project_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	breakdown, err := h.svc.TimeBreakdown(ctx, userID, from, to, projectID, filter)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toBreakdownResponse(*breakdown))
+}
+
+// parseLogFilter membaca ?tag= dan ?category= (boleh dipisah koma).
+func parseLogFilter(c *fiber.Ctx) (service.LogFilter, error) {
+	return service.NewLogFilter(splitQuery(c.Query("tag")), splitQuery(c.Query("category")))
+}
+
+func splitQuery(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func toBreakdownResponse(b service.LogBreakdown) dto.BreakdownResponse {
+	resp := dto.BreakdownResponse{
+		TotalMinutes:    b.TotalMinutes,
+		ByCategory:      make([]dto.BreakdownItemResponse, 0, len(b.ByCategory)),
+		ByTag:           make([]dto.BreakdownItemResponse, 0, len(b.ByTag)),
+		UntaggedMinutes: b.UntaggedMinutes,
+	}
+	for _, item := range b.ByCategory {
+		resp.ByCategory = append(resp.ByCategory, dto.BreakdownItemResponse{Key: item.Key, Minutes: item.Minutes, Percent: item.Percent})
+	}
+	for _, item := range b.ByTag {
+		resp.ByTag = append(resp.ByTag, dto.BreakdownItemResponse{Key: item.Key, Minutes: item.Minutes, Percent: item.Percent})
+	}
+	return resp
+}
@@ -0,0 +1,476 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type BillingHandler struct {
+	svc        *service.BillingService
+	invoiceSvc *service.InvoiceService
+}
+
+func NewBillingHandler(svc *service.BillingService, invoiceSvc *service.InvoiceService) *BillingHandler {
+	return &BillingHandler{svc: svc, invoiceSvc: invoiceSvc}
+}
+
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+func toClientResponse(c *model.Client) dto.ClientResponse {
+	return dto.ClientResponse{
+		ID:        c.ID.String(),
+		Name:      c.Name,
+		Email:     c.Email,
+		Address:   c.Address,
+		Currency:  c.Currency,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toRateResponse(r *model.HourlyRate) dto.RateResponse {
+	return dto.RateResponse{
+		ID:            r.ID.String(),
+		ProjectID:     util.UUIDPtrToStringPtr(r.ProjectID),
+		UserID:        util.UUIDPtrToStringPtr(r.UserID),
+		Rate:          fromCents(r.RateCents),
+		EffectiveFrom: r.EffectiveFrom.Format(chartDateFormat),
+		CreatedAt:     r.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toInvoiceResponse(inv *model.Invoice) dto.InvoiceResponse {
+	resp := dto.InvoiceResponse{
+		ID:              inv.ID.String(),
+		Number:          inv.Number,
+		Status:          string(inv.Status),
+		ClientID:        inv.ClientID.String(),
+		ClientName:      inv.ClientName,
+		ProjectID:       inv.ProjectID.String(),
+		ProjectName:     inv.ProjectName,
+		PeriodStart:     inv.PeriodStart.Format(chartDateFormat),
+		PeriodEnd:       inv.PeriodEnd.Format(chartDateFormat),
+		Currency:        inv.Currency,
+		RoundingMinutes: inv.RoundingMinutes,
+		RoundingMode:    string(inv.RoundingMode),
+		TaxRate:         inv.TaxRate,
+		Subtotal:        fromCents(inv.SubtotalCents),
+		Tax:             fromCents(inv.TaxCents),
+		Total:           fromCents(inv.TotalCents),
+		Notes:           inv.Notes,
+		SentAt:          util.FormatPtr(inv.SentAt),
+		PaidAt:          util.FormatPtr(inv.PaidAt),
+		CreatedAt:       inv.CreatedAt.Format(time.RFC3339),
+	}
+	if inv.DueDate != nil {
+		resp.DueDate = inv.DueDate.Format(chartDateFormat)
+	}
+	for _, l := range inv.Lines {
+		resp.Lines = append(resp.Lines, dto.InvoiceLineResponse{
+			Position:      l.Position,
+			MilestoneID:   util.UUIDPtrToStringPtr(l.MilestoneID),
+			Description:   l.Description,
+			LogCount:      l.LogCount,
+			Minutes:       l.Minutes,
+			BilledMinutes: l.BilledMinutes,
+			Rate:          fromCents(l.RateCents),
+			Amount:        fromCents(l.AmountCents),
+		})
+	}
+	return resp
+}
+
+func (h *BillingHandler) GetClients(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	clients, err := h.svc.ListClients(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.ClientResponse, 0, len(clients))
+	for i := range clients {
+		resp = append(resp, toClientResponse(&clients[i]))
+	}
+	return c.JSON(resp)
+}
+
+func (h *BillingHandler) GetClient(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid client ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	client, err := h.svc.GetClient(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toClientResponse(client))
+}
+
+func (h *BillingHandler) CreateClient(c *fiber.Ctx) error {
+	var req dto.ClientRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	client := &model.Client{Name: req.Name, Email: req.Email, Address: req.Address, Currency: req.Currency}
+	if err := h.svc.CreateClient(ctx, userID, client); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toClientResponse(client))
+}
+
+func (h *BillingHandler) UpdateClient(c *fiber.Ctx) error {
+	var req dto.ClientRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid client ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	client, err := h.svc.GetClient(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	client.Name = req.Name
+	client.Email = req.Email
+	client.Address = req.Address
+	client.Currency = req.Currency
+	if err := h.svc.UpdateClient(ctx, client); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toClientResponse(client))
+}
+
+func (h *BillingHandler) DeleteClient(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid client ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteClient(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *BillingHandler) SetProjectClient(c *fiber.Ctx) error {
+	var req dto.SetProjectClientRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	clientID, err := parseOptionalUUID(req.ClientID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid client ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	project, err := h.svc.SetProjectClient(ctx, userID, projectID, clientID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toProjectResponse(project))
+}
+
+func (h *BillingHandler) GetRates(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	rates, err := h.svc.ListRates(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.RateResponse, 0, len(rates))
+	for i := range rates {
+		resp = append(resp, toRateResponse(&rates[i]))
+	}
+	return c.JSON(resp)
+}
+
+func (h *BillingHandler) CreateRate(c *fiber.Ctx) error {
+	var req dto.CreateRateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := parseOptionalUUID(req.ProjectID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+	rateUserID, err := parseOptionalUUID(req.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user ID format"})
+	}
+
+	effectiveFrom, err := time.Parse(chartDateFormat, req.EffectiveFrom)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "effective_from must be YYYY-MM-DD"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	rate := &model.HourlyRate{
+		ProjectID:     projectID,
+		UserID:        rateUserID,
+		RateCents:     toCents(req.Rate),
+		EffectiveFrom: effectiveFrom,
+	}
+	if err := h.svc.CreateRate(ctx, userID, rate); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toRateResponse(rate))
+}
+
+func (h *BillingHandler) DeleteRate(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid rate ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteRate(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *BillingHandler) CreateInvoice(c *fiber.Ctx) error {
+	var req dto.CreateInvoiceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(req.ProjectID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	start, err := time.Parse(chartDateFormat, req.PeriodStart)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "period_start must be YYYY-MM-DD"})
+	}
+	end, err := time.Parse(chartDateFormat, req.PeriodEnd)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "period_end must be YYYY-MM-DD"})
+	}
+
+	var dueDate *time.Time
+	if req.DueDate != "" {
+		d, err := time.Parse(chartDateFormat, req.DueDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "due_date must be YYYY-MM-DD"})
+		}
+		dueDate = &d
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
+	defer cancel()
+
+	inv, err := h.invoiceSvc.GenerateInvoice(ctx, userID, service.InvoiceRequest{
+		ProjectID:       projectID,
+		PeriodStart:     start,
+		PeriodEnd:       end,
+		RoundingMinutes: req.RoundingMinutes,
+		RoundingMode:    model.RoundingMode(req.RoundingMode),
+		TaxRate:         req.TaxRate,
+		DueDate:         dueDate,
+		Notes:           req.Notes,
+	})
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toInvoiceResponse(inv))
+}
+
+func (h *BillingHandler) GetInvoices(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	invoices, err := h.invoiceSvc.ListInvoices(ctx, userID, model.InvoiceStatus(c.Query("status")))
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.InvoiceResponse, 0, len(invoices))
+	for i := range invoices {
+		resp = append(resp, toInvoiceResponse(&invoices[i]))
+	}
+	return c.JSON(resp)
+}
+
+func (h *BillingHandler) GetInvoice(c *fiber.Ctx) error {
+	return h.invoiceAction(c, h.invoiceSvc.GetInvoice)
+}
+
+func (h *BillingHandler) SendInvoice(c *fiber.Ctx) error {
+	return h.invoiceAction(c, h.invoiceSvc.SendInvoice)
+}
+
+func (h *BillingHandler) PayInvoice(c *fiber.Ctx) error {
+	return h.invoiceAction(c, h.invoiceSvc.MarkPaid)
+}
+
+func (h *BillingHandler) invoiceAction(c *fiber.Ctx, action func(ctx context.Context, userID, id uuid.UUID) (*model.Invoice, error)) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid invoice ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	inv, err := action(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toInvoiceResponse(inv))
+}
+
+func (h *BillingHandler) DeleteInvoice(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid invoice ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.invoiceSvc.DeleteInvoice(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *BillingHandler) GetInvoicePDF(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid invoice ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 5*time.Second)
+	defer cancel()
+
+	inv, body, err := h.invoiceSvc.RenderPDF(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s.pdf"`, inv.Number))
+	return c.Send(body)
+}
@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CalendarHandler struct {
+	svc *service.CalendarService
+}
+
+func NewCalendarHandler(svc *service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		svc: svc,
+	}
+}
+
+func toCalendarResponse(cal *model.WorkCalendar) dto.CalendarResponse {
+	resp := dto.CalendarResponse{
+		WorkDays:   []string{},
+		DailyHours: cal.DailyHours,
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if cal.WorkDays.Includes(d) {
+			resp.WorkDays = append(resp.WorkDays, d.String())
+		}
+	}
+	return resp
+}
+
+func toHolidayResponse(h *model.Holiday) dto.HolidayResponse {
+	return dto.HolidayResponse{
+		ID:     h.ID.String(),
+		Date:   h.Date.Format(chartDateFormat),
+		Name:   h.Name,
+		Source: string(h.Source),
+	}
+}
+
+func toTimeOffResponse(t *model.TimeOff) dto.TimeOffResponse {
+	return dto.TimeOffResponse{
+		ID:        t.ID.String(),
+		StartDate: t.StartDate.Format(chartDateFormat),
+		EndDate:   t.EndDate.Format(chartDateFormat),
+		Reason:    t.Reason,
+	}
+}
+
+// parseWorkDays menerima nama hari ("Monday" atau "mon"), tidak peka huruf besar.
+func parseWorkDays(names []string) (model.WorkDays, bool) {
+	var days model.WorkDays
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			full := strings.ToLower(d.String())
+			if name == full || name == full[:3] {
+				days |= 1 << d
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return days, true
+}
+
+func (h *CalendarHandler) GetCalendar(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	cal, err := h.svc.GetCalendar(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toCalendarResponse(cal))
+}
+
+func (h *CalendarHandler) UpdateCalendar(c *fiber.Ctx) error {
+	var req dto.UpdateCalendarRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	workDays, ok := parseWorkDays(req.WorkDays)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid work day name"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	cal, err := h.svc.UpdateCalendar(ctx, userID, workDays, req.DailyHours)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toCalendarResponse(cal))
+}
+
+func (h *CalendarHandler) GetHolidays(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	holidays, err := h.svc.GetHolidays(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.HolidayResponse, 0, len(holidays))
+	for i := range holidays {
+		resp = append(resp, toHolidayResponse(&holidays[i]))
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *CalendarHandler) CreateHoliday(c *fiber.Ctx) error {
+	var req dto.CreateHolidayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	date, err := time.Parse(chartDateFormat, req.Date)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid date format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	holiday, err := h.svc.AddHoliday(ctx, userID, date, req.Name)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toHolidayResponse(holiday))
+}
+
+// ImportHolidays menerima file ICS sebagai body mentah (text/calendar) atau field multipart "file".
+func (h *CalendarHandler) ImportHolidays(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	var r io.Reader = bytes.NewReader(c.Body())
+	if file, err := c.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cannot read uploaded file"})
+		}
+		defer f.Close()
+		r = f
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
+	defer cancel()
+
+	imported, skipped, err := h.svc.ImportHolidays(ctx, userID, r)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(dto.HolidayImportResponse{Imported: imported, Skipped: skipped})
+}
+
+func (h *CalendarHandler) DeleteHoliday(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid holiday ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteHoliday(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *CalendarHandler) GetTimeOff(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	entries, err := h.svc.GetTimeOff(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.TimeOffResponse, 0, len(entries))
+	for i := range entries {
+		resp = append(resp, toTimeOffResponse(&entries[i]))
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *CalendarHandler) CreateTimeOff(c *fiber.Ctx) error {
+	var req dto.CreateTimeOffRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	start, err := time.Parse(chartDateFormat, req.StartDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid start date format"})
+	}
+
+	end, err := time.Parse(chartDateFormat, req.EndDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid end date format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	entry, err := h.svc.AddTimeOff(ctx, userID, start, end, req.Reason)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toTimeOffResponse(entry))
+}
+
+func (h *CalendarHandler) DeleteTimeOff(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid time off ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteTimeOff(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetAvailability menghitung hari kerja dan jam tersedia di rentang from..to (inklusif, YYYY-MM-DD).
+// Default: hari ini sampai 4 minggu ke depan.
+func (h *CalendarHandler) GetAvailability(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	cal, err := h.svc.Load(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	from := time.Now().In(cal.Location)
+	if q := c.Query("from"); q != "" {
+		t, err := time.ParseInLocation(chartDateFormat, q, cal.Location)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid from date"})
+		}
+		from = t
+	}
+
+	to := from.AddDate(0, 0, 27)
+	if q := c.Query("to"); q != "" {
+		t, err := time.ParseInLocation(chartDateFormat, q, cal.Location)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid to date"})
+		}
+		to = t
+	}
+
+	if to.Before(from) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "to must not be before from"})
+	}
+	if to.Sub(from) > 366*24*time.Hour {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "range cannot exceed one year"})
+	}
+
+	avail := cal.Availability(from, to)
+	return c.JSON(dto.AvailabilityResponse{
+		From:        from.Format(chartDateFormat),
+		To:          to.Format(chartDateFormat),
+		WorkingDays: avail.WorkingDays,
+		Hours:       avail.Hours,
+	})
+}
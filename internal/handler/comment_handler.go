@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CommentHandler struct {
+	svc *service.CommentService
+}
+
+func NewCommentHandler(svc *service.CommentService) *CommentHandler {
+	return &CommentHandler{svc: svc}
+}
+
+func toCommentResponse(c *model.Comment) dto.CommentResponse {
+	mentions := make([]string, 0, len(c.Mentions))
+	for _, id := range c.Mentions {
+		mentions = append(mentions, id.String())
+	}
+	return dto.CommentResponse{
+		ID:        c.ID.String(),
+		ParentID:  util.UUIDPtrToStringPtr(c.ParentID),
+		AuthorID:  c.AuthorID.String(),
+		Body:      c.Body,
+		Mentions:  mentions,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+		Replies:   []dto.CommentResponse{},
+	}
+}
+
+func toCommentThreadResponse(threads []service.CommentThread) []dto.CommentResponse {
+	resp := make([]dto.CommentResponse, 0, len(threads))
+	for i := range threads {
+		r := toCommentResponse(&threads[i].Comment)
+		r.Replies = toCommentThreadResponse(threads[i].Replies)
+		resp = append(resp, r)
+	}
+	return resp
+}
+
+func (h *CommentHandler) GetProjectComments(c *fiber.Ctx) error {
+	return h.list(c, model.CommentOnProject)
+}
+
+func (h *CommentHandler) CreateProjectComment(c *fiber.Ctx) error {
+	return h.create(c, model.CommentOnProject)
+}
+
+func (h *CommentHandler) GetMilestoneComments(c *fiber.Ctx) error {
+	return h.list(c, model.CommentOnMilestone)
+}
+
+func (h *CommentHandler) CreateMilestoneComment(c *fiber.Ctx) error {
+	return h.create(c, model.CommentOnMilestone)
+}
+
+func (h *CommentHandler) GetLogComments(c *fiber.Ctx) error {
+	return h.list(c, model.CommentOnLog)
+}
+
+func (h *CommentHandler) CreateLogComment(c *fiber.Ctx) error {
+	return h.create(c, model.CommentOnLog)
+}
+
+func (h *CommentHandler) GetInsightComments(c *fiber.Ctx) error {
+	return h.list(c, model.CommentOnInsight)
+}
+
+func (h *CommentHandler) CreateInsightComment(c *fiber.Ctx) error {
+	return h.create(c, model.CommentOnInsight)
+}
+
+func (h *CommentHandler) list(c *fiber.Ctx, target model.CommentTarget) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	targetID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid " + string(target) + " ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	threads, err := h.svc.ListComments(ctx, userID, target, targetID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toCommentThreadResponse(threads))
+}
+
+func (h *CommentHandler) create(c *fiber.Ctx, target model.CommentTarget) error {
+	var req dto.CommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	targetID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid " + string(target) + " ID format"})
+	}
+
+	parentID, err := parseOptionalUUID(req.ParentID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid parent ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	comment, err := h.svc.CreateComment(ctx, userID, target, targetID, parentID, req.Body)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toCommentResponse(comment))
+}
+
+func (h *CommentHandler) UpdateComment(c *fiber.Ctx) error {
+	var req dto.CommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid comment ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	comment, err := h.svc.UpdateComment(ctx, userID, id, req.Body)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toCommentResponse(comment))
+}
+
+func (h *CommentHandler) DeleteComment(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid comment ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteComment(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type DashboardHandler struct {
+	svc *service.DashboardService
+}
+
+func NewDashboardHandler(svc *service.DashboardService) *DashboardHandler {
+	return &DashboardHandler{
+		svc: svc,
+	}
+}
+
+func toDashboardMilestones(items []service.DashboardMilestone) []dto.DashboardMilestoneResponse {
+	resp := make([]dto.DashboardMilestoneResponse, 0, len(items))
+	for _, it := range items {
+		resp = append(resp, dto.DashboardMilestoneResponse{
+			ID:          it.Milestone.ID.String(),
+			ProjectID:   it.Milestone.ProjectID.String(),
+			ProjectName: it.ProjectName,
+			Name:        it.Milestone.Name,
+			Status:      string(it.Milestone.Status),
+			DueDate:     util.FormatPtr(it.Milestone.DueDate),
+		})
+	}
+	return resp
+}
+
+func (h *DashboardHandler) GetDashboard(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	dashboard, err := h.svc.GetDashboard(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.DashboardResponse{
+		Projects:       make([]dto.DashboardProjectResponse, 0, len(dashboard.Projects)),
+		DueSoon:        toDashboardMilestones(dashboard.DueSoon),
+		Overdue:        toDashboardMilestones(dashboard.Overdue),
+		HoursThisWeek:  dashboard.HoursThisWeek,
+		HoursLastWeek:  dashboard.HoursLastWeek,
+		RunningTimer:   toTimerResponse(dashboard.RunningTimer),
+		Goals:          toGoalProgressResponses(dashboard.Goals),
+		RecentActivity: make([]dto.ActivityResponse, 0, len(dashboard.RecentActivity)),
+		GeneratedAt:    dashboard.GeneratedAt.Format(time.RFC3339),
+	}
+
+	for _, p := range dashboard.Projects {
+		var status *string
+		if p.InsightStatus != nil {
+			s := string(*p.InsightStatus)
+			status = &s
+		}
+		resp.Projects = append(resp.Projects, dto.DashboardProjectResponse{
+			ID:                  p.Project.ID.String(),
+			Name:                p.Project.Name,
+			Deadline:            util.FormatPtr(p.Project.Deadline),
+			ProgressPercent:     p.ProgressPercent,
+			MilestonesTotal:     p.MilestonesTotal,
+			MilestonesCompleted: p.MilestonesCompleted,
+			InsightStatus:       status,
+			InsightAt:           util.FormatPtr(p.InsightAt),
+		})
+	}
+
+	for _, a := range dashboard.RecentActivity {
+		resp.RecentActivity = append(resp.RecentActivity, dto.ActivityResponse{
+			Kind:        string(a.Kind),
+			At:          a.At.Format(time.RFC3339),
+			ProjectID:   a.ProjectID.String(),
+			ProjectName: a.ProjectName,
+			EntityID:    a.EntityID.String(),
+			Summary:     a.Summary,
+			Minutes:     a.Minutes,
+		})
+	}
+
+	return c.JSON(resp)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type DigestHandler struct {
+	svc *service.DigestService
+}
+
+func NewDigestHandler(svc *service.DigestService) *DigestHandler {
+	return &DigestHandler{svc: svc}
+}
+
+// PreviewDigest merender digest mingguan tanpa mengirimnya.
+// ?date=YYYY-MM-DD memilih minggu pengiriman (default minggu ini); ?format=html|text|json.
+func (h *DigestHandler) PreviewDigest(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	at := time.Now()
+	if v := c.Query("date"); v != "" {
+		d, err := time.Parse(chartDateFormat, v)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid date, use YYYY-MM-DD"})
+		}
+		// tengah hari supaya tanggal tidak bergeser di timezone user
+		at = d.Add(12 * time.Hour)
+	}
+
+	format := c.Query("format", "html")
+	if format != "html" && format != "text" && format != "json" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be html, text or json"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 5*time.Second)
+	defer cancel()
+
+	rendered, err := h.svc.Preview(ctx, userID, at)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	switch format {
+	case "text":
+		c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+		return c.SendString(rendered.Text)
+	case "json":
+		return c.JSON(dto.DigestPreviewResponse{
+			WeekStart: rendered.Digest.WeekStart.Format(chartDateFormat),
+			Subject:   rendered.Subject,
+			Text:      rendered.Text,
+			HTML:      rendered.HTML,
+		})
+	}
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.SendString(rendered.HTML)
+}
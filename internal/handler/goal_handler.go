@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GoalHandler struct {
+	svc *service.GoalService
+}
+
+func NewGoalHandler(svc *service.GoalService) *GoalHandler {
+	return &GoalHandler{
+		svc: svc,
+	}
+}
+
+func toGoalResponse(g *model.Goal) dto.GoalResponse {
+	return dto.GoalResponse{
+		ID:          g.ID.String(),
+		ProjectID:   util.UUIDPtrToStringPtr(g.ProjectID),
+		Period:      string(g.Period),
+		TargetHours: g.TargetHours,
+		StartDate:   g.StartDate.Format(time.RFC3339),
+		EndDate:     util.FormatPtr(g.EndDate),
+		CreatedAt:   g.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toGoalPeriodResponse(p service.GoalPeriodResult) dto.GoalPeriodResponse {
+	return dto.GoalPeriodResponse{
+		Start:       p.Start.Format(time.RFC3339),
+		End:         p.End.Format(time.RFC3339),
+		LoggedHours: p.LoggedHours,
+		TargetHours: p.TargetHours,
+		Percent:     p.Percent(),
+		Hit:         p.Hit,
+		InProgress:  p.InProgress,
+	}
+}
+
+func toGoalProgressResponses(items []service.GoalProgress) []dto.GoalProgressResponse {
+	resp := make([]dto.GoalProgressResponse, 0, len(items))
+	for i := range items {
+		entry := dto.GoalProgressResponse{
+			Goal:   toGoalResponse(&items[i].Goal),
+			Active: items[i].Active,
+		}
+		if items[i].Current != nil {
+			current := toGoalPeriodResponse(*items[i].Current)
+			entry.Current = &current
+		}
+		resp = append(resp, entry)
+	}
+	return resp
+}
+
+func (h *GoalHandler) CreateGoal(c *fiber.Ctx) error {
+	var req dto.CreateGoalRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	var projectID *uuid.UUID
+	if req.ProjectID != nil && *req.ProjectID != "" {
+		parsed, err := uuid.Parse(*req.ProjectID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+		}
+		projectID = &parsed
+	}
+
+	start := time.Now()
+	if req.StartDate != "" {
+		t, err := time.Parse(time.RFC3339, req.StartDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid start date format"})
+		}
+		start = t
+	}
+
+	var end *time.Time
+	if req.EndDate != nil && *req.EndDate != "" {
+		t, err := time.Parse(time.RFC3339, *req.EndDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid end date format"})
+		}
+		end = &t
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	goal, err := h.svc.CreateGoal(ctx, userID, projectID, model.GoalPeriod(req.Period), req.TargetHours, start, end)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toGoalResponse(goal))
+}
+
+func (h *GoalHandler) GetGoals(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	progress, err := h.svc.GetProgressByUser(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toGoalProgressResponses(progress))
+}
+
+func (h *GoalHandler) GetGoal(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid goal ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	goal, err := h.svc.GetGoal(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toGoalResponse(goal))
+}
+
+func (h *GoalHandler) UpdateGoal(c *fiber.Ctx) error {
+	var req dto.UpdateGoalRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid goal ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	goal, err := h.svc.GetGoal(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	if req.Period != nil {
+		goal.Period = model.GoalPeriod(*req.Period)
+	}
+
+	if req.TargetHours != nil {
+		goal.TargetHours = *req.TargetHours
+	}
+
+	if req.StartDate != nil {
+		t, err := time.Parse(time.RFC3339, *req.StartDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid start date format"})
+		}
+		goal.StartDate = t
+	}
+
+	if req.EndDate != nil {
+		if *req.EndDate == "" {
+			goal.EndDate = nil
+		} else {
+			t, err := time.Parse(time.RFC3339, *req.EndDate)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid end date format"})
+			}
+			goal.EndDate = &t
+		}
+	}
+
+	if err := h.svc.UpdateGoal(ctx, goal); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toGoalResponse(goal))
+}
+
+func (h *GoalHandler) DeleteGoal(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid goal ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteGoal(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *GoalHandler) GetGoalHistory(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid goal ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	history, err := h.svc.GetHistory(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.GoalHistoryResponse{
+		Goal:    toGoalResponse(&history.Goal),
+		Hits:    history.Hits,
+		Misses:  history.Misses,
+		Periods: make([]dto.GoalPeriodResponse, 0, len(history.Periods)),
+	}
+	for _, p := range history.Periods {
+		resp.Periods = append(resp.Periods, toGoalPeriodResponse(p))
+	}
+
+	return c.JSON(resp)
+}
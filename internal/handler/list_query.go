@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// parseListQuery membaca query string bersama untuk endpoint list:
+// limit, cursor, sort, order (asc|desc), from, to, milestone_id, user_id, status, type.
+// from/to menerima tanggal (2006-01-02, UTC) atau RFC3339; tanggal pada to bersifat inklusif.
+func parseListQuery(c *fiber.Ctx) (repository.ListQuery, error) {
+	q := repository.ListQuery{
+		Limit:  c.QueryInt("limit"),
+		Cursor: c.Query("cursor"),
+		Sort:   c.Query("sort"),
+		Status: c.Query("status"),
+		Type:   c.Query("type"),
+	}
+
+	switch c.Query("order") {
+	case "", "desc":
+	case "asc":
+		q.Asc = true
+	default:
+		return q, util.ErrBadRequest("order must be asc or desc")
+	}
+
+	var err error
+	if q.From, err = parseListTime(c.Query("from"), false); err != nil {
+		return q, util.ErrBadRequest("invalid from, use YYYY-MM-DD or RFC3339")
+	}
+	if q.To, err = parseListTime(c.Query("to"), true); err != nil {
+		return q, util.ErrBadRequest("invalid to, use YYYY-MM-DD or RFC3339")
+	}
+
+	if q.MilestoneID, err = parseOptionalUUID(c.Query("milestone_id")); err != nil {
+		return q, util.ErrBadRequest("invalid milestone ID")
+	}
+	if q.UserID, err = parseOptionalUUID(c.Query("user_id")); err != nil {
+		return q, util.ErrBadRequest("invalid user ID")
+	}
+
+	return q, nil
+}
+
+// parseLegacyListQuery dipakai endpoint list yang sudah ada sebelum pagination. Selain
+// parameter parseListQuery, client lama boleh mengirim ?format=array (lihat writeList).
+func parseLegacyListQuery(c *fiber.Ctx) (repository.ListQuery, error) {
+	q, err := parseListQuery(c)
+	if err != nil {
+		return q, err
+	}
+	switch c.Query("format") {
+	case "", "page", "array":
+	default:
+		return q, util.ErrBadRequest("format must be page or array")
+	}
+	return q, nil
+}
+
+func parseListTime(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(chartDateFormat, value); err == nil {
+		if endOfDay {
+			t = t.AddDate(0, 0, 1)
+		}
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// toPageResponse memetakan satu halaman repository ke dto.PageResponse.
+func toPageResponse[T, R any](page *repository.Page[T], convert func(*T) R) dto.PageResponse[R] {
+	resp := dto.PageResponse[R]{Data: make([]R, 0, len(page.Items))}
+	for i := range page.Items {
+		resp.Data = append(resp.Data, convert(&page.Items[i]))
+	}
+	if page.NextCursor != "" {
+		resp.NextCursor = &page.NextCursor
+	}
+	return resp
+}
+
+// writeList menulis satu halaman sebagai dto.PageResponse. Dengan ?format=array isinya
+// dikirim sebagai array polos untuk client lama; ukurannya tetap dibatasi limit
+// (maksimal repository.MaxListLimit) dan cursor berikutnya ada di header X-Next-Cursor.
+func writeList[T, R any](c *fiber.Ctx, page *repository.Page[T], convert func(*T) R) error {
+	resp := toPageResponse(page, convert)
+	if c.Query("format") == "array" {
+		if resp.NextCursor != nil {
+			c.Set("X-Next-Cursor", *resp.NextCursor)
+		}
+		return c.JSON(resp.Data)
+	}
+	return c.JSON(resp)
+}
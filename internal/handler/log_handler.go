@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
 	"devtracker/internal/service"
 	"devtracker/pkg/util"
 
@@ -63,6 +64,16 @@ func (h *LogHandler) CreateLog(c *fiber.Ctx) error {
 		milestoneID = &parsedMilestoneID;
 	}
 
+	var taskID *uuid.UUID
+
+	if req.TaskID != nil && *req.TaskID != "" {
+		parsedTaskID, err := uuid.Parse(*req.TaskID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task ID format"})
+		}
+		taskID = &parsedTaskID
+	}
+
 	loggedAt, err := time.Parse(time.RFC3339, req.LoggedAt)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid logged at format"})
@@ -72,24 +83,12 @@ func (h *LogHandler) CreateLog(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	log, err := h.svc.CreateLog(ctx, projectID, userID, milestoneID, req.Description, req.DurationMinutes, loggedAt)
+	log, err := h.svc.CreateLog(ctx, projectID, userID, milestoneID, taskID, req.Description, req.DurationMinutes, loggedAt, model.LogCategory(req.Category), req.Tags, req.Billable)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-	resp := dto.LogResponse{
-    ID:              log.ID.String(),
-    ProjectID:       log.ProjectID.String(),
-    MilestoneID:     util.UUIDPtrToStringPtr(log.MilestoneID),
-    UserID:          log.UserID.String(),
-    Description:     log.Description,
-    DurationMinutes: log.DurationMinutes,
-    LoggedAt:        log.LoggedAt.Format(time.RFC3339),
-    CreatedAt:       log.CreatedAt.Format(time.RFC3339),
-}
-	
-
-	return c.Status(201).JSON(resp)
+	return c.Status(201).JSON(toLogResponse(log))
 }
 
 func (h *LogHandler) GetLogByID(c *fiber.Ctx) error {
@@ -103,10 +102,12 @@ func (h *LogHandler) GetLogByID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid log ID"})
 	}
 
+	userID := c.Locals("userID").(uuid.UUID)
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	log, err := h.svc.GetLogByID(ctx, logID)
+	log, err := h.svc.GetLogByID(ctx, userID, logID)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
@@ -114,6 +115,30 @@ func (h *LogHandler) GetLogByID(c *fiber.Ctx) error {
 	return c.JSON(log)
 }
 
+// toLogResponse memetakan model.Log ke dto.LogResponse.
+func toLogResponse(log *model.Log) dto.LogResponse {
+	tags := log.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return dto.LogResponse{
+		ID:              log.ID.String(),
+		ProjectID:       log.ProjectID.String(),
+		MilestoneID:     util.UUIDPtrToStringPtr(log.MilestoneID),
+		TaskID:          util.UUIDPtrToStringPtr(log.TaskID),
+		UserID:          log.UserID.String(),
+		Description:     log.Description,
+		DurationMinutes: log.DurationMinutes,
+		LoggedAt:        log.LoggedAt.Format(time.RFC3339),
+		Category:        string(log.Category),
+		Tags:            tags,
+		Billable:        log.Billable,
+		InvoiceID:       util.UUIDPtrToStringPtr(log.InvoiceID),
+		CreatedAt:       log.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       log.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
 func (h *LogHandler) GetLogsByUser(c *fiber.Ctx) error {
 	userIDVal := c.Locals("userID")
 
@@ -122,16 +147,20 @@ func (h *LogHandler) GetLogsByUser(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
 
+	q, err := parseLegacyListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	logs, err := h.svc.GetLogsByUser(ctx, userID)
+	page, err := h.svc.GetLogsByUser(ctx, userID, q)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-	return c.JSON(logs)
+	return writeList(c, page, toLogResponse)
 }
 
 func (h *LogHandler) GetLogsByProject(c *fiber.Ctx) error {
@@ -145,44 +174,22 @@ func (h *LogHandler) GetLogsByProject(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID"})
 	}
 
+	q, err := parseLegacyListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
 
+	userID := c.Locals("userID").(uuid.UUID)
 
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	logs, err := h.svc.GetLogsByProject(ctx, projectID)
-
+	page, err := h.svc.GetLogsByProject(ctx, userID, projectID, q)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-
-	var response []dto.LogResponse;
-
-	for _, log := range logs {
-		var milestoneIDstr *string
-		if log.MilestoneID != nil{
-			s := log.MilestoneID.String()
-			milestoneIDstr = &s
-		}
-
-		response = append(response, dto.LogResponse{
-			ID: log.ID.String(),
-			ProjectID: log.ProjectID.String(),
-			MilestoneID: milestoneIDstr,
-			UserID: log.UserID.String(),
-			Description: log.Description,
-			DurationMinutes: log.DurationMinutes,
-			LoggedAt: log.LoggedAt.Format(time.RFC3339),
-			CreatedAt: log.CreatedAt.Format(time.RFC3339),
-		})
-	}
-
-	if response == nil {
-        response = []dto.LogResponse{}
-    }
-	
-	return c.JSON(response)
+	return writeList(c, page, toLogResponse)
 }
 
 func (h *LogHandler) UpdateLog(c *fiber.Ctx) error {
@@ -208,7 +215,7 @@ func (h *LogHandler) UpdateLog(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
 
-	log, err := h.svc.GetLogByID(c.Context(), logID)
+	log, err := h.svc.GetLogByID(c.Context(), userID, logID)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
@@ -234,6 +241,18 @@ func (h *LogHandler) UpdateLog(c *fiber.Ctx) error {
 		log.LoggedAt = t
 	}
 
+	if req.Category != nil {
+		log.Category = model.LogCategory(*req.Category)
+	}
+
+	if req.Tags != nil {
+		log.Tags = *req.Tags
+	}
+
+	if req.Billable != nil {
+		log.Billable = *req.Billable
+	}
+
 	if req.MilestoneID != nil {
 		if *req.MilestoneID == ""{
 			log.MilestoneID = nil
@@ -247,6 +266,19 @@ func (h *LogHandler) UpdateLog(c *fiber.Ctx) error {
 		}
 	}
 
+	if req.TaskID != nil {
+		if *req.TaskID == "" {
+			log.TaskID = nil
+		} else {
+			parsedTaskID, err := uuid.Parse(*req.TaskID)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task ID"})
+			}
+
+			log.TaskID = &parsedTaskID
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
@@ -255,26 +287,7 @@ func (h *LogHandler) UpdateLog(c *fiber.Ctx) error {
 		return util.WriteError(c, err)
 	}
 
-	var milestoneIDstr *string
-
-	if log.MilestoneID != nil{
-		s := log.MilestoneID.String()
-		milestoneIDstr = &s;
-	}
-
-	resp := dto.LogResponse{
-		ID: log.ID.String(),
-		ProjectID: log.ProjectID.String(),
-		MilestoneID: milestoneIDstr,
-		UserID: log.UserID.String(),
-		Description: log.Description,
-		DurationMinutes: log.DurationMinutes,
-		LoggedAt: log.LoggedAt.Format(time.RFC3339),
-		CreatedAt: log.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: time.Now().Format(time.RFC3339),
-	}
-
-	return c.JSON(resp)
+	return c.JSON(toLogResponse(log))
 }
 
 func (h *LogHandler) DeleteLog(c *fiber.Ctx) error {
@@ -288,10 +301,12 @@ func (h *LogHandler) DeleteLog(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid log ID"})
 	}
 
+	userID := c.Locals("userID").(uuid.UUID)
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	err = h.svc.DeleteLog(ctx, logID)
+	err = h.svc.DeleteLog(ctx, userID, logID)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
@@ -24,6 +24,24 @@ func NewMilestoneHandler(svc *service.MilestoneService) *MilestoneHandler {
 	}
 }
 
+func toMilestoneResponse(m *model.Milestone) dto.MilestoneResponse {
+	return dto.MilestoneResponse{
+		ID:             m.ID.String(),
+		ProjectID:      m.ProjectID.String(),
+		Name:           m.Name,
+		OrderIdx:       m.OrderIdx,
+		Status:         m.Status,
+		BlockedReason:  m.BlockedReason,
+		DueDate:        util.FormatPtr(m.DueDate),
+		CompletedAt:    util.FormatPtr(m.CompletedAt),
+		EstimatedHours: m.EstimatedHours,
+		Weight:         m.Weight,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      m.UpdatedAt.Format(time.RFC3339),
+		AllowedActions: service.AllowedMilestoneActions(m.Status),
+	}
+}
+
 
 func (h *MilestoneHandler) CreateMilestone(c *fiber.Ctx) error {
 	var req dto.CreateMilestoneRequest
@@ -45,6 +63,8 @@ func (h *MilestoneHandler) CreateMilestone(c *fiber.Ctx) error {
 		dueDatePtr = &dueDate
 	}
 
+	userID := c.Locals("userID").(uuid.UUID)
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
@@ -59,25 +79,12 @@ func (h *MilestoneHandler) CreateMilestone(c *fiber.Ctx) error {
 	}
 
 
-	milestone, err := h.svc.CreateMilestone(ctx, projectIDParsed, req.Name, req.OrderIdx, req.Status, dueDatePtr)
+	milestone, err := h.svc.CreateMilestone(ctx, userID, projectIDParsed, req.Name, req.OrderIdx, req.Status, dueDatePtr, req.EstimatedHours, req.Weight)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-
-
-	resp := dto.MilestoneResponse{
-		ID:          milestone.ID.String(),
-		ProjectID: projectID,
-		Name:        milestone.Name,
-		OrderIdx:    milestone.OrderIdx,
-		Status:      milestone.Status,
-		DueDate:     util.FormatPtr(milestone.DueDate),
-		CompletedAt: util.FormatPtr(milestone.CompletedAt),
-		CreatedAt:   milestone.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   milestone.UpdatedAt.Format(time.RFC3339),
-	}
-	return c.Status(fiber.StatusCreated).JSON(resp)
+	return c.Status(fiber.StatusCreated).JSON(toMilestoneResponse(milestone))
 
 }
 
@@ -102,19 +109,9 @@ func(h *MilestoneHandler) GetMilestonesByProject(c *fiber.Ctx) error {
 		return util.WriteError(c, err)
 	}
 
-	var resp []dto.MilestoneResponse
-	for _, m := range milestones {
-		resp = append(resp, dto.MilestoneResponse{
-			ID:          m.ID.String(),
-			ProjectID: projectID,
-			Name:        m.Name,
-			OrderIdx:    m.OrderIdx,
-			Status:      m.Status,
-			DueDate:     util.FormatPtr(m.DueDate),
-			CompletedAt: util.FormatPtr(m.CompletedAt),
-			CreatedAt:   m.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
-		})
+	resp := []dto.MilestoneResponse{}
+	for i := range milestones {
+		resp = append(resp, toMilestoneResponse(&milestones[i]))
 	}
 	return c.Status(fiber.StatusOK).JSON(resp)
 }
@@ -130,26 +127,17 @@ func (h *MilestoneHandler) GetMilestoneByID(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
 	}
 
+	userID := c.Locals("userID").(uuid.UUID)
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	milestone, err := h.svc.GetMilestoneByID(ctx, id)
+	milestone, err := h.svc.GetMilestoneByID(ctx, userID, id)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-	resp := dto.MilestoneResponse{
-		ID:          milestone.ID.String(),
-		ProjectID: milestone.ProjectID.String(),
-		Name:        milestone.Name,
-		OrderIdx:    milestone.OrderIdx,
-		Status:      milestone.Status,
-		DueDate:     util.FormatPtr(milestone.DueDate),
-		CompletedAt: util.FormatPtr(milestone.CompletedAt),
-		CreatedAt:   milestone.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   milestone.UpdatedAt.Format(time.RFC3339),
-	}
-	return c.Status(fiber.StatusOK).JSON(resp)
+	return c.Status(fiber.StatusOK).JSON(toMilestoneResponse(milestone))
 }
 
 func (h *MilestoneHandler) UpdateMilestone(c *fiber.Ctx) error {
@@ -171,10 +159,12 @@ func (h *MilestoneHandler) UpdateMilestone(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
 	}
 
+	userID := c.Locals("userID").(uuid.UUID)
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	milestone, err := h.svc.GetMilestoneByID(ctx, id)
+	milestone, err := h.svc.GetMilestoneByID(ctx, userID, id)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
@@ -209,30 +199,19 @@ func (h *MilestoneHandler) UpdateMilestone(c *fiber.Ctx) error {
 	}
 }
 
-	if err := h.svc.UpdateMilestone(ctx, milestone); err != nil {
-		return util.WriteError(c, err)
+	if req.EstimatedHours != nil {
+		milestone.EstimatedHours = req.EstimatedHours
 	}
 
-	if milestone.Status == model.StatusDone && milestone.CompletedAt == nil {
-		now := time.Now()
-		milestone.CompletedAt = &now
+	if req.Weight != nil {
+		milestone.Weight = req.Weight
 	}
-	if milestone.Status != model.StatusDone {
-		milestone.CompletedAt = nil
-}
 
-	resp := dto.MilestoneResponse{
-		ID:          milestone.ID.String(),
-		ProjectID: milestone.ProjectID.String(),
-		Name:        milestone.Name,
-		OrderIdx:    milestone.OrderIdx,
-		Status:      milestone.Status,
-		DueDate:     util.FormatPtr(milestone.DueDate),
-		CompletedAt: util.FormatPtr(milestone.CompletedAt),
-		CreatedAt:   milestone.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   milestone.UpdatedAt.Format(time.RFC3339),
+	if err := h.svc.UpdateMilestone(ctx, userID, milestone); err != nil {
+		return util.WriteError(c, err)
 	}
-	return c.Status(fiber.StatusOK).JSON(resp)
+
+	return c.Status(fiber.StatusOK).JSON(toMilestoneResponse(milestone))
 }
 
 
@@ -247,13 +226,283 @@ func (h *MilestoneHandler) DeleteMilestone(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
 	}
 
+	userID := c.Locals("userID").(uuid.UUID)
+
 	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 	defer cancel()
 
-	if err := h.svc.DeleteMilestone(ctx, id); err != nil {
+	if err := h.svc.DeleteMilestone(ctx, userID, id); err != nil {
 		return util.WriteError(c, err)
 	}
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+func (h *MilestoneHandler) StartMilestone(c *fiber.Ctx) error {
+	return h.transition(c, model.ActionStart)
+}
+
+func (h *MilestoneHandler) CompleteMilestone(c *fiber.Ctx) error {
+	return h.transition(c, model.ActionComplete)
+}
+
+func (h *MilestoneHandler) ReopenMilestone(c *fiber.Ctx) error {
+	return h.transition(c, model.ActionReopen)
+}
+
+func (h *MilestoneHandler) BlockMilestone(c *fiber.Ctx) error {
+	return h.transition(c, model.ActionBlock)
+}
+
+func (h *MilestoneHandler) UnblockMilestone(c *fiber.Ctx) error {
+	return h.transition(c, model.ActionUnblock)
+}
+
+func (h *MilestoneHandler) transition(c *fiber.Ctx, action model.MilestoneAction) error {
+	var req dto.MilestoneTransitionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+		}
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	milestone, err := h.svc.TransitionMilestone(ctx, userID, id, action, req.Reason)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(toMilestoneResponse(milestone))
+}
+
+func (h *MilestoneHandler) GetMilestoneHistory(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	history, err := h.svc.GetStatusHistory(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := []dto.MilestoneHistoryResponse{}
+	for _, entry := range history {
+		resp = append(resp, dto.MilestoneHistoryResponse{
+			ID:         entry.ID.String(),
+			Action:     entry.Action,
+			FromStatus: entry.FromStatus,
+			ToStatus:   entry.ToStatus,
+			Reason:     entry.Reason,
+			ChangedBy:  util.UUIDPtrToStringPtr(entry.ChangedBy),
+			ChangedAt:  entry.ChangedAt.Format(time.RFC3339),
+		})
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *MilestoneHandler) AddDependency(c *fiber.Ctx) error {
+	var req dto.AddDependencyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	dependsOnID, err := uuid.Parse(req.DependsOnID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid depends_on_id format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	dep, err := h.svc.AddDependency(ctx, userID, id, dependsOnID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toDependencyResponse(dep))
+}
+
+func (h *MilestoneHandler) GetDependencies(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	deps, err := h.svc.GetDependencies(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := []dto.MilestoneDependencyResponse{}
+	for i := range deps {
+		resp = append(resp, toDependencyResponse(&deps[i]))
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *MilestoneHandler) RemoveDependency(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	dependsOnID, err := uuid.Parse(c.Params("dependsOnID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid prerequisite ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.RemoveDependency(ctx, userID, id, dependsOnID); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func toDependencyResponse(d *model.MilestoneDependency) dto.MilestoneDependencyResponse {
+	return dto.MilestoneDependencyResponse{
+		ID:          d.ID.String(),
+		MilestoneID: d.MilestoneID.String(),
+		DependsOnID: d.DependsOnID.String(),
+		CreatedAt:   d.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (h *MilestoneHandler) ReorderMilestones(c *fiber.Ctx) error {
+	var req dto.ReorderMilestonesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.MilestoneIDs))
+	for _, raw := range req.MilestoneIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+		}
+		ids = append(ids, id)
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	milestones, err := h.svc.ReorderMilestones(ctx, userID, projectID, ids)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := []dto.MilestoneResponse{}
+	for i := range milestones {
+		resp = append(resp, toMilestoneResponse(&milestones[i]))
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *MilestoneHandler) GetBoard(c *fiber.Ctx) error {
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	board, err := h.svc.GetBoard(ctx, userID, projectID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.BoardResponse{
+		ProjectID: board.ProjectID.String(),
+		Columns:   make([]dto.BoardColumnResponse, 0, len(board.Columns)),
+	}
+
+	for _, col := range board.Columns {
+		cards := make([]dto.MilestoneResponse, 0, len(col.Milestones))
+		for i := range col.Milestones {
+			cards = append(cards, toMilestoneResponse(&col.Milestones[i]))
+		}
+
+		resp.Columns = append(resp.Columns, dto.BoardColumnResponse{
+			Status:     col.Status,
+			WIPLimit:   col.Limit,
+			Count:      len(col.Milestones),
+			OverLimit:  col.OverLimit(),
+			Milestones: cards,
+		})
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *MilestoneHandler) UpdateWIPLimits(c *fiber.Ctx) error {
+	var req dto.UpdateWIPLimitsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	limits := map[model.MilestoneStatus]*int{
+		model.StatusPending:    req.Pending,
+		model.StatusInProgress: req.InProgress,
+		model.StatusDone:       req.Done,
+	}
+
+	if err := h.svc.SetWIPLimits(ctx, userID, projectID, limits); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return h.GetBoard(c)
+}
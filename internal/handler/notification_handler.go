@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type NotificationHandler struct {
+	svc *service.NotificationService
+}
+
+func NewNotificationHandler(svc *service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{svc: svc}
+}
+
+func toNotificationResponse(n *model.Notification) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:        n.ID.String(),
+		Kind:      string(n.Kind),
+		Title:     n.Title,
+		Body:      n.Body,
+		ProjectID: util.UUIDPtrToStringPtr(n.ProjectID),
+		EntityID:  util.UUIDPtrToStringPtr(n.EntityID),
+		Read:      n.ReadAt != nil,
+		ReadAt:    util.FormatPtr(n.ReadAt),
+		CreatedAt: n.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toNotificationPreferenceResponse(p *model.NotificationPreference) dto.NotificationPreferenceResponse {
+	muted := make([]string, 0, len(p.MutedKinds))
+	for _, k := range p.MutedKinds {
+		muted = append(muted, string(k))
+	}
+	resp := dto.NotificationPreferenceResponse{
+		InApp:       true,
+		Email:       p.Email,
+		WebhookURL:  p.WebhookURL,
+		MutedKinds:  muted,
+		DueSoonDays: p.DueSoonDays,
+		IdleDays:    p.IdleDays,
+
+		DigestEnabled: p.DigestEnabled,
+		DigestHour:    p.DigestHour,
+	}
+	if p.DigestSentWeek != nil {
+		resp.DigestSentWeek = p.DigestSentWeek.Format(chartDateFormat)
+	}
+	return resp
+}
+
+// GetNotifications mendukung ?status=unread|read, ?type=<kind> dan ?unread=true.
+func (h *NotificationHandler) GetNotifications(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	q, err := parseListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	if c.QueryBool("unread") {
+		q.Status = "unread"
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	page, err := h.svc.ListNotifications(ctx, userID, q)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toPageResponse(page, toNotificationResponse))
+}
+
+func (h *NotificationHandler) GetUnreadCount(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	count, err := h.svc.UnreadCount(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(dto.UnreadCountResponse{Unread: count})
+}
+
+func (h *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid notification ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.MarkRead(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	n, err := h.svc.MarkAllRead(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(fiber.Map{"updated": n})
+}
+
+func (h *NotificationHandler) GetPreferences(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	pref, err := h.svc.GetPreferences(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toNotificationPreferenceResponse(pref))
+}
+
+func (h *NotificationHandler) UpdatePreferences(c *fiber.Ctx) error {
+	var req dto.NotificationPreferenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	upd := service.NotificationPreferenceUpdate{
+		Email:         req.Email,
+		WebhookURL:    req.WebhookURL,
+		DueSoonDays:   req.DueSoonDays,
+		IdleDays:      req.IdleDays,
+		DigestEnabled: req.DigestEnabled,
+		DigestHour:    req.DigestHour,
+	}
+	if req.MutedKinds != nil {
+		kinds := make([]model.NotificationKind, 0, len(*req.MutedKinds))
+		for _, k := range *req.MutedKinds {
+			kinds = append(kinds, model.NotificationKind(k))
+		}
+		upd.MutedKinds = &kinds
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	pref, secret, err := h.svc.UpdatePreferences(ctx, userID, upd)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	resp := toNotificationPreferenceResponse(pref)
+	resp.WebhookSecret = secret
+	return c.JSON(resp)
+}
+
+// RotateWebhookSecret membuat secret webhook baru; secret hanya ditampilkan di response ini.
+func (h *NotificationHandler) RotateWebhookSecret(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	secret, err := h.svc.RotateWebhookSecret(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(dto.WebhookSecretResponse{WebhookSecret: secret})
+}
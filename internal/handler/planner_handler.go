@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type PlannerHandler struct {
+	svc *service.PlannerService
+}
+
+func NewPlannerHandler(svc *service.PlannerService) *PlannerHandler {
+	return &PlannerHandler{
+		svc: svc,
+	}
+}
+
+func toPlanProjectResponse(p *service.PlanProject) dto.PlanProjectResponse {
+	resp := dto.PlanProjectResponse{
+		ProjectID:       p.Project.ID.String(),
+		Name:            p.Project.Name,
+		RemainingHours:  p.RemainingHours,
+		PlannedHours:    p.PlannedHours,
+		HoursByDeadline: p.HoursByDeadline,
+		ShortfallHours:  p.ShortfallHours,
+		SlipDays:        p.SlipDays,
+		Feasible:        p.Feasible,
+	}
+	if p.Project.Deadline != nil {
+		resp.Deadline = p.Project.Deadline.Format(time.RFC3339)
+	}
+	if p.ProjectedFinish != nil {
+		resp.ProjectedFinish = p.ProjectedFinish.Format(chartDateFormat)
+	}
+	return resp
+}
+
+func (h *PlannerHandler) GetPlan(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 5*time.Second)
+	defer cancel()
+
+	plan, err := h.svc.PlanCapacity(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.CapacityPlanResponse{
+		GeneratedAt:         plan.GeneratedAt.Format(time.RFC3339),
+		Timezone:            plan.Timezone,
+		DailyHours:          plan.DailyHours,
+		TotalRemainingHours: plan.TotalRemainingHours,
+		OverCommitted:       plan.OverCommitted,
+		Projects:            make([]dto.PlanProjectResponse, 0, len(plan.Projects)),
+		Weeks:               make([]dto.PlanWeekResponse, 0, len(plan.Weeks)),
+	}
+
+	if plan.FirstSlip != nil {
+		slip := toPlanProjectResponse(plan.FirstSlip)
+		resp.FirstSlip = &slip
+	}
+	for i := range plan.Projects {
+		resp.Projects = append(resp.Projects, toPlanProjectResponse(&plan.Projects[i]))
+	}
+	for _, w := range plan.Weeks {
+		week := dto.PlanWeekResponse{
+			WeekStart:     w.WeekStart.Format(chartDateFormat),
+			CapacityHours: w.CapacityHours,
+			PlannedHours:  w.PlannedHours,
+			Allocations:   make([]dto.PlanAllocationResponse, 0, len(w.Allocations)),
+		}
+		for _, a := range w.Allocations {
+			week.Allocations = append(week.Allocations, dto.PlanAllocationResponse{
+				ProjectID: a.ProjectID.String(),
+				Hours:     a.Hours,
+			})
+		}
+		resp.Weeks = append(resp.Weeks, week)
+	}
+
+	return c.JSON(resp)
+}
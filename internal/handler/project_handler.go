@@ -25,6 +25,22 @@ import (
 		}
 	}
 
+	func toProjectResponse(p *model.Project) dto.ProjectResponse {
+		resp := dto.ProjectResponse{
+			ID:         p.ID.String(),
+			Name:       p.Name,
+			Deadline:   util.FormatPtr(p.Deadline),
+			CreatedAt:  p.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:  p.UpdatedAt.Format(time.RFC3339),
+			ArchivedAt: util.FormatPtr(p.ArchivedAt),
+			ClientID:   util.UUIDPtrToStringPtr(p.ClientID),
+		}
+		if p.DeletedAt.Valid {
+			resp.DeletedAt = p.DeletedAt.Time.Format(time.RFC3339)
+		}
+		return resp
+	}
+
 	func (h *ProjectHandler) CreateProject(c *fiber.Ctx) error {
 		var req dto.CreateProjectRequest
 		if err := c.BodyParser(&req); err != nil {
@@ -50,14 +66,7 @@ import (
 			return util.WriteError(c, err)
 		}
 
-		resp := dto.ProjectResponse{
-			ID:        project.ID.String(),
-			Name:      project.Name,
-			Deadline:  util.FormatPtr(project.Deadline),
-			CreatedAt: project.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: project.UpdatedAt.Format(time.RFC3339),
-		}
-		return c.Status(201).JSON(resp)
+		return c.Status(201).JSON(toProjectResponse(project))
 	}
 
 
@@ -86,41 +95,31 @@ import (
 			return util.WriteError(c, err)
 		}
 
-		resp := (dto.ProjectResponse{
-			ID:        project.ID.String(),
-			Name:      project.Name,
-			Deadline:  util.FormatPtr(project.Deadline),
-			CreatedAt: project.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: project.UpdatedAt.Format(time.RFC3339),
-		})
-
-		return c.Status(200).JSON(resp)
+		return c.Status(200).JSON(toProjectResponse(project))
 	}
 
 
 	func (h *ProjectHandler) ListProjectsByUser(c *fiber.Ctx) error {
 		userID := c.Locals("userID").(uuid.UUID)
 
+		q, err := parseLegacyListQuery(c)
+		if err != nil {
+			return util.WriteError(c, err)
+		}
+		// project yang diarsipkan disembunyikan kecuali ?status=archived|all (atau ?include_archived=true)
+		if q.Status == "" && c.QueryBool("include_archived") {
+			q.Status = "all"
+		}
+
 		ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
 		defer cancel()
 
-		projects, err := h.svc.ListProjectByUser(ctx, userID)
+		page, err := h.svc.ListProjectByUser(ctx, userID, q)
 		if err != nil {
 			return util.WriteError(c, err)
 		}
 
-		var resp []dto.ProjectResponse
-		for _, p := range projects {
-			resp = append(resp, dto.ProjectResponse{
-				ID:        p.ID.String(),
-				Name:      p.Name,
-				Deadline:  util.FormatPtr(p.Deadline),
-				CreatedAt: p.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: p.UpdatedAt.Format(time.RFC3339),
-			})
-		}
-
-		return c.Status(200).JSON(resp)
+		return writeList(c, page, toProjectResponse)
 	}
 
 	func (h *ProjectHandler) UpdateProject(c *fiber.Ctx) error {
@@ -164,7 +163,7 @@ import (
 			UpdatedAt: time.Now(),
 		}
 
-		if err := h.svc.UpdateProject(ctx, userID, project); err != nil {
+		if err := h.svc.UpdateProject(ctx, userID, project, req.Reason); err != nil {
 			return util.WriteError(c, err)
 		}
 
@@ -203,6 +202,132 @@ import (
 		
 	}
 
+	func (h *ProjectHandler) ArchiveProject(c *fiber.Ctx) error {
+		return h.setArchived(c, true)
+	}
+
+	func (h *ProjectHandler) UnarchiveProject(c *fiber.Ctx) error {
+		return h.setArchived(c, false)
+	}
+
+	func (h *ProjectHandler) setArchived(c *fiber.Ctx, archived bool) error {
+		id, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid project ID format"})
+		}
+
+		userID := c.Locals("userID").(uuid.UUID)
+
+		ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+		defer cancel()
+
+		var project *model.Project
+		if archived {
+			project, err = h.svc.ArchiveProject(ctx, userID, id)
+		} else {
+			project, err = h.svc.UnarchiveProject(ctx, userID, id)
+		}
+		if err != nil {
+			return util.WriteError(c, err)
+		}
+
+		return c.JSON(toProjectResponse(project))
+	}
+
+	func (h *ProjectHandler) GetTrash(c *fiber.Ctx) error {
+		userID := c.Locals("userID").(uuid.UUID)
+
+		ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+		defer cancel()
+
+		projects, err := h.svc.GetTrash(ctx, userID)
+		if err != nil {
+			return util.WriteError(c, err)
+		}
+
+		resp := make([]dto.ProjectResponse, 0, len(projects))
+		for i := range projects {
+			resp = append(resp, toProjectResponse(&projects[i]))
+		}
+
+		return c.JSON(resp)
+	}
+
+	func (h *ProjectHandler) RestoreProject(c *fiber.Ctx) error {
+		id, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid project ID format"})
+		}
+
+		userID := c.Locals("userID").(uuid.UUID)
+
+		ctx, cancel := context.WithTimeout(c.Context(), 5*time.Second)
+		defer cancel()
+
+		project, err := h.svc.RestoreProject(ctx, userID, id)
+		if err != nil {
+			return util.WriteError(c, err)
+		}
+
+		return c.JSON(toProjectResponse(project))
+	}
+
+	func (h *ProjectHandler) GetProjectHistory(c *fiber.Ctx) error {
+		id, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid project ID format"})
+		}
+
+		userID := c.Locals("userID").(uuid.UUID)
+
+		ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+		defer cancel()
+
+		history, err := h.svc.GetHistory(ctx, userID, id)
+		if err != nil {
+			return util.WriteError(c, err)
+		}
+
+		resp := dto.ProjectHistoryResponse{
+			ProjectID: history.Project.ID.String(),
+			Slippage: dto.DeadlineSlippageResponse{
+				DeadlineChanges: history.Slippage.DeadlineChanges,
+				Postponements:   history.Slippage.Postponements,
+				TotalSlipDays:   history.Slippage.TotalSlipDays,
+				LastChangedAt:   util.FormatPtr(history.Slippage.LastChangedAt),
+			},
+			Revisions:    make([]dto.ProjectRevisionResponse, 0, len(history.Revisions)),
+			ScopeChanges: make([]dto.ScopeChangeResponse, 0, len(history.ScopeChanges)),
+		}
+
+		for i := range history.Revisions {
+			r := &history.Revisions[i]
+			resp.Revisions = append(resp.Revisions, dto.ProjectRevisionResponse{
+				Version:         r.Version,
+				Name:            r.Name,
+				Deadline:        util.FormatPtr(r.Deadline),
+				PrevName:        r.PrevName,
+				PrevDeadline:    util.FormatPtr(r.PrevDeadline),
+				DeadlineChanged: r.DeadlineChanged(),
+				Reason:          r.Reason,
+				ChangedBy:       r.ChangedBy.String(),
+				ChangedAt:       r.ChangedAt.Format(time.RFC3339),
+			})
+		}
+		for _, sc := range history.ScopeChanges {
+			resp.ScopeChanges = append(resp.ScopeChanges, dto.ScopeChangeResponse{
+				MilestoneID:    sc.MilestoneID.String(),
+				MilestoneName:  sc.MilestoneName,
+				Kind:           string(sc.Kind),
+				Weight:         sc.Weight,
+				EstimatedHours: sc.EstimatedHours,
+				ChangedAt:      sc.ChangedAt.Format(time.RFC3339),
+			})
+		}
+
+		return c.JSON(resp)
+	}
+
 	func (h *ProjectHandler) GetProjectLogs(c *fiber.Ctx){
 		
 	}
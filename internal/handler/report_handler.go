@@ -107,22 +107,24 @@ func (h *ReportHandler) GetReportsByProject(c *fiber.Ctx) error {
 	}
 
 
-	reports, err := h.svc.GetReportsByProject(ctx, projectIDParsed)
+	q, err := parseLegacyListQuery(c)
 	if err != nil {
 		return util.WriteError(c, err)
 	}
 
-	resp := make([]dto.ReportResponse, 0, len(reports))
-	for _, report := range reports {
-		resp = append(resp, dto.ReportResponse{
-			ID:         report.ID.String(),
-			ProjectID:  report.ProjectID.String(),
-			URLPDF:     report.URLPDF,
-			GeneratedAt: report.GeneratedAt.Format(time.RFC3339),
-		})
+	page, err := h.svc.GetReportsByProject(ctx, projectIDParsed, q)
+	if err != nil {
+		return util.WriteError(c, err)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(resp)
+	return writeList(c, page, func(report *model.Report) dto.ReportResponse {
+		return dto.ReportResponse{
+			ID:          report.ID.String(),
+			ProjectID:   report.ProjectID.String(),
+			URLPDF:      report.URLPDF,
+			GeneratedAt: report.GeneratedAt.Format(time.RFC3339),
+		}
+	})
 }
 
 
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ScheduleHandler struct {
+	svc *service.ScheduleService
+}
+
+func NewScheduleHandler(svc *service.ScheduleService) *ScheduleHandler {
+	return &ScheduleHandler{
+		svc: svc,
+	}
+}
+
+func (h *ScheduleHandler) GetProjectSchedule(c *fiber.Ctx) error {
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	schedule, err := h.svc.CalculateSchedule(ctx, userID, projectID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.ProjectScheduleResponse{
+		ProjectID:       schedule.ProjectID.String(),
+		Deadline:        util.FormatPtr(schedule.Deadline),
+		ProjectedFinish: schedule.ProjectedFinish.Format(time.RFC3339),
+		Feasible:        schedule.Feasible,
+		CriticalPath:    uuidsToStrings(schedule.CriticalPath),
+		Milestones:      make([]dto.MilestoneScheduleResponse, 0, len(schedule.Milestones)),
+	}
+
+	for _, ms := range schedule.Milestones {
+		resp.Milestones = append(resp.Milestones, dto.MilestoneScheduleResponse{
+			MilestoneID:    ms.Milestone.ID.String(),
+			Name:           ms.Milestone.Name,
+			Status:         ms.Milestone.Status,
+			DependsOn:      uuidsToStrings(ms.DependsOn),
+			DurationDays:   ms.DurationDays,
+			EarliestStart:  ms.EarliestStart.Format(time.RFC3339),
+			EarliestFinish: ms.EarliestFinish.Format(time.RFC3339),
+			LatestStart:    ms.LatestStart.Format(time.RFC3339),
+			LatestFinish:   ms.LatestFinish.Format(time.RFC3339),
+			SlackDays:      ms.SlackDays,
+			Critical:       ms.Critical,
+			Warnings:       ms.Warnings,
+		})
+	}
+
+	return c.JSON(resp)
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, id.String())
+	}
+	return res
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/repository"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SearchHandler struct {
+	svc *service.SearchService
+}
+
+func NewSearchHandler(svc *service.SearchService) *SearchHandler {
+	return &SearchHandler{svc: svc}
+}
+
+// Search: GET /search?q=&project_id=&from=&to=&type=log,milestone&limit=
+func (h *SearchHandler) Search(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	text := c.Query("q")
+	if strings.TrimSpace(text) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "q is required"})
+	}
+
+	opts := service.SearchOptions{Limit: c.QueryInt("limit")}
+
+	var err error
+	if opts.ProjectID, err = parseOptionalUUID(c.Query("project_id")); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID"})
+	}
+	if opts.From, err = parseListTime(c.Query("from"), false); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid from, use YYYY-MM-DD or RFC3339"})
+	}
+	if opts.To, err = parseListTime(c.Query("to"), true); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid to, use YYYY-MM-DD or RFC3339"})
+	}
+	if types := c.Query("type"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			opts.Kinds = append(opts.Kinds, repository.SearchKind(strings.TrimSpace(t)))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	hits, err := h.svc.Search(ctx, userID, text, opts)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.SearchResponse{Query: text, Data: make([]dto.SearchResultResponse, 0, len(hits))}
+	for _, hit := range hits {
+		resp.Data = append(resp.Data, dto.SearchResultResponse{
+			Type:        string(hit.Kind),
+			ID:          hit.ID.String(),
+			ProjectID:   hit.ProjectID.String(),
+			ProjectName: hit.ProjectName,
+			MilestoneID: util.UUIDPtrToStringPtr(hit.MilestoneID),
+			Snippet:     hit.Snippet,
+			Rank:        hit.Rank,
+			OccurredAt:  hit.OccurredAt,
+		})
+	}
+
+	return c.JSON(resp)
+}
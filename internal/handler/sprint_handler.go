@@ -0,0 +1,352 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SprintHandler struct {
+	svc *service.SprintService
+}
+
+func NewSprintHandler(svc *service.SprintService) *SprintHandler {
+	return &SprintHandler{
+		svc: svc,
+	}
+}
+
+func toSprintResponse(s *model.Sprint) dto.SprintResponse {
+	return dto.SprintResponse{
+		ID:          s.ID.String(),
+		ProjectID:   s.ProjectID.String(),
+		Name:        s.Name,
+		Goal:        s.Goal,
+		StartDate:   s.StartDate.Format(time.RFC3339),
+		EndDate:     s.EndDate.Format(time.RFC3339),
+		Status:      string(s.Status),
+		CompletedAt: util.FormatPtr(s.CompletedAt),
+		CreatedAt:   s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   s.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toSprintReportResponse(r *service.SprintReport) dto.SprintReportResponse {
+	resp := dto.SprintReportResponse{
+		Sprint:              toSprintResponse(&r.Sprint),
+		CommittedCount:      r.CommittedCount,
+		CompletedCount:      r.CompletedCount,
+		AddedMidSprint:      r.AddedMidSprint,
+		CarriedIn:           r.CarriedIn,
+		CarriedOver:         r.CarriedOver,
+		CommittedHours:      r.CommittedHours,
+		CompletedHours:      r.CompletedHours,
+		HoursLoggedInSprint: r.HoursLoggedInSprint,
+		Items:               make([]dto.SprintItemResponse, 0, len(r.Items)),
+	}
+	for _, it := range r.Items {
+		resp.Items = append(resp.Items, dto.SprintItemResponse{
+			ID:             it.Item.ID.String(),
+			MilestoneID:    util.UUIDPtrToStringPtr(it.Item.MilestoneID),
+			TaskID:         util.UUIDPtrToStringPtr(it.Item.TaskID),
+			Title:          it.Title,
+			Done:           it.Done,
+			Committed:      it.Committed,
+			Outcome:        string(it.Item.Outcome),
+			CarriedFromID:  util.UUIDPtrToStringPtr(it.Item.CarriedFromID),
+			EstimatedHours: it.EstimatedHours,
+			LoggedHours:    it.LoggedHours,
+			AddedAt:        it.Item.AddedAt.Format(time.RFC3339),
+		})
+	}
+	return resp
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, v := range values {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (h *SprintHandler) CreateSprint(c *fiber.Ctx) error {
+	var req dto.CreateSprintRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	start, err := time.Parse(time.RFC3339, req.StartDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid start date format"})
+	}
+
+	end, err := time.Parse(time.RFC3339, req.EndDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid end date format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sprint, err := h.svc.CreateSprint(ctx, userID, projectID, req.Name, req.Goal, start, end)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toSprintResponse(sprint))
+}
+
+func (h *SprintHandler) GetSprintsByProject(c *fiber.Ctx) error {
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sprints, err := h.svc.GetSprintsByProject(ctx, userID, projectID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.SprintResponse, 0, len(sprints))
+	for i := range sprints {
+		resp = append(resp, toSprintResponse(&sprints[i]))
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *SprintHandler) GetSprintByID(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sprint, err := h.svc.GetSprintByID(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toSprintResponse(sprint))
+}
+
+func (h *SprintHandler) UpdateSprint(c *fiber.Ctx) error {
+	var req dto.UpdateSprintRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sprint, err := h.svc.GetSprintByID(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	if req.Name != nil {
+		sprint.Name = *req.Name
+	}
+
+	if req.Goal != nil {
+		sprint.Goal = *req.Goal
+	}
+
+	if req.StartDate != nil {
+		t, err := time.Parse(time.RFC3339, *req.StartDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid start date format"})
+		}
+		sprint.StartDate = t
+	}
+
+	if req.EndDate != nil {
+		t, err := time.Parse(time.RFC3339, *req.EndDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid end date format"})
+		}
+		sprint.EndDate = t
+	}
+
+	if err := h.svc.UpdateSprint(ctx, userID, sprint); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toSprintResponse(sprint))
+}
+
+func (h *SprintHandler) DeleteSprint(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteSprint(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SprintHandler) StartSprint(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sprint, err := h.svc.StartSprint(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toSprintResponse(sprint))
+}
+
+func (h *SprintHandler) AssignItems(c *fiber.Ctx) error {
+	return h.changeItems(c, h.svc.AssignItems)
+}
+
+func (h *SprintHandler) UnassignItems(c *fiber.Ctx) error {
+	return h.changeItems(c, h.svc.UnassignItems)
+}
+
+func (h *SprintHandler) changeItems(c *fiber.Ctx, apply func(context.Context, uuid.UUID, uuid.UUID, []uuid.UUID, []uuid.UUID) error) error {
+	var req dto.SprintItemsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	milestoneIDs, err := parseUUIDs(req.MilestoneIDs)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	taskIDs, err := parseUUIDs(req.TaskIDs)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task ID format"})
+	}
+
+	if len(milestoneIDs) == 0 && len(taskIDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "milestone_ids or task_ids is required"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := apply(ctx, userID, id, milestoneIDs, taskIDs); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	report, err := h.svc.GetSprintReport(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toSprintReportResponse(report))
+}
+
+func (h *SprintHandler) CompleteSprint(c *fiber.Ctx) error {
+	var req dto.CompleteSprintRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+		}
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	var nextSprintID *uuid.UUID
+	if req.NextSprintID != nil && *req.NextSprintID != "" {
+		parsed, err := uuid.Parse(*req.NextSprintID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid next sprint ID format"})
+		}
+		nextSprintID = &parsed
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	report, err := h.svc.CompleteSprint(ctx, userID, id, nextSprintID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toSprintReportResponse(report))
+}
+
+func (h *SprintHandler) GetSprintReport(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid sprint ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	report, err := h.svc.GetSprintReport(ctx, userID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toSprintReportResponse(report))
+}
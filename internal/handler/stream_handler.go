@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/realtime"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	// komentar heartbeat menjaga koneksi tetap hidup di balik proxy dan mendeteksi klien yang putus
+	streamHeartbeat = 25 * time.Second
+	// interval pengecekan ulang akses selama stream terbuka
+	streamRefresh = time.Minute
+	streamRetryMs = 3000
+)
+
+type StreamHandler struct {
+	svc *service.StreamService
+}
+
+func NewStreamHandler(svc *service.StreamService) *StreamHandler {
+	return &StreamHandler{svc: svc}
+}
+
+func toStreamEventResponse(m *realtime.Message) dto.StreamEventResponse {
+	resp := dto.StreamEventResponse{
+		ID:        m.ID,
+		Type:      string(m.Type),
+		ProjectID: m.ProjectID.String(),
+		EntityID:  m.EntityID.String(),
+		At:        m.At.Format(time.RFC3339),
+	}
+	if m.ActorID != uuid.Nil {
+		resp.ActorID = util.UUIDPtrToStringPtr(&m.ActorID)
+	}
+	return resp
+}
+
+// StreamUser mengirim event semua project yang dimiliki atau disupervisi user.
+func (h *StreamHandler) StreamUser(c *fiber.Ctx) error {
+	return h.stream(c, nil)
+}
+
+// StreamProject mengirim event satu project untuk pemilik atau supervisor aktifnya.
+func (h *StreamHandler) StreamProject(c *fiber.Ctx) error {
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+	return h.stream(c, &projectID)
+}
+
+// stream membuka koneksi Server-Sent Events. Event yang terlewat sejak Last-Event-ID
+// (header, atau ?last_event_id=) dikirim ulang dari buffer; jika sudah tidak ada di buffer,
+// event "reset" dikirim agar klien memuat ulang datanya.
+func (h *StreamHandler) stream(c *fiber.Ctx, projectID *uuid.UUID) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	// disalin karena string dari fiber hanya valid selama handler berjalan
+	lastEventID := strings.Clone(c.Get("Last-Event-ID", c.Query("last_event_id")))
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	sub, replay, complete, err := h.svc.Open(ctx, userID, projectID, lastEventID)
+	cancel()
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// nginx tidak boleh menahan event di buffer
+	c.Set("X-Accel-Buffering", "no")
+
+	// writer berjalan setelah handler selesai, jadi c tidak boleh dipakai di dalamnya
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer h.svc.Close(sub)
+
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetryMs)
+		if !complete {
+			writeStreamEvent(w, "", "reset", fiber.Map{"last_event_id": lastEventID})
+		}
+		for i := range replay {
+			writeStreamMessage(w, &replay[i])
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(streamHeartbeat)
+		defer heartbeat.Stop()
+		refresh := time.NewTicker(streamRefresh)
+		defer refresh.Stop()
+
+		for {
+			select {
+			case msg, ok := <-sub.C:
+				if !ok {
+					// diputus hub karena terlalu lambat; klien reconnect dengan Last-Event-ID
+					return
+				}
+				writeStreamMessage(w, &msg)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+			case <-refresh.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+				err := h.svc.Refresh(ctx, sub, userID, projectID)
+				cancel()
+				if err != nil {
+					writeStreamEvent(w, "", "error", fiber.Map{"error": err.Error()})
+					w.Flush()
+					return
+				}
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
+
+func writeStreamMessage(w *bufio.Writer, m *realtime.Message) {
+	writeStreamEvent(w, m.ID, string(m.Type), toStreamEventResponse(m))
+}
+
+func writeStreamEvent(w *bufio.Writer, id, event string, data any) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	if id != "" {
+		fmt.Fprintf(w, "id: %s\n", id)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+}
@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// SupervisionHandler menyediakan endpoint read-only untuk pemilik dan supervisor project.
+type SupervisionHandler struct {
+	svc          *service.SupervisionService
+	milestoneSvc *service.MilestoneService
+	logSvc       *service.LogService
+	insightSvc   *service.AIInsightService
+}
+
+func NewSupervisionHandler(svc *service.SupervisionService, milestoneSvc *service.MilestoneService, logSvc *service.LogService, insightSvc *service.AIInsightService) *SupervisionHandler {
+	return &SupervisionHandler{
+		svc:          svc,
+		milestoneSvc: milestoneSvc,
+		logSvc:       logSvc,
+		insightSvc:   insightSvc,
+	}
+}
+
+func toSupervisorResponse(s *model.ProjectSupervisor) dto.SupervisorResponse {
+	return dto.SupervisorResponse{
+		ID:           s.ID.String(),
+		ProjectID:    s.ProjectID.String(),
+		Email:        s.Email,
+		SupervisorID: util.UUIDPtrToStringPtr(s.SupervisorID),
+		Status:       string(s.Status),
+		InvitedAt:    s.CreatedAt.Format(time.RFC3339),
+		RespondedAt:  util.FormatPtr(s.RespondedAt),
+	}
+}
+
+func (h *SupervisionHandler) InviteSupervisor(c *fiber.Ctx) error {
+	var req dto.InviteSupervisorRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	inv, err := h.svc.Invite(ctx, userID, projectID, req.Email)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toSupervisorResponse(inv))
+}
+
+func (h *SupervisionHandler) GetSupervisors(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sups, err := h.svc.ListSupervisors(ctx, userID, projectID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.SupervisorResponse, 0, len(sups))
+	for i := range sups {
+		resp = append(resp, toSupervisorResponse(&sups[i]))
+	}
+	return c.JSON(resp)
+}
+
+func (h *SupervisionHandler) RevokeSupervisor(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+	id, err := uuid.Parse(c.Params("supervisorID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid supervisor ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.Revoke(ctx, userID, projectID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SupervisionHandler) GetInvites(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	invites, err := h.svc.PendingInvites(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.SupervisorResponse, 0, len(invites))
+	for i := range invites {
+		resp = append(resp, toSupervisorResponse(&invites[i]))
+	}
+	return c.JSON(resp)
+}
+
+func (h *SupervisionHandler) AcceptInvite(c *fiber.Ctx) error {
+	return h.respondInvite(c, true)
+}
+
+func (h *SupervisionHandler) DeclineInvite(c *fiber.Ctx) error {
+	return h.respondInvite(c, false)
+}
+
+func (h *SupervisionHandler) respondInvite(c *fiber.Ctx, accept bool) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid invitation ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	inv, err := h.svc.RespondInvite(ctx, userID, id, accept)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toSupervisorResponse(inv))
+}
+
+// GetCohort mengembalikan semua project yang disupervisi beserta status insight terbarunya.
+func (h *SupervisionHandler) GetCohort(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 5*time.Second)
+	defer cancel()
+
+	cohort, err := h.svc.Cohort(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.CohortProjectResponse, 0, len(cohort))
+	for i := range cohort {
+		item := &cohort[i]
+		r := dto.CohortProjectResponse{
+			Project:             toProjectResponse(&item.Project),
+			OwnerName:           item.OwnerName,
+			OwnerEmail:          item.OwnerEmail,
+			LatestStatus:        "UNKNOWN",
+			MilestonesTotal:     item.MilestonesTotal,
+			MilestonesCompleted: item.MilestonesCompleted,
+		}
+		if item.LatestInsight != nil {
+			insight := toInsightResponse(item.LatestInsight)
+			r.LatestInsight = &insight
+			r.LatestStatus = string(item.LatestInsight.Status)
+		}
+		resp = append(resp, r)
+	}
+	return c.JSON(resp)
+}
+
+func (h *SupervisionHandler) GetProject(c *fiber.Ctx) error {
+	project, role, err := h.viewProject(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(dto.SupervisedProjectResponse{
+		Project: toProjectResponse(project),
+		Role:    string(role),
+	})
+}
+
+func (h *SupervisionHandler) GetMilestones(c *fiber.Ctx) error {
+	project, _, err := h.viewProject(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	milestones, err := h.milestoneSvc.GetMilestonesByProject(ctx, c.Locals("userID").(uuid.UUID), project.ID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := []dto.MilestoneResponse{}
+	for i := range milestones {
+		resp = append(resp, toMilestoneResponse(&milestones[i]))
+	}
+	return c.JSON(resp)
+}
+
+func (h *SupervisionHandler) GetLogs(c *fiber.Ctx) error {
+	project, _, err := h.viewProject(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	q, err := parseListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	page, err := h.logSvc.GetLogsByProject(ctx, c.Locals("userID").(uuid.UUID), project.ID, q)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toPageResponse(page, toLogResponse))
+}
+
+func (h *SupervisionHandler) GetInsights(c *fiber.Ctx) error {
+	project, _, err := h.viewProject(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	q, err := parseListQuery(c)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	page, err := h.insightSvc.GetInsightsByProject(ctx, project.ID, q)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toPageResponse(page, toInsightResponse))
+}
+
+// viewProject memastikan user pemilik atau supervisor aktif project :id.
+func (h *SupervisionHandler) viewProject(c *fiber.Ctx) (*model.Project, service.ProjectRole, error) {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return nil, "", util.ErrUnauthorized("unauthorized")
+	}
+
+	projectID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return nil, "", util.ErrBadRequest("invalid project ID format")
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	return h.svc.ViewProject(ctx, userID, projectID)
+}
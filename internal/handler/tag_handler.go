@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TagHandler struct {
+	svc *service.TagService
+}
+
+func NewTagHandler(svc *service.TagService) *TagHandler {
+	return &TagHandler{svc: svc}
+}
+
+func toTagResponse(t *model.Tag) dto.TagResponse {
+	return dto.TagResponse{
+		ID:        t.ID.String(),
+		Name:      t.Name,
+		Color:     t.Color,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (h *TagHandler) GetTags(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	tags, err := h.svc.ListTags(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.TagResponse, 0, len(tags))
+	for _, t := range tags {
+		resp = append(resp, toTagUsageResponse(t))
+	}
+	return c.JSON(resp)
+}
+
+func toTagUsageResponse(t repository.TagUsage) dto.TagResponse {
+	resp := toTagResponse(&t.Tag)
+	resp.LogCount = t.LogCount
+	resp.TotalMinutes = t.TotalMinutes
+	return resp
+}
+
+// GetCategories mengembalikan daftar kategori aktivitas log yang valid.
+func (h *TagHandler) GetCategories(c *fiber.Ctx) error {
+	return c.JSON(model.LogCategories)
+}
+
+func (h *TagHandler) CreateTag(c *fiber.Ctx) error {
+	var req dto.CreateTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	tag, err := h.svc.CreateTag(ctx, userID, req.Name, req.Color)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toTagResponse(tag))
+}
+
+func (h *TagHandler) UpdateTag(c *fiber.Ctx) error {
+	var req dto.UpdateTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid tag ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	tag, err := h.svc.UpdateTag(ctx, userID, id, req.Name, req.Color)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(toTagResponse(tag))
+}
+
+func (h *TagHandler) DeleteTag(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid tag ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteTag(ctx, userID, id); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TaskHandler struct {
+	svc *service.TaskService
+}
+
+func NewTaskHandler(svc *service.TaskService) *TaskHandler {
+	return &TaskHandler{
+		svc: svc,
+	}
+}
+
+func toTaskResponse(t *model.Task) dto.TaskResponse {
+	return dto.TaskResponse{
+		ID:          t.ID.String(),
+		MilestoneID: t.MilestoneID.String(),
+		Title:       t.Title,
+		Done:        t.Done,
+		AssigneeID:  util.UUIDPtrToStringPtr(t.AssigneeID),
+		OrderIdx:    t.OrderIdx,
+		CompletedAt: util.FormatPtr(t.CompletedAt),
+		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   t.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toTaskSummaryResponse(s *service.TaskSummary) dto.TaskSummaryResponse {
+	resp := dto.TaskSummaryResponse{
+		Total:           s.Total,
+		Done:            s.Done,
+		ProgressPercent: s.ProgressPercent(),
+		SuggestComplete: s.SuggestComplete,
+	}
+	if s.SuggestComplete {
+		resp.SuggestedAction = string(model.ActionComplete)
+	}
+	return resp
+}
+
+func (h *TaskHandler) CreateTask(c *fiber.Ctx) error {
+	var req dto.CreateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	milestoneID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	var assigneeID *uuid.UUID
+	if req.AssigneeID != nil && *req.AssigneeID != "" {
+		parsed, err := uuid.Parse(*req.AssigneeID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid assignee ID format"})
+		}
+		assigneeID = &parsed
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	task, err := h.svc.CreateTask(ctx, userID, milestoneID, req.Title, assigneeID, req.OrderIdx)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toTaskResponse(task))
+}
+
+func (h *TaskHandler) GetTasksByMilestone(c *fiber.Ctx) error {
+	milestoneID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	tasks, summary, err := h.svc.GetTasksByMilestone(ctx, userID, milestoneID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := dto.TaskListResponse{
+		Tasks:   make([]dto.TaskResponse, 0, len(tasks)),
+		Summary: toTaskSummaryResponse(summary),
+	}
+	for i := range tasks {
+		resp.Tasks = append(resp.Tasks, toTaskResponse(&tasks[i]))
+	}
+
+	return c.JSON(resp)
+}
+
+func (h *TaskHandler) UpdateTask(c *fiber.Ctx) error {
+	var req dto.UpdateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	milestoneID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	taskID, err := uuid.Parse(c.Params("taskID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	task, err := h.svc.GetTask(ctx, userID, milestoneID, taskID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	if req.Title != nil {
+		task.Title = *req.Title
+	}
+
+	if req.Done != nil {
+		task.Done = *req.Done
+	}
+
+	if req.OrderIdx != nil {
+		task.OrderIdx = *req.OrderIdx
+	}
+
+	if req.AssigneeID != nil {
+		if *req.AssigneeID == "" {
+			task.AssigneeID = nil
+		} else {
+			parsed, err := uuid.Parse(*req.AssigneeID)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid assignee ID format"})
+			}
+			task.AssigneeID = &parsed
+		}
+	}
+
+	summary, err := h.svc.UpdateTask(ctx, userID, task)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(dto.TaskUpdateResponse{
+		Task:    toTaskResponse(task),
+		Summary: toTaskSummaryResponse(summary),
+	})
+}
+
+func (h *TaskHandler) DeleteTask(c *fiber.Ctx) error {
+	milestoneID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+	}
+
+	taskID, err := uuid.Parse(c.Params("taskID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task ID format"})
+	}
+
+	userID := c.Locals("userID").(uuid.UUID)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	if err := h.svc.DeleteTask(ctx, userID, milestoneID, taskID); err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TimerHandler struct {
+	svc *service.TimerService
+}
+
+func NewTimerHandler(svc *service.TimerService) *TimerHandler {
+	return &TimerHandler{
+		svc: svc,
+	}
+}
+
+func toTimerResponse(t *model.Timer) *dto.TimerResponse {
+	if t == nil {
+		return nil
+	}
+	return &dto.TimerResponse{
+		ID:             t.ID.String(),
+		ProjectID:      t.ProjectID.String(),
+		MilestoneID:    util.UUIDPtrToStringPtr(t.MilestoneID),
+		TaskID:         util.UUIDPtrToStringPtr(t.TaskID),
+		Description:    t.Description,
+		StartedAt:      t.StartedAt.Format(time.RFC3339),
+		ElapsedMinutes: int(time.Since(t.StartedAt).Minutes()),
+	}
+}
+
+func (h *TimerHandler) StartTimer(c *fiber.Ctx) error {
+	var req dto.StartTimerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(req.ProjectID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	var milestoneID *uuid.UUID
+	if req.MilestoneID != nil && *req.MilestoneID != "" {
+		parsed, err := uuid.Parse(*req.MilestoneID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid milestone ID format"})
+		}
+		milestoneID = &parsed
+	}
+
+	var taskID *uuid.UUID
+	if req.TaskID != nil && *req.TaskID != "" {
+		parsed, err := uuid.Parse(*req.TaskID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task ID format"})
+		}
+		taskID = &parsed
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	timer, err := h.svc.StartTimer(ctx, userID, projectID, milestoneID, taskID, req.Description)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toTimerResponse(timer))
+}
+
+func (h *TimerHandler) GetRunningTimer(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	timer, err := h.svc.GetRunningTimer(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	if timer == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "no running timer"})
+	}
+
+	return c.JSON(toTimerResponse(timer))
+}
+
+func (h *TimerHandler) StopTimer(c *fiber.Ctx) error {
+	var req dto.StopTimerRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+		}
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	log, err := h.svc.StopTimer(ctx, userID, req.Description)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	return c.JSON(dto.LogResponse{
+		ID:              log.ID.String(),
+		ProjectID:       log.ProjectID.String(),
+		MilestoneID:     util.UUIDPtrToStringPtr(log.MilestoneID),
+		TaskID:          util.UUIDPtrToStringPtr(log.TaskID),
+		UserID:          log.UserID.String(),
+		Description:     log.Description,
+		DurationMinutes: log.DurationMinutes,
+		LoggedAt:        log.LoggedAt.Format(time.RFC3339),
+		CreatedAt:       log.CreatedAt.Format(time.RFC3339),
+	})
+}
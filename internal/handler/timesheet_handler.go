@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/dto"
+	"devtracker/internal/domain/model"
+	"devtracker/internal/service"
+	"devtracker/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TimesheetHandler struct {
+	svc *service.TimesheetService
+}
+
+func NewTimesheetHandler(svc *service.TimesheetService) *TimesheetHandler {
+	return &TimesheetHandler{svc: svc}
+}
+
+func toTimesheetResponse(t *model.Timesheet) dto.TimesheetResponse {
+	return dto.TimesheetResponse{
+		ID:           t.ID.String(),
+		ProjectID:    t.ProjectID.String(),
+		UserID:       t.UserID.String(),
+		WeekStart:    t.WeekStart.Format(chartDateFormat),
+		Status:       string(t.Status),
+		LogCount:     t.LogCount,
+		TotalMinutes: t.TotalMinutes,
+		SubmittedAt:  t.SubmittedAt.Format(time.RFC3339),
+		ReviewedBy:   util.UUIDPtrToStringPtr(t.ReviewedBy),
+		ReviewedAt:   util.FormatPtr(t.ReviewedAt),
+	}
+}
+
+func toTimesheetCommentResponse(c *model.TimesheetComment) dto.TimesheetCommentResponse {
+	return dto.TimesheetCommentResponse{
+		ID:        c.ID.String(),
+		AuthorID:  c.AuthorID.String(),
+		Action:    string(c.Action),
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toTimesheetWeekResponse(w *service.TimesheetWeek) dto.TimesheetWeekResponse {
+	resp := dto.TimesheetWeekResponse{
+		ProjectID:    w.ProjectID.String(),
+		UserID:       w.UserID.String(),
+		WeekStart:    w.WeekStart.Format(chartDateFormat),
+		WeekEnd:      w.WeekStart.AddDate(0, 0, 6).Format(chartDateFormat),
+		Status:       "open",
+		DailyMinutes: w.DailyMinutes[:],
+		TotalMinutes: w.TotalMinutes,
+		Logs:         make([]dto.LogResponse, 0, len(w.Logs)),
+		Comments:     make([]dto.TimesheetCommentResponse, 0, len(w.Comments)),
+	}
+	if w.Timesheet != nil {
+		ts := toTimesheetResponse(w.Timesheet)
+		resp.Timesheet = &ts
+		resp.Status = ts.Status
+	}
+	for i := range w.Logs {
+		resp.Logs = append(resp.Logs, toLogResponse(&w.Logs[i]))
+	}
+	for i := range w.Comments {
+		resp.Comments = append(resp.Comments, toTimesheetCommentResponse(&w.Comments[i]))
+	}
+	return resp
+}
+
+func (h *TimesheetHandler) GetMyTimesheets(c *fiber.Ctx) error {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	sheets, err := h.svc.ListMine(ctx, userID)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.TimesheetResponse, 0, len(sheets))
+	for i := range sheets {
+		resp = append(resp, toTimesheetResponse(&sheets[i]))
+	}
+	return c.JSON(resp)
+}
+
+// GetWeek: ?project_id=&date=YYYY-MM-DD (default hari ini) &user_id= (default user sendiri).
+func (h *TimesheetHandler) GetWeek(c *fiber.Ctx) error {
+	viewerID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(c.Query("project_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	userID := viewerID
+	if v := c.Query("user_id"); v != "" {
+		userID, err = uuid.Parse(v)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user ID format"})
+		}
+	}
+
+	date := time.Now()
+	if v := c.Query("date"); v != "" {
+		date, err = time.Parse(chartDateFormat, v)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "date must be YYYY-MM-DD"})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	week, err := h.svc.GetWeek(ctx, viewerID, projectID, userID, date)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toTimesheetWeekResponse(week))
+}
+
+func (h *TimesheetHandler) GetTimesheet(c *fiber.Ctx) error {
+	viewerID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid timesheet ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	week, err := h.svc.GetTimesheet(ctx, viewerID, id)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toTimesheetWeekResponse(week))
+}
+
+func (h *TimesheetHandler) SubmitTimesheet(c *fiber.Ctx) error {
+	var req dto.SubmitTimesheetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	projectID, err := uuid.Parse(req.ProjectID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid project ID format"})
+	}
+
+	week, err := time.Parse(chartDateFormat, req.Week)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "week must be YYYY-MM-DD"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	ts, err := h.svc.Submit(ctx, userID, projectID, week, req.Comment)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toTimesheetResponse(ts))
+}
+
+func (h *TimesheetHandler) ApproveTimesheet(c *fiber.Ctx) error {
+	return h.review(c, h.svc.Approve)
+}
+
+func (h *TimesheetHandler) RejectTimesheet(c *fiber.Ctx) error {
+	return h.review(c, h.svc.Reject)
+}
+
+func (h *TimesheetHandler) review(c *fiber.Ctx, action func(ctx context.Context, reviewerID, id uuid.UUID, comment string) (*model.Timesheet, error)) error {
+	var req dto.ReviewTimesheetRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+		}
+	}
+
+	reviewerID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid timesheet ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	ts, err := action(ctx, reviewerID, id, req.Comment)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.JSON(toTimesheetResponse(ts))
+}
+
+func (h *TimesheetHandler) AddComment(c *fiber.Ctx) error {
+	var req dto.TimesheetCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid timesheet ID format"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	comment, err := h.svc.AddComment(ctx, userID, id, req.Body)
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(toTimesheetCommentResponse(comment))
+}
+
+// GetInbox mengembalikan timesheet yang menunggu review; ?status= untuk riwayat keputusan.
+func (h *TimesheetHandler) GetInbox(c *fiber.Ctx) error {
+	reviewerID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 3*time.Second)
+	defer cancel()
+
+	items, err := h.svc.Inbox(ctx, reviewerID, model.TimesheetStatus(c.Query("status")))
+	if err != nil {
+		return util.WriteError(c, err)
+	}
+
+	resp := make([]dto.TimesheetResponse, 0, len(items))
+	for i := range items {
+		r := toTimesheetResponse(&items[i].Timesheet)
+		r.UserName = items[i].UserName
+		r.ProjectName = items[i].ProjectName
+		resp = append(resp, r)
+	}
+	return c.JSON(resp)
+}
@@ -121,6 +121,7 @@ func (h *UserHandler) GetCurrentUser(c *fiber.Ctx) error{
 		ID:    userID.String(),
 		Name:  user.Name,
 		Email: user.Email,
+		Timezone: user.Timezone,
 	})
 }
 
@@ -140,7 +141,7 @@ func (h *UserHandler) UpdateProfile(c *fiber.Ctx) error {
     defer cancel()
 
     // Call service with only necessary data
-    user, err := h.svc.UpdateProfile(ctx, userID, req.Name)
+    user, err := h.svc.UpdateProfile(ctx, userID, req.Name, req.Timezone)
     if err != nil {
         return util.WriteError(c, err)
     }
@@ -151,6 +152,7 @@ func (h *UserHandler) UpdateProfile(c *fiber.Ctx) error {
             "id":    user.ID,
             "name":  user.Name,
             "email": user.Email,
+            "timezone": user.Timezone,
         },
     })
 }
@@ -14,6 +14,11 @@ func JWTProtected() fiber.Handler{
 	return func(c *fiber.Ctx) error{
 		authHeader := c.Get("Authorization")
 
+		// EventSource di browser tidak bisa mengirim header, jadi stream SSE boleh memakai ?access_token=
+		if authHeader == "" && c.Get(fiber.HeaderAccept) == "text/event-stream" && c.Query("access_token") != "" {
+			authHeader = "Bearer " + c.Query("access_token")
+		}
+
 		if authHeader == "" {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"error": "invalid Authorization",
@@ -0,0 +1,186 @@
+package realtime
+
+import (
+	"sync"
+	"time"
+
+	"devtracker/internal/events"
+
+	"github.com/google/uuid"
+)
+
+// StreamTypes adalah event domain yang diteruskan ke klien realtime.
+var StreamTypes = []events.Type{
+	events.LogCreated, events.LogUpdated, events.LogDeleted,
+	events.MilestoneCreated, events.MilestoneUpdated, events.MilestoneDeleted,
+	events.InsightCreated, events.InsightUpdated,
+	events.ReportCreated, events.ReportUpdated, events.ReportDeleted,
+}
+
+// subscriberBuffer adalah jumlah event yang boleh antre per koneksi sebelum koneksi diputus.
+const subscriberBuffer = 64
+
+// Message adalah event yang dikirim ke klien. ID unik lintas instance dan dipakai
+// klien sebagai Last-Event-ID saat reconnect.
+type Message struct {
+	ID        string      `json:"id"`
+	Type      events.Type `json:"type"`
+	ProjectID uuid.UUID   `json:"project_id"`
+	EntityID  uuid.UUID   `json:"entity_id"`
+	ActorID   uuid.UUID   `json:"actor_id"`
+	At        time.Time   `json:"at"`
+}
+
+// Filter menentukan apakah sebuah subscriber menerima message. Filter dipanggil
+// sambil memegang lock hub, jadi harus cepat dan tidak boleh memanggil hub.
+type Filter func(Message) bool
+
+// Relay meneruskan event lokal ke instance API lain.
+type Relay interface {
+	Publish(msg Message)
+}
+
+// Subscription adalah satu koneksi stream. C ditutup jika koneksi terlalu lambat
+// membaca; klien diharapkan reconnect dengan Last-Event-ID.
+type Subscription struct {
+	C      <-chan Message
+	ch     chan Message
+	filter Filter
+}
+
+// Hub membagikan event ke subscriber lokal dan menyimpan buffer pendek untuk replay.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	buffer []Message // terurut dari yang terlama
+	size   int
+	maxAge time.Duration
+	relay  Relay
+}
+
+// NewHub membuat hub dengan buffer replay maksimal size event dan maxAge umur event.
+// relay boleh nil untuk deployment satu instance.
+func NewHub(relay Relay, size int, maxAge time.Duration) *Hub {
+	return &Hub{
+		subs:   make(map[*Subscription]struct{}),
+		buffer: make([]Message, 0, size),
+		size:   size,
+		maxAge: maxAge,
+		relay:  relay,
+	}
+}
+
+// Subscribe mendaftarkan hub ke bus untuk semua StreamTypes.
+func (h *Hub) Subscribe(bus *events.Bus) {
+	bus.Subscribe(h.publish, StreamTypes...)
+}
+
+func (h *Hub) publish(e events.Event) {
+	// UUIDv7 terurut waktu sehingga ID mudah dibaca saat debugging
+	id, err := uuid.NewV7()
+	if err != nil {
+		id = uuid.New()
+	}
+
+	msg := Message{
+		ID:        id.String(),
+		Type:      e.Type,
+		ProjectID: e.ProjectID,
+		EntityID:  e.EntityID,
+		ActorID:   e.UserID,
+		At:        e.At,
+	}
+	h.Deliver(msg)
+	if h.relay != nil {
+		h.relay.Publish(msg)
+	}
+}
+
+// Deliver menyimpan message ke buffer dan mengirimkannya ke subscriber yang cocok.
+// Dipanggil untuk event lokal maupun event dari instance lain.
+func (h *Hub) Deliver(msg Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.buffer = append(h.buffer, msg)
+	h.trim(time.Now())
+
+	for sub := range h.subs {
+		if !sub.filter(msg) {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+		default:
+			// subscriber lambat diputus daripada menahan publisher
+			delete(h.subs, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// Listen mendaftarkan subscriber baru. Jika lastEventID diisi, message setelahnya yang
+// masih ada di buffer dikembalikan sebagai replay. complete bernilai false jika lastEventID
+// sudah tidak ada di buffer, sehingga klien perlu memuat ulang datanya.
+func (h *Hub) Listen(filter Filter, lastEventID string) (sub *Subscription, replay []Message, complete bool) {
+	ch := make(chan Message, subscriberBuffer)
+	sub = &Subscription{C: ch, ch: ch, filter: filter}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.trim(time.Now())
+	if lastEventID == "" {
+		// koneksi baru memuat data sendiri, jadi tidak perlu replay
+		h.subs[sub] = struct{}{}
+		return sub, nil, true
+	}
+	for _, msg := range h.buffer {
+		if complete {
+			if filter(msg) {
+				replay = append(replay, msg)
+			}
+		} else if msg.ID == lastEventID {
+			complete = true
+		}
+	}
+	if !complete {
+		replay = nil
+	}
+
+	// didaftarkan di bawah lock yang sama supaya tidak ada event yang terlewat antara replay dan live
+	h.subs[sub] = struct{}{}
+	return sub, replay, complete
+}
+
+// SetFilter mengganti filter subscriber, misalnya setelah akses project berubah.
+func (h *Hub) SetFilter(sub *Subscription, filter Filter) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub.filter = filter
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// trim membuang message yang melebihi kapasitas atau umur buffer. Caller memegang lock.
+func (h *Hub) trim(now time.Time) {
+	drop := len(h.buffer) - h.size
+	if drop < 0 {
+		drop = 0
+	}
+	for drop < len(h.buffer) && now.Sub(h.buffer[drop].At) > h.maxAge {
+		drop++
+	}
+	if drop > 0 {
+		n := copy(h.buffer, h.buffer[drop:])
+		h.buffer = h.buffer[:n]
+	}
+}
@@ -0,0 +1,113 @@
+package realtime
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func all(Message) bool { return true }
+
+func messageIDs(msgs []Message) []string {
+	ids := make([]string, len(msgs))
+	for i, m := range msgs {
+		ids[i] = m.ID
+	}
+	return ids
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHubListenReplay(t *testing.T) {
+	now := time.Now()
+	projectA, projectB := uuid.New(), uuid.New()
+
+	h := NewHub(nil, 10, time.Hour)
+	for i, project := range []uuid.UUID{projectA, projectB, projectA, projectA} {
+		h.Deliver(Message{ID: strconv.Itoa(i + 1), ProjectID: project, At: now})
+	}
+	onlyA := func(m Message) bool { return m.ProjectID == projectA }
+
+	tests := []struct {
+		name         string
+		filter       Filter
+		lastEventID  string
+		wantReplay   []string
+		wantComplete bool
+	}{
+		{"no last event id", all, "", nil, true},
+		{"replays after last event", all, "2", []string{"3", "4"}, true},
+		{"last event is newest", all, "4", nil, true},
+		{"replay respects filter", onlyA, "1", []string{"3", "4"}, true},
+		{"unknown id is incomplete", all, "missing", nil, false},
+	}
+
+	for _, tt := range tests {
+		sub, replay, complete := h.Listen(tt.filter, tt.lastEventID)
+		if !equalIDs(messageIDs(replay), tt.wantReplay) || complete != tt.wantComplete {
+			t.Errorf("%s: Listen() = (%v, %v), want (%v, %v)", tt.name, messageIDs(replay), complete, tt.wantReplay, tt.wantComplete)
+		}
+		h.Unsubscribe(sub)
+	}
+}
+
+func TestHubTrim(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		size       int
+		ages       []time.Duration // umur tiap message, dari yang terlama
+		lastID     string
+		wantReplay []string
+		complete   bool
+	}{
+		{"within limits", 5, []time.Duration{3 * time.Minute, 2 * time.Minute, time.Minute}, "1", []string{"2", "3"}, true},
+		{"oldest dropped by size", 2, []time.Duration{0, 0, 0}, "1", nil, false},
+		{"size keeps newest", 2, []time.Duration{0, 0, 0}, "2", []string{"3"}, true},
+		{"expired dropped by age", 5, []time.Duration{20 * time.Minute, 12 * time.Minute, time.Minute}, "2", nil, false},
+		{"unexpired survives age trim", 5, []time.Duration{20 * time.Minute, 5 * time.Minute, time.Minute}, "2", []string{"3"}, true},
+	}
+
+	for _, tt := range tests {
+		h := NewHub(nil, tt.size, 10*time.Minute)
+		for i, age := range tt.ages {
+			h.Deliver(Message{ID: strconv.Itoa(i + 1), At: now.Add(-age)})
+		}
+
+		sub, replay, complete := h.Listen(all, tt.lastID)
+		if !equalIDs(messageIDs(replay), tt.wantReplay) || complete != tt.complete {
+			t.Errorf("%s: Listen() = (%v, %v), want (%v, %v)", tt.name, messageIDs(replay), complete, tt.wantReplay, tt.complete)
+		}
+		h.Unsubscribe(sub)
+	}
+}
+
+func TestHubDeliverDropsSlowSubscriber(t *testing.T) {
+	h := NewHub(nil, subscriberBuffer*2, time.Hour)
+	sub, _, _ := h.Listen(all, "")
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		h.Deliver(Message{ID: strconv.Itoa(i), At: time.Now()})
+	}
+
+	n := 0
+	for range sub.C {
+		n++
+	}
+	if n != subscriberBuffer {
+		t.Errorf("received %d messages before close, want %d", n, subscriberBuffer)
+	}
+}
@@ -0,0 +1,143 @@
+package realtime
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+const (
+	pgChannel        = "devtracker_events"
+	pgRelayQueue     = 256
+	pgReconnectDelay = 5 * time.Second
+)
+
+type envelope struct {
+	Origin  string  `json:"origin"`
+	Message Message `json:"message"`
+}
+
+// PGRelay menyebarkan event antar instance API lewat LISTEN/NOTIFY Postgres, jadi
+// tidak perlu broker tambahan. Event yang dikirim saat listener terputus tidak diulang.
+type PGRelay struct {
+	db     *gorm.DB
+	origin string
+	queue  chan Message
+}
+
+func NewPGRelay(db *gorm.DB) *PGRelay {
+	return &PGRelay{
+		db:     db,
+		origin: uuid.NewString(),
+		queue:  make(chan Message, pgRelayQueue),
+	}
+}
+
+// Publish mengantrikan message untuk NOTIFY tanpa menahan request yang memicu event.
+func (r *PGRelay) Publish(msg Message) {
+	select {
+	case r.queue <- msg:
+	default:
+		log.Printf("realtime relay queue full, dropping %s %s", msg.Type, msg.ID)
+	}
+}
+
+// Run mengirim event lokal lewat NOTIFY dan meneruskan event dari instance lain ke
+// deliver sampai ctx dibatalkan. Listener tersambung ulang otomatis jika koneksi putus.
+func (r *PGRelay) Run(ctx context.Context, deliver func(Message)) {
+	go r.notifyLoop(ctx)
+
+	for {
+		err := r.listen(ctx, deliver)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("realtime listener stopped: %v; reconnecting in %s", err, pgReconnectDelay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pgReconnectDelay):
+		}
+	}
+}
+
+func (r *PGRelay) notifyLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-r.queue:
+			payload, err := json.Marshal(envelope{Origin: r.origin, Message: msg})
+			if err != nil {
+				log.Printf("realtime relay encode failed: %v", err)
+				continue
+			}
+
+			nctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			err = r.db.WithContext(nctx).Exec("SELECT pg_notify(?, ?)", pgChannel, string(payload)).Error
+			cancel()
+			if err != nil {
+				log.Printf("realtime relay notify failed: %v", err)
+			}
+		}
+	}
+}
+
+// listen memakai satu koneksi khusus dari pool selama LISTEN aktif. Koneksi itu selalu
+// dibuang setelahnya agar tidak kembali ke pool dalam keadaan LISTEN.
+func (r *PGRelay) listen(ctx context.Context, deliver func(Message)) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var listenErr error
+	conn.Raw(func(driverConn any) error {
+		pc, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			listenErr = fmt.Errorf("unexpected driver connection %T", driverConn)
+			return driver.ErrBadConn
+		}
+		listenErr = r.wait(ctx, pc, deliver)
+		return driver.ErrBadConn
+	})
+	return listenErr
+}
+
+func (r *PGRelay) wait(ctx context.Context, pc *stdlib.Conn, deliver func(Message)) error {
+	c := pc.Conn()
+	if _, err := c.Exec(ctx, "LISTEN "+pgChannel); err != nil {
+		return err
+	}
+
+	for {
+		n, err := c.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var env envelope
+		if err := json.Unmarshal([]byte(n.Payload), &env); err != nil {
+			log.Printf("realtime relay decode failed: %v", err)
+			continue
+		}
+		// event instance sendiri sudah dikirim langsung oleh hub
+		if env.Origin == r.origin {
+			continue
+		}
+		deliver(env.Message)
+	}
+}
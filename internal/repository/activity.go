@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ActivityType string
+
+const (
+	ActivityLogCreated       ActivityType = "log_created"
+	ActivityMilestoneStatus  ActivityType = "milestone_status_changed"
+	ActivityInsightGenerated ActivityType = "insight_generated"
+	ActivityReportGenerated  ActivityType = "report_generated"
+)
+
+// Activity adalah satu baris feed aktivitas project, dirangkai dari logs,
+// milestone_status_histories, ai_insights dan reports. ID adalah id baris sumbernya.
+type Activity struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Type        ActivityType
+	ProjectID   uuid.UUID
+	OccurredAt  time.Time
+	ActorID     *uuid.UUID // kosong untuk aktivitas sistem (insight, report)
+	MilestoneID *uuid.UUID
+	Title       string // deskripsi log, nama milestone, tipe insight atau URL report
+	Minutes     *int   // durasi log
+	FromStatus  string
+	ToStatus    string // status milestone baru atau status insight
+}
+
+type ActivityRepository interface {
+	// List mengambil satu halaman feed project terurut OccurredAt; filter Type, UserID (aktor),
+	// MilestoneID dan rentang OccurredAt. Sort lain tidak didukung.
+	List(ctx context.Context, projectID uuid.UUID, q ListQuery) (*Page[Activity], error)
+}
@@ -13,6 +13,12 @@ type AIInsightRepository interface {
 	Create(ctx context.Context, insight *model.AIInsight) error
 	FindByID(ctx context.Context, id uuid.UUID) (*model.AIInsight, error)
 	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.AIInsight, error)
+	// List mengambil satu halaman insight; filter ProjectID, Type, Status dan rentang GeneratedAt.
+	List(ctx context.Context, q ListQuery) (*Page[model.AIInsight], error)
+	// FindLatestByProjects mengembalikan insight terbaru untuk setiap project
+	FindLatestByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]model.AIInsight, error)
+	// FindLatestBefore mengembalikan insight terakhir project sebelum waktu tersebut (nil jika tidak ada).
+	FindLatestBefore(ctx context.Context, projectID uuid.UUID, before time.Time) (*model.AIInsight, error)
 	FindByProjectAndTypeAndDate(ctx context.Context, projectID uuid.UUID, insightType model.InsightType, date time.Time) (*model.AIInsight, error)
 	Update(ctx context.Context, insight *model.AIInsight) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type ClientRepository interface {
+	Create(ctx context.Context, c *model.Client) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Client, error)
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Client, error)
+	Update(ctx context.Context, c *model.Client) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type RateRepository interface {
+	Create(ctx context.Context, r *model.HourlyRate) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.HourlyRate, error)
+	// FindByOwner mengembalikan semua rate milik owner, terbaru dulu per EffectiveFrom.
+	FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]model.HourlyRate, error)
+	// FindForProject mengembalikan rate khusus project beserta rate umum milik owner.
+	FindForProject(ctx context.Context, ownerID, projectID uuid.UUID) ([]model.HourlyRate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type InvoiceRepository interface {
+	// Create menyimpan invoice beserta Lines.
+	Create(ctx context.Context, inv *model.Invoice) error
+	// FindByID mengisi Lines terurut Position.
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Invoice, error)
+	FindByUser(ctx context.Context, userID uuid.UUID, status model.InvoiceStatus) ([]model.Invoice, error)
+	Update(ctx context.Context, inv *model.Invoice) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ExistsForClient(ctx context.Context, clientID uuid.UUID) (bool, error)
+	// LatestNumber mengembalikan nomor invoice terbesar milik user dengan prefix tersebut ("" jika belum ada).
+	LatestNumber(ctx context.Context, userID uuid.UUID, prefix string) (string, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type BoardLimitRepository interface {
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.BoardColumnLimit, error)
+	Replace(ctx context.Context, projectID uuid.UUID, limits []model.BoardColumnLimit) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type CalendarRepository interface {
+	FindCalendar(ctx context.Context, userID uuid.UUID) (*model.WorkCalendar, error)
+	SaveCalendar(ctx context.Context, c *model.WorkCalendar) error
+
+	FindHolidays(ctx context.Context, userID uuid.UUID) ([]model.Holiday, error)
+	// CreateHolidays melewati tanggal yang sudah ada dan mengembalikan jumlah baris baru.
+	CreateHolidays(ctx context.Context, holidays []model.Holiday) (int64, error)
+	DeleteHoliday(ctx context.Context, userID, id uuid.UUID) error
+
+	FindTimeOff(ctx context.Context, userID uuid.UUID) ([]model.TimeOff, error)
+	CreateTimeOff(ctx context.Context, t *model.TimeOff) error
+	DeleteTimeOff(ctx context.Context, userID, id uuid.UUID) error
+}
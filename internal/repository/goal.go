@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type GoalRepository interface {
+	Create(ctx context.Context, g *model.Goal) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Goal, error)
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Goal, error)
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Goal, error)
+	Update(ctx context.Context, g *model.Goal) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
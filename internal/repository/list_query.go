@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// ErrInvalidCursor dikembalikan jika cursor rusak atau tidak cocok dengan sort yang diminta.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrInvalidSort dikembalikan jika field sort tidak didukung oleh entity.
+var ErrInvalidSort = errors.New("invalid sort field")
+
+// ListQuery adalah spesifikasi bersama untuk endpoint list: keyset cursor, limit,
+// sort dan filter. Filter yang tidak relevan untuk suatu entity diabaikan.
+type ListQuery struct {
+	Limit  int
+	Cursor string // opaque, dari Page.NextCursor sebelumnya
+	Sort   string // kosong = sort default entity
+	Asc    bool   // default descending (terbaru dulu)
+
+	// From/To memfilter kolom tanggal utama entity (LoggedAt untuk log), [From, To)
+	From *time.Time
+	To   *time.Time
+
+	ProjectID   *uuid.UUID
+	MilestoneID *uuid.UUID
+	UserID      *uuid.UUID
+	Status      string
+	Type        string
+}
+
+// Page adalah satu halaman hasil list; NextCursor kosong berarti tidak ada halaman berikutnya.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+}
+
+// PageLimit menormalkan limit ke rentang [1, MaxListLimit].
+func (q ListQuery) PageLimit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultListLimit
+	case q.Limit > MaxListLimit:
+		return MaxListLimit
+	}
+	return q.Limit
+}
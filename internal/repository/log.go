@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"devtracker/internal/domain/model"
 
@@ -9,11 +10,34 @@ import (
 )
 
 
+// UserLastLog adalah waktu log terakhir user yang masih punya project aktif. Untuk user
+// yang belum pernah mencatat log, LastLoggedAt adalah waktu project aktif pertamanya dibuat.
+type UserLastLog struct {
+	UserID       uuid.UUID
+	LastLoggedAt time.Time
+}
+
 type LogRepository interface {
 	Create(ctx context.Context, log *model.Log) error
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Log, error)
+	// FindByIDForUpdate membaca log dengan SELECT ... FOR UPDATE; dipanggil di dalam UnitOfWork.
+	FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Log, error)
 	FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Log, error)
+	// FindByUserBetween mengambil log dengan LoggedAt di [from, to)
+	FindByUserBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Log, error)
 	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Log, error)
+	// List mengambil satu halaman log; filter ProjectID/UserID/MilestoneID dan rentang LoggedAt.
+	List(ctx context.Context, q ListQuery) (*Page[model.Log], error)
+	FindRecentByUser(ctx context.Context, userID uuid.UUID, limit int) ([]model.Log, error)
+	// FindLastLogByActiveUsers dipakai pengingat "belum ada log selama N hari".
+	FindLastLogByActiveUsers(ctx context.Context) ([]UserLastLog, error)
+	// FindUninvoiced mengambil log billable project yang belum masuk invoice, LoggedAt di [from, to).
+	// Baris dikunci FOR UPDATE sampai transaksi UnitOfWork selesai.
+	FindUninvoiced(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]model.Log, error)
+	// LockToInvoice menautkan log ke invoice; hanya log yang belum terkunci yang diubah.
+	LockToInvoice(ctx context.Context, logIDs []uuid.UUID, invoiceID uuid.UUID) (int64, error)
+	// ReleaseInvoice melepas semua log dari invoice (saat invoice draft dihapus).
+	ReleaseInvoice(ctx context.Context, invoiceID uuid.UUID) error
 	Update(ctx context.Context, log *model.Log) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -2,16 +2,32 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"devtracker/internal/domain/model"
 
 	"github.com/google/uuid"
 )
 
+// MilestoneCount adalah jumlah milestone satu project dan yang sudah done.
+type MilestoneCount struct {
+	ProjectID uuid.UUID
+	Total     int
+	Done      int
+}
+
 type MilestoneRepository interface {
 	Create(ctx context.Context, m *model.Milestone) error
 	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Milestone, error)
+	FindByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]model.Milestone, error)
+	// CountByProjects menghitung milestone total/done per project dalam satu query;
+	// project tanpa milestone tidak muncul di hasil.
+	CountByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]MilestoneCount, error)
+	// FindOpenDueBefore mengambil milestone belum selesai dengan DueDate < before di semua
+	// project aktif (tidak diarsipkan/di-trash); dipakai pengingat terjadwal.
+	FindOpenDueBefore(ctx context.Context, before time.Time) ([]model.Milestone, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Milestone, error)
 	Update(ctx context.Context, m *model.Milestone) error
+	UpdateOrder(ctx context.Context, projectID uuid.UUID, ranks map[uuid.UUID]int) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
\ No newline at end of file
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type MilestoneDependencyRepository interface {
+	Create(ctx context.Context, d *model.MilestoneDependency) error
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.MilestoneDependency, error)
+	FindByMilestone(ctx context.Context, milestoneID uuid.UUID) ([]model.MilestoneDependency, error)
+	// Delete mengembalikan gorm.ErrRecordNotFound jika dependency tidak ada.
+	Delete(ctx context.Context, milestoneID, dependsOnID uuid.UUID) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type MilestoneHistoryRepository interface {
+	Create(ctx context.Context, h *model.MilestoneStatusHistory) error
+	FindByMilestone(ctx context.Context, milestoneID uuid.UUID) ([]model.MilestoneStatusHistory, error)
+	FindLatest(ctx context.Context, milestoneID uuid.UUID) (*model.MilestoneStatusHistory, error)
+	FindRecentByProjects(ctx context.Context, projectIDs []uuid.UUID, limit int) ([]model.MilestoneStatusHistory, error)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	// Create menyimpan notifikasi; bernilai false jika DedupeKey yang sama sudah ada untuk user.
+	Create(ctx context.Context, n *model.Notification) (bool, error)
+	// List mengambil satu halaman notifikasi user; filter Status (read/unread), Type (kind),
+	// ProjectID dan rentang CreatedAt.
+	List(ctx context.Context, userID uuid.UUID, q ListQuery) (*Page[model.Notification], error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	// MarkRead bernilai false jika notifikasi tidak ditemukan untuk user tersebut.
+	MarkRead(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	FindPreference(ctx context.Context, userID uuid.UUID) (*model.NotificationPreference, error)
+	SavePreference(ctx context.Context, p *model.NotificationPreference) error
+
+	// FindDigestSubscribers mengembalikan preferensi user yang mengaktifkan digest mingguan.
+	FindDigestSubscribers(ctx context.Context) ([]model.NotificationPreference, error)
+	// ClaimDigest menandai digest minggu week sebagai terkirim untuk user; bernilai false jika
+	// sudah diklaim sebelumnya (mis. oleh instance API lain).
+	ClaimDigest(ctx context.Context, userID uuid.UUID, week time.Time) (bool, error)
+}
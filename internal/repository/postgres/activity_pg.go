@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ActivityPG struct {
+	db *gorm.DB
+}
+
+func NewActivityPG(db *gorm.DB) repository.ActivityRepository {
+	return &ActivityPG{db}
+}
+
+// activityFeed menggabungkan semua sumber aktivitas; setiap cabang memakai project_id = ?.
+const activityFeed = `(
+	SELECT 'log_created'::text AS type, l.id, l.project_id, l.created_at AS occurred_at,
+		l.user_id AS actor_id, l.milestone_id, coalesce(l.description, '') AS title,
+		l.duration_minutes AS minutes, ''::text AS from_status, ''::text AS to_status
+	FROM logs l
+	WHERE l.project_id = ? AND l.deleted_at IS NULL
+	UNION ALL
+	SELECT 'milestone_status_changed', h.id, m.project_id, h.changed_at,
+		h.changed_by, h.milestone_id, m.name,
+		NULL::int, h.from_status, h.to_status
+	FROM milestone_status_histories h
+	JOIN milestones m ON m.id = h.milestone_id AND m.deleted_at IS NULL
+	WHERE m.project_id = ?
+	UNION ALL
+	SELECT 'insight_generated', i.id, i.project_id, i.generated_at,
+		NULL::uuid, NULL::uuid, i.type,
+		NULL::int, '', i.status
+	FROM ai_insights i
+	WHERE i.project_id = ? AND i.deleted_at IS NULL
+	UNION ALL
+	SELECT 'report_generated', r.id, r.project_id, r.generated_at,
+		NULL::uuid, NULL::uuid, r.url_pdf,
+		NULL::int, '', ''
+	FROM reports r
+	WHERE r.project_id = ? AND r.deleted_at IS NULL
+) AS feed`
+
+var activityListSpec = listSpec{
+	sorts:           map[string]string{"occurred_at": "occurred_at"},
+	defaultSort:     "occurred_at",
+	dateColumn:      "occurred_at",
+	milestoneColumn: "milestone_id",
+	userColumn:      "actor_id",
+	typeColumn:      "type",
+}
+
+func (r *ActivityPG) List(ctx context.Context, projectID uuid.UUID, q repository.ListQuery) (*repository.Page[repository.Activity], error) {
+	db := conn(ctx, r.db).Table(activityFeed, projectID, projectID, projectID, projectID)
+	return list[repository.Activity](ctx, db, q, activityListSpec)
+}
@@ -21,12 +21,12 @@ func NewAIInsightPG(db *gorm.DB) repository.AIInsightRepository {
 }
 
 func (r *AIInsightPG) Create(ctx context.Context, insight *model.AIInsight) error {
-	return r.db.WithContext(ctx).Create(insight).Error
+	return conn(ctx, r.db).Create(insight).Error
 }
 
 func (r *AIInsightPG) FindByID(ctx context.Context, id uuid.UUID) (*model.AIInsight, error) {
 	var insight model.AIInsight
-	err := r.db.WithContext(ctx).First(&insight, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&insight, "id = ?", id).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound{
@@ -41,7 +41,7 @@ func (r *AIInsightPG) FindByID(ctx context.Context, id uuid.UUID) (*model.AIInsi
 
 func (r *AIInsightPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.AIInsight, error) {
 	var insights []model.AIInsight
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("project_id = ?", projectID).Order("created_at desc").Find(&insights).Error
 
 	if err != nil {
@@ -54,7 +54,7 @@ func (r *AIInsightPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([
 
 func (r *AIInsightPG) FindByProjectAndTypeAndDate(ctx context.Context, projectID uuid.UUID, insightType model.InsightType, date time.Time) (*model.AIInsight, error) {
 	var insight model.AIInsight
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("project_id = ? AND type = ? AND DATE(generated_at) = ?", projectID, insightType, date).
 		First(&insight).Error
 
@@ -71,9 +71,56 @@ func (r *AIInsightPG) FindByProjectAndTypeAndDate(ctx context.Context, projectID
 
 
 func (r *AIInsightPG) Update(ctx context.Context, insight *model.AIInsight) error {
-	return r.db.WithContext(ctx).Save(insight).Error
+	return conn(ctx, r.db).Save(insight).Error
 }
 
 func (r *AIInsightPG) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.AIInsight{}, "id = ?", id).Error
-}
\ No newline at end of file
+	return conn(ctx, r.db).Unscoped().Delete(&model.AIInsight{}, "id = ?", id).Error
+}
+
+func (r *AIInsightPG) FindLatestByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]model.AIInsight, error) {
+	var insights []model.AIInsight
+	if len(projectIDs) == 0 {
+		return insights, nil
+	}
+
+	err := conn(ctx, r.db).
+		Raw(`SELECT DISTINCT ON (project_id) * FROM ai_insights
+			WHERE project_id IN ? AND deleted_at IS NULL ORDER BY project_id, generated_at DESC`, projectIDs).
+		Scan(&insights).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return insights, nil
+}
+
+func (r *AIInsightPG) FindLatestBefore(ctx context.Context, projectID uuid.UUID, before time.Time) (*model.AIInsight, error) {
+	var insight model.AIInsight
+	err := conn(ctx, r.db).
+		Where("project_id = ? AND generated_at < ?", projectID, before).
+		Order("generated_at desc").First(&insight).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &insight, nil
+}
+
+var insightListSpec = listSpec{
+	sorts:         map[string]string{"generated_at": "generated_at"},
+	defaultSort:   "generated_at",
+	dateColumn:    "generated_at",
+	projectColumn: "project_id",
+	typeColumn:    "type",
+	status:        columnStatus("status"),
+}
+
+func (r *AIInsightPG) List(ctx context.Context, q repository.ListQuery) (*repository.Page[model.AIInsight], error) {
+	return list[model.AIInsight](ctx, conn(ctx, r.db).Model(&model.AIInsight{}), q, insightListSpec)
+}
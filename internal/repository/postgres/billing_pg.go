@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ClientPG struct {
+	db *gorm.DB
+}
+
+func NewClientPG(db *gorm.DB) repository.ClientRepository {
+	return &ClientPG{db}
+}
+
+func (r *ClientPG) Create(ctx context.Context, c *model.Client) error {
+	return conn(ctx, r.db).Create(c).Error
+}
+
+func (r *ClientPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Client, error) {
+	var c model.Client
+	err := conn(ctx, r.db).First(&c, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *ClientPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Client, error) {
+	var res []model.Client
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).Order("name asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *ClientPG) Update(ctx context.Context, c *model.Client) error {
+	return conn(ctx, r.db).Save(c).Error
+}
+
+func (r *ClientPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Project{}).Unscoped().Where("client_id = ?", id).UpdateColumn("client_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Client{}, "id = ?", id).Error
+	})
+}
+
+type RatePG struct {
+	db *gorm.DB
+}
+
+func NewRatePG(db *gorm.DB) repository.RateRepository {
+	return &RatePG{db}
+}
+
+func (r *RatePG) Create(ctx context.Context, rate *model.HourlyRate) error {
+	return conn(ctx, r.db).Create(rate).Error
+}
+
+func (r *RatePG) FindByID(ctx context.Context, id uuid.UUID) (*model.HourlyRate, error) {
+	var rate model.HourlyRate
+	err := conn(ctx, r.db).First(&rate, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (r *RatePG) FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]model.HourlyRate, error) {
+	var res []model.HourlyRate
+	err := conn(ctx, r.db).
+		Where("owner_id = ?", ownerID).Order("effective_from desc, created_at desc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *RatePG) FindForProject(ctx context.Context, ownerID, projectID uuid.UUID) ([]model.HourlyRate, error) {
+	var res []model.HourlyRate
+	err := conn(ctx, r.db).
+		Where("owner_id = ? AND (project_id = ? OR project_id IS NULL)", ownerID, projectID).
+		Order("effective_from desc, created_at desc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *RatePG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&model.HourlyRate{}, "id = ?", id).Error
+}
+
+type InvoicePG struct {
+	db *gorm.DB
+}
+
+func NewInvoicePG(db *gorm.DB) repository.InvoiceRepository {
+	return &InvoicePG{db}
+}
+
+func (r *InvoicePG) Create(ctx context.Context, inv *model.Invoice) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(inv).Error; err != nil {
+			return err
+		}
+		if len(inv.Lines) == 0 {
+			return nil
+		}
+		for i := range inv.Lines {
+			inv.Lines[i].InvoiceID = inv.ID
+		}
+		return tx.Create(&inv.Lines).Error
+	})
+}
+
+func (r *InvoicePG) FindByID(ctx context.Context, id uuid.UUID) (*model.Invoice, error) {
+	var inv model.Invoice
+	err := conn(ctx, r.db).First(&inv, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	err = conn(ctx, r.db).
+		Where("invoice_id = ?", id).Order("position asc").Find(&inv.Lines).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &inv, nil
+}
+
+func (r *InvoicePG) FindByUser(ctx context.Context, userID uuid.UUID, status model.InvoiceStatus) ([]model.Invoice, error) {
+	var res []model.Invoice
+	db := conn(ctx, r.db).Where("user_id = ?", userID)
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	err := db.Order("created_at desc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *InvoicePG) Update(ctx context.Context, inv *model.Invoice) error {
+	return conn(ctx, r.db).Save(inv).Error
+}
+
+func (r *InvoicePG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("invoice_id = ?", id).Delete(&model.InvoiceLine{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Invoice{}, "id = ?", id).Error
+	})
+}
+
+func (r *InvoicePG) ExistsForClient(ctx context.Context, clientID uuid.UUID) (bool, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&model.Invoice{}).Where("client_id = ?", clientID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *InvoicePG) LatestNumber(ctx context.Context, userID uuid.UUID, prefix string) (string, error) {
+	var numbers []string
+	err := conn(ctx, r.db).Model(&model.Invoice{}).
+		Where("user_id = ? AND number LIKE ?", userID, prefix+"%").
+		Order("number desc").Limit(1).Pluck("number", &numbers).Error
+	if err != nil || len(numbers) == 0 {
+		return "", err
+	}
+	return numbers[0], nil
+}
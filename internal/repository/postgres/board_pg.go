@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BoardLimitPG struct {
+	db *gorm.DB
+}
+
+func NewBoardLimitPG(db *gorm.DB) repository.BoardLimitRepository {
+	return &BoardLimitPG{db}
+}
+
+func (r *BoardLimitPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.BoardColumnLimit, error) {
+	var res []model.BoardColumnLimit
+	err := conn(ctx, r.db).Where("project_id = ?", projectID).Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Replace mengganti seluruh WIP limit project dalam satu transaksi.
+func (r *BoardLimitPG) Replace(ctx context.Context, projectID uuid.UUID, limits []model.BoardColumnLimit) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.BoardColumnLimit{}, "project_id = ?", projectID).Error; err != nil {
+			return err
+		}
+		if len(limits) == 0 {
+			return nil
+		}
+		return tx.Create(&limits).Error
+	})
+}
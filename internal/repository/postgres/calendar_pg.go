@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CalendarPG struct {
+	db *gorm.DB
+}
+
+func NewCalendarPG(db *gorm.DB) repository.CalendarRepository {
+	return &CalendarPG{db}
+}
+
+func (r *CalendarPG) FindCalendar(ctx context.Context, userID uuid.UUID) (*model.WorkCalendar, error) {
+	var c model.WorkCalendar
+	err := conn(ctx, r.db).First(&c, "user_id = ?", userID).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *CalendarPG) SaveCalendar(ctx context.Context, c *model.WorkCalendar) error {
+	return conn(ctx, r.db).Save(c).Error
+}
+
+func (r *CalendarPG) FindHolidays(ctx context.Context, userID uuid.UUID) ([]model.Holiday, error) {
+	var res []model.Holiday
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).Order("date asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *CalendarPG) CreateHolidays(ctx context.Context, holidays []model.Holiday) (int64, error) {
+	if len(holidays) == 0 {
+		return 0, nil
+	}
+
+	res := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&holidays)
+
+	return res.RowsAffected, res.Error
+}
+
+func (r *CalendarPG) DeleteHoliday(ctx context.Context, userID, id uuid.UUID) error {
+	res := conn(ctx, r.db).Delete(&model.Holiday{}, "id = ? AND user_id = ?", id, userID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *CalendarPG) FindTimeOff(ctx context.Context, userID uuid.UUID) ([]model.TimeOff, error) {
+	var res []model.TimeOff
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).Order("start_date asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *CalendarPG) CreateTimeOff(ctx context.Context, t *model.TimeOff) error {
+	return conn(ctx, r.db).Create(t).Error
+}
+
+func (r *CalendarPG) DeleteTimeOff(ctx context.Context, userID, id uuid.UUID) error {
+	res := conn(ctx, r.db).Delete(&model.TimeOff{}, "id = ? AND user_id = ?", id, userID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
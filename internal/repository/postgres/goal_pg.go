@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GoalPG struct {
+	db *gorm.DB
+}
+
+func NewGoalPG(db *gorm.DB) repository.GoalRepository {
+	return &GoalPG{db}
+}
+
+func (r *GoalPG) Create(ctx context.Context, g *model.Goal) error {
+	return conn(ctx, r.db).Create(g).Error
+}
+
+func (r *GoalPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Goal, error) {
+	var g model.Goal
+	err := conn(ctx, r.db).Where("project_id IS NULL OR "+liveProject).First(&g, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+func (r *GoalPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Goal, error) {
+	var res []model.Goal
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).Where("project_id IS NULL OR "+liveProject).
+		Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *GoalPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Goal, error) {
+	var res []model.Goal
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Where(liveProject).Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *GoalPG) Update(ctx context.Context, g *model.Goal) error {
+	return conn(ctx, r.db).Save(g).Error
+}
+
+func (r *GoalPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&model.Goal{}, "id = ?", id).Error
+}
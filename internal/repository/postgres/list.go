@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// listSpec mendeskripsikan kolom yang boleh dipakai ListQuery untuk satu tabel.
+// Kolom sort harus NOT NULL supaya perbandingan keyset (col, id) selalu terdefinisi.
+type listSpec struct {
+	sorts       map[string]string // nama sort di API -> kolom
+	defaultSort string
+	dateColumn  string
+
+	projectColumn   string
+	milestoneColumn string
+	userColumn      string
+	typeColumn      string
+	// status boleh berupa kolom biasa atau kondisi khusus (mis. archived untuk project)
+	status func(db *gorm.DB, status string) *gorm.DB
+}
+
+// cursorToken adalah isi cursor sebelum di-encode base64.
+type cursorToken struct {
+	Sort  string          `json:"s"`
+	Asc   bool            `json:"a"`
+	Value json.RawMessage `json:"v"`
+	ID    string          `json:"id"`
+}
+
+var listSchemas sync.Map
+
+// list menjalankan ListQuery dengan keyset pagination di atas db (yang sudah berisi
+// scope seperti WHERE project_id). Satu baris ekstra diambil untuk menentukan NextCursor.
+func list[T any](ctx context.Context, db *gorm.DB, q repository.ListQuery, spec listSpec) (*repository.Page[T], error) {
+	sortName := q.Sort
+	if sortName == "" {
+		sortName = spec.defaultSort
+	}
+	column, ok := spec.sorts[sortName]
+	if !ok {
+		return nil, repository.ErrInvalidSort
+	}
+
+	sch, err := schema.Parse(new(T), &listSchemas, db.NamingStrategy)
+	if err != nil {
+		return nil, err
+	}
+	sortField := sch.LookUpField(column)
+	idField := sch.PrioritizedPrimaryField
+	if sortField == nil || idField == nil {
+		return nil, fmt.Errorf("list: %s has no column %s", sch.Table, column)
+	}
+
+	db = applyFilters(db, q, spec)
+
+	dir, cmp := "DESC", "<"
+	if q.Asc {
+		dir, cmp = "ASC", ">"
+	}
+
+	db = db.Order(fmt.Sprintf("%s %s, %s %s", column, dir, idField.DBName, dir))
+
+	if q.Cursor != "" {
+		value, id, err := decodeCursor(q.Cursor, sortName, q.Asc, sortField.FieldType)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", column, idField.DBName, cmp), value, id)
+	}
+
+	limit := q.PageLimit()
+	var items []T
+	if err := db.Limit(limit + 1).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	page := &repository.Page[T]{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		last := reflect.ValueOf(&page.Items[limit-1]).Elem()
+
+		value, _ := sortField.ValueOf(ctx, last)
+		id, _ := idField.ValueOf(ctx, last)
+		page.NextCursor, err = encodeCursor(sortName, q.Asc, value, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+func applyFilters(db *gorm.DB, q repository.ListQuery, spec listSpec) *gorm.DB {
+	if spec.dateColumn != "" {
+		if q.From != nil {
+			db = db.Where(spec.dateColumn+" >= ?", *q.From)
+		}
+		if q.To != nil {
+			db = db.Where(spec.dateColumn+" < ?", *q.To)
+		}
+	}
+	if q.ProjectID != nil && spec.projectColumn != "" {
+		db = db.Where(spec.projectColumn+" = ?", *q.ProjectID)
+	}
+	if q.MilestoneID != nil && spec.milestoneColumn != "" {
+		db = db.Where(spec.milestoneColumn+" = ?", *q.MilestoneID)
+	}
+	if q.UserID != nil && spec.userColumn != "" {
+		db = db.Where(spec.userColumn+" = ?", *q.UserID)
+	}
+	if q.Type != "" && spec.typeColumn != "" {
+		db = db.Where(spec.typeColumn+" = ?", q.Type)
+	}
+	if q.Status != "" && spec.status != nil {
+		db = spec.status(db, q.Status)
+	}
+	return db
+}
+
+func encodeCursor(sort string, asc bool, value, id interface{}) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	token, err := json.Marshal(cursorToken{Sort: sort, Asc: asc, Value: raw, ID: fmt.Sprint(id)})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// decodeCursor membuka cursor dan memvalidasinya terhadap sort yang diminta: id harus UUID
+// dan nilai sort harus bisa dibaca sebagai tipe kolom sort (null ditolak karena kolom
+// sort NOT NULL). Semua kegagalan dikembalikan sebagai ErrInvalidCursor.
+func decodeCursor(cursor, sort string, asc bool, valueType reflect.Type) (interface{}, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, uuid.Nil, repository.ErrInvalidCursor
+	}
+	var token cursorToken
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return nil, uuid.Nil, repository.ErrInvalidCursor
+	}
+	if token.Sort != sort || token.Asc != asc {
+		return nil, uuid.Nil, repository.ErrInvalidCursor
+	}
+
+	id, err := uuid.Parse(token.ID)
+	if err != nil {
+		return nil, uuid.Nil, repository.ErrInvalidCursor
+	}
+
+	if len(token.Value) == 0 || string(token.Value) == "null" {
+		return nil, uuid.Nil, repository.ErrInvalidCursor
+	}
+	value := reflect.New(valueType)
+	if err := json.Unmarshal(token.Value, value.Interface()); err != nil {
+		return nil, uuid.Nil, repository.ErrInvalidCursor
+	}
+	return value.Elem().Interface(), id, nil
+}
+
+// columnStatus memfilter status sebagai kolom biasa.
+func columnStatus(column string) func(db *gorm.DB, status string) *gorm.DB {
+	return func(db *gorm.DB, status string) *gorm.DB {
+		return db.Where(column+" = ?", status)
+	}
+}
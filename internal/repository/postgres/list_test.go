@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := uuid.New()
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		typ   reflect.Type
+	}{
+		{"time", at, reflect.TypeOf(time.Time{})},
+		{"string", "alpha", reflect.TypeOf("")},
+		{"int", 42, reflect.TypeOf(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := encodeCursor("sort", true, tt.value, id)
+			if err != nil {
+				t.Fatalf("encodeCursor: %v", err)
+			}
+			value, gotID, err := decodeCursor(cursor, "sort", true, tt.typ)
+			if err != nil {
+				t.Fatalf("decodeCursor: %v", err)
+			}
+			if gotID != id {
+				t.Errorf("id = %s, want %s", gotID, id)
+			}
+			if want, ok := tt.value.(time.Time); ok {
+				if got, _ := value.(time.Time); !got.Equal(want) {
+					t.Errorf("value = %v, want %v", value, want)
+				}
+			} else if !reflect.DeepEqual(value, tt.value) {
+				t.Errorf("value = %v, want %v", value, tt.value)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	id := uuid.New()
+	raw := func(token string) string { return base64.RawURLEncoding.EncodeToString([]byte(token)) }
+	valid, err := encodeCursor("name", false, "alpha", id)
+	if err != nil {
+		t.Fatalf("encodeCursor: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   string
+		asc    bool
+		typ    reflect.Type
+	}{
+		{"not base64", "%%%", "name", false, reflect.TypeOf("")},
+		{"not json", raw("nope"), "name", false, reflect.TypeOf("")},
+		{"other sort", valid, "created_at", false, reflect.TypeOf("")},
+		{"other order", valid, "name", true, reflect.TypeOf("")},
+		{"id not uuid", raw(`{"s":"name","a":false,"v":"alpha","id":"1 OR 1=1"}`), "name", false, reflect.TypeOf("")},
+		{"missing value", raw(`{"s":"name","a":false,"id":"` + id.String() + `"}`), "name", false, reflect.TypeOf("")},
+		{"null value", raw(`{"s":"name","a":false,"v":null,"id":"` + id.String() + `"}`), "name", false, reflect.TypeOf("")},
+		{"wrong value type", valid, "name", false, reflect.TypeOf(time.Time{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := decodeCursor(tt.cursor, tt.sort, tt.asc, tt.typ); !errors.Is(err, repository.ErrInvalidCursor) {
+				t.Errorf("err = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
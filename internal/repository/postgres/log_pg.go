@@ -2,12 +2,14 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"devtracker/internal/domain/model"
 	"devtracker/internal/repository"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type LogPG struct {
@@ -21,12 +23,12 @@ func NewLogPG(db *gorm.DB) repository.LogRepository {
 }
 
 func (r *LogPG) Create(ctx context.Context, log *model.Log) error {
-	return r.db.WithContext(ctx).Create(log).Error
+	return conn(ctx, r.db).Create(log).Error
 }
 
 func (r *LogPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Log, error) {
 	var log model.Log
-	err := r.db.WithContext(ctx).First(&log, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&log, "id = ?", id).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound{
@@ -38,9 +40,21 @@ func (r *LogPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Log, error)
 	return &log, err
 }
 
+func (r *LogPG) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Log, error) {
+	var log model.Log
+	err := conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).First(&log, "id = ?", id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &log, nil
+}
+
 func (r *LogPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Log, error) {
 	var logs []model.Log
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("user_id = ?", userID).Order("created_at desc").Find(&logs).Error
 
 	if err != nil {
@@ -50,9 +64,22 @@ func (r *LogPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Log,
 	return logs, err
 }	
 
+func (r *LogPG) FindByUserBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]model.Log, error) {
+	var logs []model.Log
+	err := conn(ctx, r.db).
+		Where("user_id = ? AND logged_at >= ? AND logged_at < ?", userID, from, to).
+		Order("logged_at asc").Find(&logs).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
 func (r *LogPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Log, error) {
 	var logs []model.Log
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("project_id = ?", projectID).Order("created_at desc").Find(&logs).Error
 
 	if err != nil {
@@ -62,11 +89,89 @@ func (r *LogPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model
 	return logs, err
 }
 
+// Update tidak pernah menyentuh invoice_id; kunci invoice hanya lewat LockToInvoice/ReleaseInvoice.
 func (r *LogPG) Update(ctx context.Context, log *model.Log) error {
-	return r.db.WithContext(ctx).Save(log).Error
+	return conn(ctx, r.db).Omit("InvoiceID").Save(log).Error
 }
 
 func (r *LogPG) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.Log{}, "id = ?", id).Error
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("log_id = ?", id).Delete(&model.LogTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&model.Log{}, "id = ?", id).Error
+	})
+}
+
+func (r *LogPG) FindRecentByUser(ctx context.Context, userID uuid.UUID, limit int) ([]model.Log, error) {
+	var logs []model.Log
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).Order("logged_at desc").Limit(limit).Find(&logs).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func (r *LogPG) FindLastLogByActiveUsers(ctx context.Context) ([]repository.UserLastLog, error) {
+	var res []repository.UserLastLog
+	err := conn(ctx, r.db).Raw(`
+		SELECT p.user_id, COALESCE(
+			(SELECT MAX(l.logged_at) FROM logs l WHERE l.user_id = p.user_id AND l.deleted_at IS NULL),
+			MIN(p.created_at)
+		) AS last_logged_at
+		FROM projects p
+		WHERE p.deleted_at IS NULL AND p.archived_at IS NULL
+		GROUP BY p.user_id`).Scan(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+var logListSpec = listSpec{
+	sorts: map[string]string{
+		"logged_at":  "logged_at",
+		"created_at": "created_at",
+		"duration":   "duration_minutes",
+	},
+	defaultSort:     "logged_at",
+	dateColumn:      "logged_at",
+	projectColumn:   "project_id",
+	milestoneColumn: "milestone_id",
+	userColumn:      "user_id",
+}
+
+func (r *LogPG) List(ctx context.Context, q repository.ListQuery) (*repository.Page[model.Log], error) {
+	return list[model.Log](ctx, conn(ctx, r.db).Model(&model.Log{}), q, logListSpec)
 }
 
+func (r *LogPG) FindUninvoiced(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]model.Log, error) {
+	var logs []model.Log
+	err := conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("project_id = ? AND billable AND invoice_id IS NULL AND logged_at >= ? AND logged_at < ?", projectID, from, to).
+		Order("logged_at asc").Find(&logs).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func (r *LogPG) LockToInvoice(ctx context.Context, logIDs []uuid.UUID, invoiceID uuid.UUID) (int64, error) {
+	res := conn(ctx, r.db).Model(&model.Log{}).
+		Where("id IN ? AND invoice_id IS NULL", logIDs).
+		UpdateColumn("invoice_id", invoiceID)
+	return res.RowsAffected, res.Error
+}
+
+func (r *LogPG) ReleaseInvoice(ctx context.Context, invoiceID uuid.UUID) error {
+	return conn(ctx, r.db).Model(&model.Log{}).
+		Where("invoice_id = ?", invoiceID).
+		UpdateColumn("invoice_id", nil).Error
+}
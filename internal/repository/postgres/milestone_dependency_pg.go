@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MilestoneDependencyPG struct {
+	db *gorm.DB
+}
+
+func NewMilestoneDependencyPG(db *gorm.DB) repository.MilestoneDependencyRepository {
+	return &MilestoneDependencyPG{db}
+}
+
+func (r *MilestoneDependencyPG) Create(ctx context.Context, d *model.MilestoneDependency) error {
+	return conn(ctx, r.db).Create(d).Error
+}
+
+func (r *MilestoneDependencyPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.MilestoneDependency, error) {
+	var res []model.MilestoneDependency
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MilestoneDependencyPG) FindByMilestone(ctx context.Context, milestoneID uuid.UUID) ([]model.MilestoneDependency, error) {
+	var res []model.MilestoneDependency
+	err := conn(ctx, r.db).
+		Where("milestone_id = ?", milestoneID).Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MilestoneDependencyPG) Delete(ctx context.Context, milestoneID, dependsOnID uuid.UUID) error {
+	res := conn(ctx, r.db).
+		Delete(&model.MilestoneDependency{}, "milestone_id = ? AND depends_on_id = ?", milestoneID, dependsOnID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
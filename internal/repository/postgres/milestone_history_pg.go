@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MilestoneHistoryPG struct {
+	db *gorm.DB
+}
+
+func NewMilestoneHistoryPG(db *gorm.DB) repository.MilestoneHistoryRepository {
+	return &MilestoneHistoryPG{db}
+}
+
+func (r *MilestoneHistoryPG) Create(ctx context.Context, h *model.MilestoneStatusHistory) error {
+	return conn(ctx, r.db).Create(h).Error
+}
+
+func (r *MilestoneHistoryPG) FindByMilestone(ctx context.Context, milestoneID uuid.UUID) ([]model.MilestoneStatusHistory, error) {
+	var res []model.MilestoneStatusHistory
+	err := conn(ctx, r.db).
+		Where("milestone_id = ?", milestoneID).Order("changed_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MilestoneHistoryPG) FindLatest(ctx context.Context, milestoneID uuid.UUID) (*model.MilestoneStatusHistory, error) {
+	var h model.MilestoneStatusHistory
+	err := conn(ctx, r.db).
+		Where("milestone_id = ?", milestoneID).Order("changed_at desc").First(&h).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+func (r *MilestoneHistoryPG) FindRecentByProjects(ctx context.Context, projectIDs []uuid.UUID, limit int) ([]model.MilestoneStatusHistory, error) {
+	var res []model.MilestoneStatusHistory
+	if len(projectIDs) == 0 {
+		return res, nil
+	}
+
+	err := conn(ctx, r.db).
+		Joins("JOIN milestones ON milestones.id = milestone_status_histories.milestone_id").
+		Where("milestones.project_id IN ?", projectIDs).
+		Order("milestone_status_histories.changed_at desc").Limit(limit).Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"devtracker/internal/domain/model"
 	"devtracker/internal/repository"
@@ -21,13 +22,13 @@ func NewMilestonePG(db *gorm.DB) repository.MilestoneRepository {
 }
 
 func (r *MilestonePG) Create(ctx context.Context, m *model.Milestone) error {
-	return r.db.WithContext(ctx).Create(m).Error
+	return conn(ctx, r.db).Create(m).Error
 }
 
 func (r *MilestonePG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Milestone, error) {
 	var res []model.Milestone
-	err := r.db.WithContext(ctx).
-		Where("project_id = ?", projectID).Order("created_at desc").Order("status != 'done', order_idx asc").Find(&res).Error
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Order("order_idx asc, created_at asc").Find(&res).Error
 	
 	if err != nil {
 		return nil, err
@@ -36,9 +37,23 @@ func (r *MilestonePG) FindByProject(ctx context.Context, projectID uuid.UUID) ([
 	return res, err
 }
 
+func (r *MilestonePG) FindOpenDueBefore(ctx context.Context, before time.Time) ([]model.Milestone, error) {
+	var res []model.Milestone
+	err := conn(ctx, r.db).
+		Where("status <> ? AND due_date IS NOT NULL AND due_date < ?", model.StatusDone, before).
+		Where("project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL AND archived_at IS NULL)").
+		Order("due_date asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (r *MilestonePG) FindByID(ctx context.Context, id uuid.UUID) (*model.Milestone, error) {
 	var ms model.Milestone
-	err := r.db.WithContext(ctx).First(&ms, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&ms, "id = ?", id).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound{
@@ -51,9 +66,57 @@ func (r *MilestonePG) FindByID(ctx context.Context, id uuid.UUID) (*model.Milest
 }
 
 func (r *MilestonePG) Update(ctx context.Context, m *model.Milestone) error {
-	return r.db.WithContext(ctx).Save(m).Error
+	return conn(ctx, r.db).Save(m).Error
+}
+
+// UpdateOrder menulis order_idx baru secara atomik; hanya milestone yang berubah yang dikirim.
+func (r *MilestonePG) UpdateOrder(ctx context.Context, projectID uuid.UUID, ranks map[uuid.UUID]int) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for id, rank := range ranks {
+			err := tx.Model(&model.Milestone{}).
+				Where("id = ? AND project_id = ?", id, projectID).
+				Update("order_idx", rank).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (r *MilestonePG) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.Milestone{}, "id = ?", id).Error
+	return conn(ctx, r.db).Unscoped().Delete(&model.Milestone{}, "id = ?", id).Error
+}
+
+func (r *MilestonePG) FindByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]model.Milestone, error) {
+	var res []model.Milestone
+	if len(projectIDs) == 0 {
+		return res, nil
+	}
+
+	err := conn(ctx, r.db).
+		Where("project_id IN ?", projectIDs).Order("order_idx asc, created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MilestonePG) CountByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]repository.MilestoneCount, error) {
+	var res []repository.MilestoneCount
+	if len(projectIDs) == 0 {
+		return res, nil
+	}
+
+	err := conn(ctx, r.db).Model(&model.Milestone{}).
+		Select("project_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE status = ?) AS done", model.StatusDone).
+		Where("project_id IN ?", projectIDs).
+		Group("project_id").
+		Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPG struct {
+	db *gorm.DB
+}
+
+func NewNotificationPG(db *gorm.DB) repository.NotificationRepository {
+	return &NotificationPG{db}
+}
+
+func (r *NotificationPG) Create(ctx context.Context, n *model.Notification) (bool, error) {
+	res := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(n)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+var notificationListSpec = listSpec{
+	sorts:         map[string]string{"created_at": "created_at"},
+	defaultSort:   "created_at",
+	dateColumn:    "created_at",
+	projectColumn: "project_id",
+	typeColumn:    "kind",
+	status: func(db *gorm.DB, status string) *gorm.DB {
+		switch status {
+		case "unread":
+			return db.Where("read_at IS NULL")
+		case "read":
+			return db.Where("read_at IS NOT NULL")
+		}
+		return db
+	},
+}
+
+func (r *NotificationPG) List(ctx context.Context, userID uuid.UUID, q repository.ListQuery) (*repository.Page[model.Notification], error) {
+	db := conn(ctx, r.db).Model(&model.Notification{}).Where("user_id = ?", userID)
+	return list[model.Notification](ctx, db, q, notificationListSpec)
+}
+
+func (r *NotificationPG) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *NotificationPG) MarkRead(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	// notifikasi yang sudah dibaca tetap dianggap ditemukan, read_at-nya tidak diubah
+	res := conn(ctx, r.db).Model(&model.Notification{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", time.Now()))
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *NotificationPG) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	res := conn(ctx, r.db).Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	return res.RowsAffected, res.Error
+}
+
+func (r *NotificationPG) FindPreference(ctx context.Context, userID uuid.UUID) (*model.NotificationPreference, error) {
+	var p model.NotificationPreference
+	err := conn(ctx, r.db).First(&p, "user_id = ?", userID).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *NotificationPG) SavePreference(ctx context.Context, p *model.NotificationPreference) error {
+	// DigestSentWeek hanya diubah lewat ClaimDigest supaya tidak tertimpa nilai lama
+	return conn(ctx, r.db).Omit("DigestSentWeek").Save(p).Error
+}
+
+func (r *NotificationPG) FindDigestSubscribers(ctx context.Context) ([]model.NotificationPreference, error) {
+	var res []model.NotificationPreference
+	err := conn(ctx, r.db).Where("digest_enabled").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *NotificationPG) ClaimDigest(ctx context.Context, userID uuid.UUID, week time.Time) (bool, error) {
+	res := conn(ctx, r.db).Model(&model.NotificationPreference{}).
+		Where("user_id = ? AND (digest_sent_week IS NULL OR digest_sent_week < ?)", userID, week).
+		Update("digest_sent_week", week)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
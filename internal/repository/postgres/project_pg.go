@@ -2,12 +2,14 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"devtracker/internal/domain/model"
 	"devtracker/internal/repository"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProjectPG struct {
@@ -20,19 +22,19 @@ func NewProjectPG(db *gorm.DB) repository.ProjectRepository {
 }
 
 func (r *ProjectPG) Create(ctx context.Context, p *model.Project) error {
-	return r.db.WithContext(ctx).Create(p).Error
+	return conn(ctx, r.db).Create(p).Error
 }
 
 func (r *ProjectPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
 	var res []model.Project
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("user_id = ?", userID).Order("created_at desc").Find(&res).Error
 	return res, err
 }
 
 func (r *ProjectPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Project, error) {
 	var proj model.Project
-	err := r.db.WithContext(ctx).First(&proj, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&proj, "id = ?", id).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound{
@@ -44,10 +46,162 @@ func (r *ProjectPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Project,
 	return &proj, err
 }
 
+func (r *ProjectPG) Lock(ctx context.Context, id uuid.UUID) error {
+	var proj model.Project
+	return conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").First(&proj, "id = ?", id).Error
+}
+
 func (r *ProjectPG) Update(ctx context.Context, p *model.Project) error {
-	return r.db.WithContext(ctx).Save(p).Error
+	return conn(ctx, r.db).Save(p).Error
 }
 
+// trashedChildren adalah tabel yang ikut di-soft delete bersama project.
+var trashedChildren = []interface{}{&model.Milestone{}, &model.Log{}, &model.AIInsight{}, &model.Report{}}
+
+// projectMilestones dipakai untuk child yang tidak punya kolom project_id langsung.
+const projectMilestones = "milestone_id IN (SELECT id FROM milestones WHERE project_id IN ?)"
+
+// liveProject menyaring child tanpa soft delete sendiri (sprint, goal) dari project di trash.
+const liveProject = "project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)"
+
 func (r *ProjectPG) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.Project{}, "id = ?", id).Error
+	// timestamp yang sama dipakai saat restore untuk membedakan child yang ikut terhapus
+	now := time.Now().Truncate(time.Microsecond)
+	ids := []uuid.UUID{id}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Task{}).Where(projectMilestones, ids).Update("deleted_at", now).Error; err != nil {
+			return err
+		}
+		for _, child := range trashedChildren {
+			if err := tx.Model(child).Where("project_id = ?", id).Update("deleted_at", now).Error; err != nil {
+				return err
+			}
+		}
+		// timer yang berjalan dibuang: sesi tidak bisa dijadikan log di project yang di trash
+		if err := tx.Where("project_id = ?", id).Delete(&model.Timer{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Project{}).Where("id = ?", id).Update("deleted_at", now).Error
+	})
+}
+
+func (r *ProjectPG) FindTrashedByUser(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	var res []model.Project
+	err := conn(ctx, r.db).Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).Order("deleted_at desc").Find(&res).Error
+	return res, err
+}
+
+func (r *ProjectPG) FindTrashedByID(ctx context.Context, id uuid.UUID) (*model.Project, error) {
+	var proj model.Project
+	err := conn(ctx, r.db).Unscoped().First(&proj, "id = ? AND deleted_at IS NOT NULL", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &proj, nil
+}
+
+func (r *ProjectPG) Restore(ctx context.Context, p *model.Project) error {
+	stamp := p.DeletedAt.Time
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, child := range trashedChildren {
+			err := tx.Unscoped().Model(child).
+				Where("project_id = ? AND deleted_at = ?", p.ID, stamp).Update("deleted_at", nil).Error
+			if err != nil {
+				return err
+			}
+		}
+		err := tx.Unscoped().Model(&model.Task{}).
+			Where(projectMilestones+" AND deleted_at = ?", []uuid.UUID{p.ID}, stamp).Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&model.Project{}).Where("id = ?", p.ID).Update("deleted_at", nil).Error
+	})
+}
+
+func (r *ProjectPG) PurgeTrashed(ctx context.Context, cutoff time.Time) (int64, error) {
+	var ids []uuid.UUID
+	err := conn(ctx, r.db).Unscoped().Model(&model.Project{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	err = conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		tx = tx.Unscoped()
+
+		// data yang bergantung pada milestone dihapus sebelum milestone-nya
+		byMilestone := []interface{}{&model.Task{}, &model.MilestoneStatusHistory{}}
+		for _, m := range byMilestone {
+			if err := tx.Where(projectMilestones, ids).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("sprint_id IN (SELECT id FROM sprints WHERE project_id IN ?)", ids).Delete(&model.SprintItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("log_id IN (SELECT id FROM logs WHERE project_id IN ?)", ids).Delete(&model.LogTag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("timesheet_id IN (SELECT id FROM timesheets WHERE project_id IN ?)", ids).Delete(&model.TimesheetComment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id IN (SELECT id FROM comments WHERE project_id IN ?)", ids).Delete(&model.CommentMention{}).Error; err != nil {
+			return err
+		}
+
+		byProject := []interface{}{
+			&model.Milestone{}, &model.MilestoneDependency{}, &model.BoardColumnLimit{},
+			&model.Log{}, &model.AIInsight{}, &model.Report{},
+			&model.Sprint{}, &model.ScopeChange{}, &model.ProjectRevision{},
+			&model.Goal{}, &model.Timer{}, &model.HourlyRate{}, &model.Timesheet{},
+			&model.ProjectSupervisor{}, &model.Comment{}, &model.Notification{},
+		}
+		for _, m := range byProject {
+			if err := tx.Where("project_id IN ?", ids).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("id IN ?", ids).Delete(&model.Project{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(ids)), nil
+}
+
+var projectListSpec = listSpec{
+	sorts: map[string]string{
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+		"name":       "name",
+	},
+	defaultSort: "created_at",
+	dateColumn:  "created_at",
+	userColumn:  "user_id",
+	status: func(db *gorm.DB, status string) *gorm.DB {
+		switch status {
+		case "active":
+			return db.Where("archived_at IS NULL")
+		case "archived":
+			return db.Where("archived_at IS NOT NULL")
+		}
+		return db
+	},
+}
+
+func (r *ProjectPG) List(ctx context.Context, q repository.ListQuery) (*repository.Page[model.Project], error) {
+	return list[model.Project](ctx, conn(ctx, r.db).Model(&model.Project{}), q, projectListSpec)
 }
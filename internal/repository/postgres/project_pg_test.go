@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TestProjectTrashRestore memastikan restore hanya mengembalikan child yang ikut terhapus
+// bersama project (deleted_at sama), bukan child yang sudah dihapus sebelumnya.
+// Butuh Postgres: TEST_DATABASE_DSN.
+func TestProjectTrashRestore(t *testing.T) {
+	tx := testTx(t)
+	if err := tx.AutoMigrate(&model.User{}, &model.Project{}, &model.Milestone{}, &model.Task{},
+		&model.Log{}, &model.AIInsight{}, &model.Report{}, &model.Timer{}); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), txKey{}, tx)
+	repo := NewProjectPG(tx)
+
+	user := model.User{Name: "Trash", Email: uuid.NewString() + "@example.com", PasswordHash: "x"}
+	mustCreate(t, tx, &user)
+	project := model.Project{UserID: user.ID, Name: "Trash " + uuid.NewString()}
+	mustCreate(t, tx, &project)
+
+	kept := model.Milestone{ProjectID: project.ID, Name: "kept"}
+	earlier := model.Milestone{ProjectID: project.ID, Name: "deleted earlier"}
+	mustCreate(t, tx, &kept)
+	mustCreate(t, tx, &earlier)
+	keptTask := model.Task{MilestoneID: kept.ID, Title: "kept task"}
+	earlierTask := model.Task{MilestoneID: kept.ID, Title: "deleted earlier"}
+	mustCreate(t, tx, &keptTask)
+	mustCreate(t, tx, &earlierTask)
+	keptLog := model.Log{ProjectID: project.ID, UserID: user.ID, DurationMinutes: 30, LoggedAt: time.Now()}
+	mustCreate(t, tx, &keptLog)
+	mustCreate(t, tx, &model.Timer{UserID: user.ID, ProjectID: project.ID, StartedAt: time.Now()})
+
+	if err := tx.Delete(&earlier).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Delete(&earlierTask).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repo.Delete(ctx, project.ID); err != nil {
+		t.Fatalf("Delete() err = %v", err)
+	}
+	trashed, err := repo.FindTrashedByID(ctx, project.ID)
+	if err != nil || trashed == nil {
+		t.Fatalf("FindTrashedByID() = %v, %v", trashed, err)
+	}
+
+	stamp := trashed.DeletedAt.Time
+	rows := []struct {
+		name      string
+		model     interface{}
+		id        uuid.UUID
+		withTrash bool
+	}{
+		{"project", &model.Project{}, project.ID, true},
+		{"milestone trashed with project", &model.Milestone{}, kept.ID, true},
+		{"task trashed with project", &model.Task{}, keptTask.ID, true},
+		{"log trashed with project", &model.Log{}, keptLog.ID, true},
+		{"milestone deleted earlier", &model.Milestone{}, earlier.ID, false},
+		{"task deleted earlier", &model.Task{}, earlierTask.ID, false},
+	}
+	for _, tt := range rows {
+		got := deletedAt(t, tx, tt.model, tt.id)
+		if got == nil || got.Equal(stamp) != tt.withTrash {
+			t.Errorf("%s: deleted_at = %v, project stamp %v, want shared stamp = %v", tt.name, got, stamp, tt.withTrash)
+		}
+	}
+	var timers int64
+	tx.Model(&model.Timer{}).Where("project_id = ?", project.ID).Count(&timers)
+	if timers != 0 {
+		t.Errorf("timers after trash = %d, want 0", timers)
+	}
+
+	if err := repo.Restore(ctx, trashed); err != nil {
+		t.Fatalf("Restore() err = %v", err)
+	}
+
+	for _, tt := range rows {
+		if alive := deletedAt(t, tx, tt.model, tt.id) == nil; alive != tt.withTrash {
+			t.Errorf("%s: alive after restore = %v, want %v", tt.name, alive, tt.withTrash)
+		}
+	}
+}
+
+func mustCreate(t *testing.T, tx *gorm.DB, row interface{}) {
+	t.Helper()
+	if err := tx.Create(row).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// deletedAt membaca ulang kolom deleted_at baris id di tabel milik m.
+func deletedAt(t *testing.T, tx *gorm.DB, m interface{}, id uuid.UUID) *time.Time {
+	t.Helper()
+	var res struct{ DeletedAt *time.Time }
+	if err := tx.Unscoped().Model(m).Select("deleted_at").Where("id = ?", id).Take(&res).Error; err != nil {
+		t.Fatal(err)
+	}
+	return res.DeletedAt
+}
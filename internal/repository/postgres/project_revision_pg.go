@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ProjectRevisionPG struct {
+	db *gorm.DB
+}
+
+func NewProjectRevisionPG(db *gorm.DB) repository.ProjectRevisionRepository {
+	return &ProjectRevisionPG{db}
+}
+
+func (r *ProjectRevisionPG) Create(ctx context.Context, rev *model.ProjectRevision) error {
+	return conn(ctx, r.db).Create(rev).Error
+}
+
+func (r *ProjectRevisionPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.ProjectRevision, error) {
+	var res []model.ProjectRevision
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Order("version asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *ProjectRevisionPG) LatestVersion(ctx context.Context, projectID uuid.UUID) (int, error) {
+	var version int
+	err := conn(ctx, r.db).Model(&model.ProjectRevision{}).
+		Where("project_id = ?", projectID).
+		Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+
+	return version, err
+}
@@ -19,12 +19,12 @@ func NewReportPG(db *gorm.DB) repository.ReportRepository {
 }
 
 func (r *ReportPG) Create(ctx context.Context, report *model.Report) error {
-	return r.db.WithContext(ctx).Create(report).Error
+	return conn(ctx, r.db).Create(report).Error
 }
 
 func (r *ReportPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Report, error) {
 	var report model.Report
-	err := r.db.WithContext(ctx).First(&report, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&report, "id = ?", id).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound{
@@ -39,7 +39,7 @@ func (r *ReportPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Report, e
 
 func (r *ReportPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Report, error) {
 	var reports []model.Report
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("project_id = ?", projectID).Order("generated_at desc").Find(&reports).Error
 
 	if err != nil {
@@ -50,10 +50,21 @@ func (r *ReportPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]mo
 }
 
 func (r *ReportPG) Update(ctx context.Context, report *model.Report) error {
-	return r.db.WithContext(ctx).Save(report).Error
+	return conn(ctx, r.db).Save(report).Error
 }
 
 func (r *ReportPG) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.Report{}, "id = ?", id).Error
+	return conn(ctx, r.db).Unscoped().Delete(&model.Report{}, "id = ?", id).Error
 }
 
+
+var reportListSpec = listSpec{
+	sorts:         map[string]string{"generated_at": "generated_at"},
+	defaultSort:   "generated_at",
+	dateColumn:    "generated_at",
+	projectColumn: "project_id",
+}
+
+func (r *ReportPG) List(ctx context.Context, q repository.ListQuery) (*repository.Page[model.Report], error) {
+	return list[model.Report](ctx, conn(ctx, r.db).Model(&model.Report{}), q, reportListSpec)
+}
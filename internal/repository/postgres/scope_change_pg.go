@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ScopeChangePG struct {
+	db *gorm.DB
+}
+
+func NewScopeChangePG(db *gorm.DB) repository.ScopeChangeRepository {
+	return &ScopeChangePG{db}
+}
+
+func (r *ScopeChangePG) Create(ctx context.Context, c *model.ScopeChange) error {
+	return conn(ctx, r.db).Create(c).Error
+}
+
+func (r *ScopeChangePG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.ScopeChange, error) {
+	var res []model.ScopeChange
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Order("changed_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+type SearchPG struct {
+	db *gorm.DB
+}
+
+func NewSearchPG(db *gorm.DB) repository.SearchRepository {
+	return &SearchPG{db}
+}
+
+const searchHeadline = `'StartSel=<mark>, StopSel=</mark>, MaxWords=25, MinWords=8, MaxFragments=2'`
+
+// searchAccess membatasi hasil ke project milik user atau yang ia supervisi secara aktif.
+const searchAccess = `(p.user_id = @user_id OR EXISTS (
+			SELECT 1 FROM project_supervisors ps
+			WHERE ps.project_id = p.id AND ps.supervisor_id = @user_id AND ps.status = @supervisor_active))`
+
+// ekspresi to_tsvector di bawah harus sama persis dengan index di 0004_search_indexes.sql
+var searchBranches = map[repository.SearchKind]string{
+	repository.SearchLog: `
+		SELECT 'log' AS kind, l.id, l.project_id, p.name AS project_name, l.milestone_id,
+			ts_headline('simple', l.description, q.query, ` + searchHeadline + `) AS snippet,
+			ts_rank(to_tsvector('simple', coalesce(l.description, '')), q.query) AS rank,
+			l.logged_at AS occurred_at
+		FROM logs l
+		JOIN projects p ON p.id = l.project_id AND p.deleted_at IS NULL, q
+		WHERE ` + searchAccess + ` AND l.deleted_at IS NULL
+			AND to_tsvector('simple', coalesce(l.description, '')) @@ q.query`,
+	repository.SearchMilestone: `
+		SELECT 'milestone' AS kind, m.id, m.project_id, p.name AS project_name, m.id AS milestone_id,
+			ts_headline('simple', m.name, q.query, ` + searchHeadline + `) AS snippet,
+			ts_rank(to_tsvector('simple', coalesce(m.name, '')), q.query) AS rank,
+			m.created_at AS occurred_at
+		FROM milestones m
+		JOIN projects p ON p.id = m.project_id AND p.deleted_at IS NULL, q
+		WHERE ` + searchAccess + ` AND m.deleted_at IS NULL
+			AND to_tsvector('simple', coalesce(m.name, '')) @@ q.query`,
+	repository.SearchProject: `
+		SELECT 'project' AS kind, p.id, p.id AS project_id, p.name AS project_name, NULL::uuid AS milestone_id,
+			ts_headline('simple', p.name, q.query, ` + searchHeadline + `) AS snippet,
+			ts_rank(to_tsvector('simple', coalesce(p.name, '')), q.query) AS rank,
+			p.created_at AS occurred_at
+		FROM projects p, q
+		WHERE ` + searchAccess + ` AND p.deleted_at IS NULL
+			AND to_tsvector('simple', coalesce(p.name, '')) @@ q.query`,
+}
+
+var searchOrder = []repository.SearchKind{repository.SearchLog, repository.SearchMilestone, repository.SearchProject}
+
+func (r *SearchPG) Search(ctx context.Context, q repository.SearchQuery) ([]repository.SearchHit, error) {
+	kinds := q.Kinds
+	if len(kinds) == 0 {
+		kinds = searchOrder
+	}
+
+	branches := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		if branch, ok := searchBranches[kind]; ok {
+			branches = append(branches, branch)
+		}
+	}
+
+	var filters []string
+	args := map[string]interface{}{
+		"query":   q.TSQuery,
+		"user_id": q.UserID,
+		"limit":   q.Limit,
+
+		"supervisor_active": model.SupervisorActive,
+	}
+	if q.ProjectID != nil {
+		filters = append(filters, "project_id = @project_id")
+		args["project_id"] = *q.ProjectID
+	}
+	if q.From != nil {
+		filters = append(filters, "occurred_at >= @from")
+		args["from"] = *q.From
+	}
+	if q.To != nil {
+		filters = append(filters, "occurred_at < @to")
+		args["to"] = *q.To
+	}
+
+	where := ""
+	if len(filters) > 0 {
+		where = "WHERE " + strings.Join(filters, " AND ")
+	}
+
+	sql := `WITH q AS (SELECT to_tsquery('simple', @query) AS query)
+		SELECT * FROM (` + strings.Join(branches, "\nUNION ALL\n") + `) hits
+		` + where + `
+		ORDER BY rank DESC, occurred_at DESC, id
+		LIMIT @limit`
+
+	var hits []repository.SearchHit
+	if err := conn(ctx, r.db).Raw(sql, args).Scan(&hits).Error; err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SprintPG struct {
+	db *gorm.DB
+}
+
+func NewSprintPG(db *gorm.DB) repository.SprintRepository {
+	return &SprintPG{db}
+}
+
+func (r *SprintPG) Create(ctx context.Context, s *model.Sprint) error {
+	return conn(ctx, r.db).Create(s).Error
+}
+
+func (r *SprintPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Sprint, error) {
+	var sprint model.Sprint
+	err := conn(ctx, r.db).Where(liveProject).First(&sprint, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+func (r *SprintPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Sprint, error) {
+	var res []model.Sprint
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Where(liveProject).Order("start_date asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SprintPG) Update(ctx context.Context, s *model.Sprint) error {
+	return conn(ctx, r.db).Save(s).Error
+}
+
+func (r *SprintPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.SprintItem{}, "sprint_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Sprint{}, "id = ?", id).Error
+	})
+}
+
+func (r *SprintPG) AddItems(ctx context.Context, items []model.SprintItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).Create(&items).Error
+}
+
+func (r *SprintPG) FindItems(ctx context.Context, sprintID uuid.UUID) ([]model.SprintItem, error) {
+	var res []model.SprintItem
+	err := conn(ctx, r.db).
+		Where("sprint_id = ?", sprintID).Order("added_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SprintPG) FindOpenItems(ctx context.Context, milestoneIDs, taskIDs []uuid.UUID) ([]model.SprintItem, error) {
+	var res []model.SprintItem
+	if len(milestoneIDs) == 0 && len(taskIDs) == 0 {
+		return res, nil
+	}
+
+	q := conn(ctx, r.db).Where("outcome = ?", model.OutcomeOpen)
+	switch {
+	case len(milestoneIDs) > 0 && len(taskIDs) > 0:
+		q = q.Where("milestone_id IN ? OR task_id IN ?", milestoneIDs, taskIDs)
+	case len(milestoneIDs) > 0:
+		q = q.Where("milestone_id IN ?", milestoneIDs)
+	default:
+		q = q.Where("task_id IN ?", taskIDs)
+	}
+
+	if err := q.Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *SprintPG) DeleteOpenItems(ctx context.Context, sprintID uuid.UUID, milestoneIDs, taskIDs []uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if len(milestoneIDs) > 0 {
+			err := tx.Where("sprint_id = ? AND outcome = ? AND milestone_id IN ?", sprintID, model.OutcomeOpen, milestoneIDs).
+				Delete(&model.SprintItem{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(taskIDs) > 0 {
+			err := tx.Where("sprint_id = ? AND outcome = ? AND task_id IN ?", sprintID, model.OutcomeOpen, taskIDs).
+				Delete(&model.SprintItem{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *SprintPG) Complete(ctx context.Context, s *model.Sprint, closed []model.SprintItem, carried []model.SprintItem) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(s).Error; err != nil {
+			return err
+		}
+		for i := range closed {
+			err := tx.Model(&model.SprintItem{}).Where("id = ?", closed[i].ID).
+				Updates(map[string]interface{}{"outcome": closed[i].Outcome, "closed_at": closed[i].ClosedAt}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(carried) > 0 {
+			return tx.Create(&carried).Error
+		}
+		return nil
+	})
+}
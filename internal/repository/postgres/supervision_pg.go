@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SupervisorPG struct {
+	db *gorm.DB
+}
+
+func NewSupervisorPG(db *gorm.DB) repository.SupervisorRepository {
+	return &SupervisorPG{db}
+}
+
+func (r *SupervisorPG) Create(ctx context.Context, s *model.ProjectSupervisor) error {
+	return conn(ctx, r.db).Create(s).Error
+}
+
+func (r *SupervisorPG) FindByID(ctx context.Context, id uuid.UUID) (*model.ProjectSupervisor, error) {
+	var s model.ProjectSupervisor
+	err := conn(ctx, r.db).First(&s, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *SupervisorPG) FindByProjectAndEmail(ctx context.Context, projectID uuid.UUID, email string) (*model.ProjectSupervisor, error) {
+	var s model.ProjectSupervisor
+	err := conn(ctx, r.db).First(&s, "project_id = ? AND email = ?", projectID, email).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *SupervisorPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.ProjectSupervisor, error) {
+	var res []model.ProjectSupervisor
+	err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SupervisorPG) FindPendingByEmail(ctx context.Context, email string) ([]model.ProjectSupervisor, error) {
+	var res []model.ProjectSupervisor
+	err := conn(ctx, r.db).
+		Where("email = ? AND status = ?", email, model.SupervisorPending).
+		Where("project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)").
+		Order("created_at desc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SupervisorPG) IsActive(ctx context.Context, projectID, supervisorID uuid.UUID) (bool, error) {
+	var n int64
+	err := conn(ctx, r.db).Model(&model.ProjectSupervisor{}).
+		Where("project_id = ? AND supervisor_id = ? AND status = ?", projectID, supervisorID, model.SupervisorActive).
+		Count(&n).Error
+	return n > 0, err
+}
+
+func (r *SupervisorPG) FindSupervisedProjects(ctx context.Context, supervisorID uuid.UUID) ([]model.Project, error) {
+	var res []model.Project
+	err := conn(ctx, r.db).
+		Joins("JOIN project_supervisors ps ON ps.project_id = projects.id").
+		Where("ps.supervisor_id = ? AND ps.status = ?", supervisorID, model.SupervisorActive).
+		Order("projects.name asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SupervisorPG) Update(ctx context.Context, s *model.ProjectSupervisor) error {
+	return conn(ctx, r.db).Save(s).Error
+}
+
+type CommentPG struct {
+	db *gorm.DB
+}
+
+func NewCommentPG(db *gorm.DB) repository.CommentRepository {
+	return &CommentPG{db}
+}
+
+func (r *CommentPG) Create(ctx context.Context, c *model.Comment) error {
+	return conn(ctx, r.db).Create(c).Error
+}
+
+func (r *CommentPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Comment, error) {
+	var c model.Comment
+	err := conn(ctx, r.db).First(&c, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *CommentPG) FindByTarget(ctx context.Context, targetType model.CommentTarget, targetID uuid.UUID) ([]model.Comment, error) {
+	var res []model.Comment
+	err := conn(ctx, r.db).
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *CommentPG) Update(ctx context.Context, c *model.Comment) error {
+	return conn(ctx, r.db).Save(c).Error
+}
+
+const commentThread = `
+	WITH RECURSIVE thread AS (
+		SELECT id FROM comments WHERE id = ?
+		UNION ALL
+		SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id
+	)`
+
+func (r *CommentPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(commentThread+` DELETE FROM comment_mentions WHERE comment_id IN (SELECT id FROM thread)`, id).Error; err != nil {
+			return err
+		}
+		return tx.Exec(commentThread+` DELETE FROM comments WHERE id IN (SELECT id FROM thread)`, id).Error
+	})
+}
+
+func (r *CommentPG) SetMentions(ctx context.Context, commentID uuid.UUID, userIDs []uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("comment_id = ?", commentID).Delete(&model.CommentMention{}).Error; err != nil {
+			return err
+		}
+		if len(userIDs) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		mentions := make([]model.CommentMention, len(userIDs))
+		for i, id := range userIDs {
+			mentions[i] = model.CommentMention{CommentID: commentID, UserID: id, CreatedAt: now}
+		}
+		return tx.Create(&mentions).Error
+	})
+}
+
+func (r *CommentPG) FindMentions(ctx context.Context, commentIDs []uuid.UUID) ([]model.CommentMention, error) {
+	var res []model.CommentMention
+	if len(commentIDs) == 0 {
+		return res, nil
+	}
+
+	err := conn(ctx, r.db).Where("comment_id IN ?", commentIDs).Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
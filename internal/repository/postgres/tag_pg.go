@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagPG struct {
+	db *gorm.DB
+}
+
+func NewTagPG(db *gorm.DB) repository.TagRepository {
+	return &TagPG{db}
+}
+
+func (r *TagPG) Create(ctx context.Context, t *model.Tag) error {
+	return conn(ctx, r.db).Create(t).Error
+}
+
+func (r *TagPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Tag, error) {
+	var t model.Tag
+	err := conn(ctx, r.db).First(&t, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *TagPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]repository.TagUsage, error) {
+	var res []repository.TagUsage
+	err := conn(ctx, r.db).Model(&model.Tag{}).
+		Select("tags.*, COUNT(logs.id) AS log_count, COALESCE(SUM(logs.duration_minutes), 0) AS total_minutes").
+		Joins("LEFT JOIN log_tags ON log_tags.tag_id = tags.id").
+		Joins("LEFT JOIN logs ON logs.id = log_tags.log_id AND logs.deleted_at IS NULL").
+		Where("tags.user_id = ?", userID).
+		Group("tags.id").Order("tags.name asc").
+		Scan(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *TagPG) FindOrCreate(ctx context.Context, userID uuid.UUID, names []string) ([]model.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags := make([]model.Tag, len(names))
+	for i, name := range names {
+		tags[i] = model.Tag{ID: uuid.New(), UserID: userID, Name: name}
+	}
+	err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "user_id"}, {Name: "name"}}, DoNothing: true}).
+		Create(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var res []model.Tag
+	err = conn(ctx, r.db).Where("user_id = ? AND name IN ?", userID, names).Find(&res).Error
+	return res, err
+}
+
+func (r *TagPG) Update(ctx context.Context, t *model.Tag) error {
+	return conn(ctx, r.db).Save(t).Error
+}
+
+func (r *TagPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&model.LogTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Tag{}, "id = ?", id).Error
+	})
+}
+
+func (r *TagPG) SetLogTags(ctx context.Context, logID uuid.UUID, tagIDs []uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("log_id = ?", logID).Delete(&model.LogTag{}).Error; err != nil {
+			return err
+		}
+		if len(tagIDs) == 0 {
+			return nil
+		}
+
+		links := make([]model.LogTag, len(tagIDs))
+		for i, id := range tagIDs {
+			links[i] = model.LogTag{LogID: logID, TagID: id}
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&links).Error
+	})
+}
+
+func (r *TagPG) FindNamesByLogs(ctx context.Context, logIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	res := make(map[uuid.UUID][]string)
+	if len(logIDs) == 0 {
+		return res, nil
+	}
+
+	var rows []struct {
+		LogID uuid.UUID
+		Name  string
+	}
+	err := conn(ctx, r.db).Model(&model.LogTag{}).
+		Select("log_tags.log_id, tags.name").
+		Joins("JOIN tags ON tags.id = log_tags.tag_id").
+		Where("log_tags.log_id IN ?", logIDs).
+		Order("tags.name asc").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		res[row.LogID] = append(res[row.LogID], row.Name)
+	}
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TaskPG struct {
+	db *gorm.DB
+}
+
+func NewTaskPG(db *gorm.DB) repository.TaskRepository {
+	return &TaskPG{db}
+}
+
+func (r *TaskPG) Create(ctx context.Context, t *model.Task) error {
+	return conn(ctx, r.db).Create(t).Error
+}
+
+func (r *TaskPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	var task model.Task
+	err := conn(ctx, r.db).First(&task, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+func (r *TaskPG) FindByMilestone(ctx context.Context, milestoneID uuid.UUID) ([]model.Task, error) {
+	var res []model.Task
+	err := conn(ctx, r.db).
+		Where("milestone_id = ?", milestoneID).Order("order_idx asc, created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *TaskPG) FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Task, error) {
+	var res []model.Task
+	err := conn(ctx, r.db).
+		Joins("JOIN milestones ON milestones.id = tasks.milestone_id").
+		Where("milestones.project_id = ?", projectID).
+		Order("tasks.order_idx asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *TaskPG) Update(ctx context.Context, t *model.Task) error {
+	return conn(ctx, r.db).Save(t).Error
+}
+
+func (r *TaskPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Unscoped().Delete(&model.Task{}, "id = ?", id).Error
+}
+
+func (r *TaskPG) FindByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]model.Task, error) {
+	var res []model.Task
+	if len(projectIDs) == 0 {
+		return res, nil
+	}
+
+	err := conn(ctx, r.db).
+		Joins("JOIN milestones ON milestones.id = tasks.milestone_id").
+		Where("milestones.project_id IN ?", projectIDs).
+		Order("tasks.order_idx asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TimerPG struct {
+	db *gorm.DB
+}
+
+func NewTimerPG(db *gorm.DB) repository.TimerRepository {
+	return &TimerPG{db}
+}
+
+func (r *TimerPG) Create(ctx context.Context, t *model.Timer) error {
+	return conn(ctx, r.db).Create(t).Error
+}
+
+func (r *TimerPG) FindByUser(ctx context.Context, userID uuid.UUID) (*model.Timer, error) {
+	var t model.Timer
+	err := conn(ctx, r.db).First(&t, "user_id = ?", userID).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *TimerPG) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&model.Timer{}, "id = ?", id).Error
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TimesheetPG struct {
+	db *gorm.DB
+}
+
+func NewTimesheetPG(db *gorm.DB) repository.TimesheetRepository {
+	return &TimesheetPG{db}
+}
+
+func (r *TimesheetPG) Create(ctx context.Context, t *model.Timesheet) error {
+	return conn(ctx, r.db).Create(t).Error
+}
+
+func (r *TimesheetPG) FindByID(ctx context.Context, id uuid.UUID) (*model.Timesheet, error) {
+	var t model.Timesheet
+	err := conn(ctx, r.db).First(&t, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *TimesheetPG) FindByWeek(ctx context.Context, projectID, userID uuid.UUID, weekStart time.Time) (*model.Timesheet, error) {
+	var t model.Timesheet
+	err := conn(ctx, r.db).
+		Where("project_id = ? AND user_id = ? AND week_start = ?", projectID, userID, weekStart.Format("2006-01-02")).
+		First(&t).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *TimesheetPG) FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Timesheet, error) {
+	var res []model.Timesheet
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).Order("week_start desc, project_id").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *TimesheetPG) FindByProjects(ctx context.Context, projectIDs []uuid.UUID, status model.TimesheetStatus) ([]model.Timesheet, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	q := conn(ctx, r.db).Where("project_id IN ?", projectIDs)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+
+	var res []model.Timesheet
+	if err := q.Order("submitted_at asc").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *TimesheetPG) Update(ctx context.Context, t *model.Timesheet) error {
+	return conn(ctx, r.db).Save(t).Error
+}
+
+func (r *TimesheetPG) CreateComment(ctx context.Context, c *model.TimesheetComment) error {
+	return conn(ctx, r.db).Create(c).Error
+}
+
+func (r *TimesheetPG) FindComments(ctx context.Context, timesheetID uuid.UUID) ([]model.TimesheetComment, error) {
+	var res []model.TimesheetComment
+	err := conn(ctx, r.db).
+		Where("timesheet_id = ?", timesheetID).Order("created_at asc").Find(&res).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"testing"
+
+	"devtracker/pkg/util"
+)
+
+// TestUniqueViolationFromDriver memastikan error duplicate dari driver GORM yang dipakai
+// aplikasi dikenali util.IsUniqueViolation. Butuh Postgres: TEST_DATABASE_DSN.
+func TestUniqueViolationFromDriver(t *testing.T) {
+	tx := testTx(t)
+
+	if err := tx.Exec("CREATE TEMP TABLE uniq_probe (name text PRIMARY KEY) ON COMMIT DROP").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Exec("INSERT INTO uniq_probe (name) VALUES ('devtracker')").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	err := tx.Exec("INSERT INTO uniq_probe (name) VALUES ('devtracker')").Error
+	if err == nil {
+		t.Fatal("expected duplicate insert to fail")
+	}
+	if !util.IsUniqueViolation(err) {
+		t.Fatalf("IsUniqueViolation(%T: %v) = false, want true", err, err)
+	}
+}
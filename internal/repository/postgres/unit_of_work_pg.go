@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+
+	"devtracker/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+type UnitOfWorkPG struct {
+	db *gorm.DB
+}
+
+func NewUnitOfWorkPG(db *gorm.DB) repository.UnitOfWork {
+	return &UnitOfWorkPG{db}
+}
+
+// Do membuka transaksi dan menyimpannya di context. Panggilan bersarang ikut
+// transaksi terluar supaya commit/rollback tetap satu kesatuan.
+func (u *UnitOfWorkPG) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn mengembalikan transaksi aktif dari UnitOfWork jika ada, selain itu koneksi biasa.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -19,13 +19,13 @@ func NewUserPG(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *UserPG) Create(ctx context.Context, user *model.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return conn(ctx, r.db).Create(user).Error
 }
 
 // user_pg.go - FIXED
 func (r *UserPG) FindByEmail(ctx context.Context, email string) (*model.User, error) {
     var user model.User
-    err := r.db.WithContext(ctx).First(&user, "email = ?", email).Error
+    err := conn(ctx, r.db).First(&user, "email = ?", email).Error
     
     // Handle record not found
     if err != nil {
@@ -40,7 +40,7 @@ func (r *UserPG) FindByEmail(ctx context.Context, email string) (*model.User, er
 
 func (r *UserPG) FindByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
     var user model.User
-    err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error
+    err := conn(ctx, r.db).First(&user, "id = ?", id).Error
     
     if err != nil {
         if err == gorm.ErrRecordNotFound {
@@ -52,11 +52,22 @@ func (r *UserPG) FindByID(ctx context.Context, id uuid.UUID) (*model.User, error
     return &user, nil
 }
 
+func (r *UserPG) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]model.User, error) {
+	var res []model.User
+	if len(ids) == 0 {
+		return res, nil
+	}
+	if err := conn(ctx, r.db).Where("id IN ?", ids).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (r *UserPG) Update(ctx context.Context, user *model.User) error {
-	return r.db.WithContext(ctx).Save(user).Error
+	return conn(ctx, r.db).Save(user).Error
 }
 
 func (r *UserPG) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.User{}, "id = ?", id).Error
+	return conn(ctx, r.db).Delete(&model.User{}, "id = ?", id).Error
 }
 
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"devtracker/internal/domain/model"
 
@@ -12,7 +13,20 @@ import (
 type ProjectRepository interface {
 	Create(ctx context.Context, p *model.Project) error
 	FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Project, error)
+	// List mengambil satu halaman project milik q.UserID; Status: active, archived atau all.
+	List(ctx context.Context, q ListQuery) (*Page[model.Project], error)
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Project, error)
+	// Lock mengunci baris project (SELECT ... FOR UPDATE) sampai transaksi UnitOfWork selesai.
+	Lock(ctx context.Context, id uuid.UUID) error
 	Update(ctx context.Context, p *model.Project) error
+	// Delete memindahkan project beserta milestone, task, log, insight dan report ke trash (soft delete)
+	// dan membuang timer yang sedang berjalan di project itu.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	FindTrashedByUser(ctx context.Context, userID uuid.UUID) ([]model.Project, error)
+	FindTrashedByID(ctx context.Context, id uuid.UUID) (*model.Project, error)
+	// Restore mengembalikan project dan child yang ikut terhapus bersamanya.
+	Restore(ctx context.Context, p *model.Project) error
+	// PurgeTrashed menghapus permanen project yang masuk trash sebelum cutoff beserta semua datanya.
+	PurgeTrashed(ctx context.Context, cutoff time.Time) (int64, error)
 }
\ No newline at end of file
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type ProjectRevisionRepository interface {
+	Create(ctx context.Context, r *model.ProjectRevision) error
+	// FindByProject mengembalikan revisi urut dari versi tertua.
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.ProjectRevision, error)
+	LatestVersion(ctx context.Context, projectID uuid.UUID) (int, error)
+}
@@ -13,6 +13,8 @@ type ReportRepository interface {
 	Create(ctx context.Context, report *model.Report) error
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Report, error)
 	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Report, error)
+	// List mengambil satu halaman report; filter ProjectID dan rentang GeneratedAt.
+	List(ctx context.Context, q ListQuery) (*Page[model.Report], error)
 	Update(ctx context.Context, report *model.Report) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
\ No newline at end of file
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type ScopeChangeRepository interface {
+	Create(ctx context.Context, c *model.ScopeChange) error
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.ScopeChange, error)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchKind string
+
+const (
+	SearchLog       SearchKind = "log"
+	SearchMilestone SearchKind = "milestone"
+	SearchProject   SearchKind = "project"
+)
+
+// SearchQuery dibatasi pada project milik UserID dan yang ia supervisi secara aktif. TSQuery sudah berupa sintaks to_tsquery
+// yang aman (lihat service.buildPrefixQuery). From/To memfilter waktu hit, [From, To).
+type SearchQuery struct {
+	UserID    uuid.UUID
+	TSQuery   string
+	ProjectID *uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	Kinds     []SearchKind // kosong = semua
+	Limit     int
+}
+
+// SearchHit adalah satu hasil pencarian. Snippet menandai kata yang cocok dengan <mark></mark>.
+// OccurredAt adalah LoggedAt untuk log dan CreatedAt untuk milestone/project.
+type SearchHit struct {
+	Kind        SearchKind
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	ProjectName string
+	MilestoneID *uuid.UUID
+	Snippet     string
+	Rank        float64
+	OccurredAt  time.Time
+}
+
+type SearchRepository interface {
+	Search(ctx context.Context, q SearchQuery) ([]SearchHit, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type SprintRepository interface {
+	Create(ctx context.Context, s *model.Sprint) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Sprint, error)
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Sprint, error)
+	Update(ctx context.Context, s *model.Sprint) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	AddItems(ctx context.Context, items []model.SprintItem) error
+	FindItems(ctx context.Context, sprintID uuid.UUID) ([]model.SprintItem, error)
+	FindOpenItems(ctx context.Context, milestoneIDs, taskIDs []uuid.UUID) ([]model.SprintItem, error)
+	DeleteOpenItems(ctx context.Context, sprintID uuid.UUID, milestoneIDs, taskIDs []uuid.UUID) error
+	// Complete menutup sprint, memperbarui outcome item, dan membuat item carry-over dalam satu transaksi.
+	Complete(ctx context.Context, s *model.Sprint, closed []model.SprintItem, carried []model.SprintItem) error
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type SupervisorRepository interface {
+	Create(ctx context.Context, s *model.ProjectSupervisor) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ProjectSupervisor, error)
+	FindByProjectAndEmail(ctx context.Context, projectID uuid.UUID, email string) (*model.ProjectSupervisor, error)
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.ProjectSupervisor, error)
+	// FindPendingByEmail mengembalikan undangan yang belum dijawab untuk email tersebut.
+	FindPendingByEmail(ctx context.Context, email string) ([]model.ProjectSupervisor, error)
+	// IsActive bernilai true jika user adalah supervisor aktif project.
+	IsActive(ctx context.Context, projectID, supervisorID uuid.UUID) (bool, error)
+	// FindSupervisedProjects mengembalikan project (belum di-trash) yang disupervisi user.
+	FindSupervisedProjects(ctx context.Context, supervisorID uuid.UUID) ([]model.Project, error)
+	Update(ctx context.Context, s *model.ProjectSupervisor) error
+}
+
+type CommentRepository interface {
+	Create(ctx context.Context, c *model.Comment) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Comment, error)
+	// FindByTarget mengembalikan semua komentar sebuah target terurut kronologis.
+	FindByTarget(ctx context.Context, targetType model.CommentTarget, targetID uuid.UUID) ([]model.Comment, error)
+	Update(ctx context.Context, c *model.Comment) error
+	// Delete menghapus komentar beserta seluruh balasannya.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// SetMentions mengganti daftar user yang di-mention di komentar.
+	SetMentions(ctx context.Context, commentID uuid.UUID, userIDs []uuid.UUID) error
+	FindMentions(ctx context.Context, commentIDs []uuid.UUID) ([]model.CommentMention, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// TagUsage adalah tag beserta jumlah log dan total menit yang memakainya.
+type TagUsage struct {
+	model.Tag
+	LogCount     int
+	TotalMinutes int
+}
+
+type TagRepository interface {
+	Create(ctx context.Context, t *model.Tag) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Tag, error)
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]TagUsage, error)
+	// FindOrCreate mengembalikan tag dengan nama-nama tersebut, membuat yang belum ada.
+	FindOrCreate(ctx context.Context, userID uuid.UUID, names []string) ([]model.Tag, error)
+	Update(ctx context.Context, t *model.Tag) error
+	// Delete menghapus tag beserta tautannya ke log.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// SetLogTags mengganti seluruh tag sebuah log.
+	SetLogTags(ctx context.Context, logID uuid.UUID, tagIDs []uuid.UUID) error
+	// FindNamesByLogs mengembalikan nama tag per log ID, terurut alfabetis.
+	FindNamesByLogs(ctx context.Context, logIDs []uuid.UUID) (map[uuid.UUID][]string, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type TaskRepository interface {
+	Create(ctx context.Context, t *model.Task) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Task, error)
+	FindByMilestone(ctx context.Context, milestoneID uuid.UUID) ([]model.Task, error)
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]model.Task, error)
+	FindByProjects(ctx context.Context, projectIDs []uuid.UUID) ([]model.Task, error)
+	Update(ctx context.Context, t *model.Task) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
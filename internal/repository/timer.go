@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type TimerRepository interface {
+	Create(ctx context.Context, t *model.Timer) error
+	FindByUser(ctx context.Context, userID uuid.UUID) (*model.Timer, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+type TimesheetRepository interface {
+	Create(ctx context.Context, t *model.Timesheet) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Timesheet, error)
+	// FindByWeek mengembalikan timesheet untuk minggu weekStart (tanggal Senin), nil jika belum diajukan.
+	FindByWeek(ctx context.Context, projectID, userID uuid.UUID, weekStart time.Time) (*model.Timesheet, error)
+	// FindByUser mengembalikan timesheet milik user, minggu terbaru dulu.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]model.Timesheet, error)
+	// FindByProjects mengembalikan timesheet di project-project tersebut; status kosong = semua.
+	FindByProjects(ctx context.Context, projectIDs []uuid.UUID, status model.TimesheetStatus) ([]model.Timesheet, error)
+	Update(ctx context.Context, t *model.Timesheet) error
+
+	CreateComment(ctx context.Context, c *model.TimesheetComment) error
+	// FindComments mengembalikan komentar timesheet terurut kronologis.
+	FindComments(ctx context.Context, timesheetID uuid.UUID) ([]model.TimesheetComment, error)
+}
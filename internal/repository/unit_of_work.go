@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// UnitOfWork menjalankan beberapa operasi repository dalam satu transaksi.
+// Repository yang dipanggil dengan ctx dari fn otomatis memakai transaksi yang sama;
+// fn yang mengembalikan error membatalkan semua perubahan.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -11,6 +11,8 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
 	FindByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+	// FindByIDs mengambil banyak user sekaligus; id yang tidak ada dilewati.
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]model.User, error)
 	FindByEmail(ctx context.Context, email string) (*model.User, error)
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
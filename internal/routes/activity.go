@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupActivityRoutes(app fiber.Router, handler *handler.ActivityHandler) {
+	app.Get("/projects/:id/activity", handler.GetProjectActivity)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupAnalyticsRoutes(app fiber.Router, handler *handler.AnalyticsHandler) {
+	app.Get("/projects/:id/estimates", handler.GetEstimateReport)
+	app.Get("/projects/:id/burndown", handler.GetProjectBurndown)
+	app.Get("/projects/:id/burnup", handler.GetProjectBurnup)
+	app.Get("/sprints/:id/burndown", handler.GetSprintBurndown)
+	app.Get("/sprints/:id/burnup", handler.GetSprintBurnup)
+	app.Get("/users/me/heatmap", handler.GetUserHeatmap)
+	app.Get("/users/me/breakdown", handler.GetTimeBreakdown)
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupBillingRoutes(app fiber.Router, handler *handler.BillingHandler) {
+	clients := app.Group("/clients")
+	clients.Get("/", handler.GetClients)
+	clients.Post("/", handler.CreateClient)
+	clients.Get("/:id", handler.GetClient)
+	clients.Put("/:id", handler.UpdateClient)
+	clients.Delete("/:id", handler.DeleteClient)
+
+	app.Put("/projects/:id/client", handler.SetProjectClient)
+
+	rates := app.Group("/rates")
+	rates.Get("/", handler.GetRates)
+	rates.Post("/", handler.CreateRate)
+	rates.Delete("/:id", handler.DeleteRate)
+
+	invoices := app.Group("/invoices")
+	invoices.Get("/", handler.GetInvoices)
+	invoices.Post("/", handler.CreateInvoice)
+	invoices.Get("/:id", handler.GetInvoice)
+	invoices.Get("/:id/pdf", handler.GetInvoicePDF)
+	invoices.Post("/:id/send", handler.SendInvoice)
+	invoices.Post("/:id/pay", handler.PayInvoice)
+	invoices.Delete("/:id", handler.DeleteInvoice)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupCalendarRoutes(app fiber.Router, handler *handler.CalendarHandler, plannerHandler *handler.PlannerHandler) {
+	me := app.Group("/users/me")
+
+	me.Get("/calendar", handler.GetCalendar)
+	me.Put("/calendar", handler.UpdateCalendar)
+	me.Get("/availability", handler.GetAvailability)
+	me.Get("/plan", plannerHandler.GetPlan)
+
+	me.Get("/holidays", handler.GetHolidays)
+	me.Post("/holidays", handler.CreateHoliday)
+	me.Post("/holidays/import", handler.ImportHolidays)
+	me.Delete("/holidays/:id", handler.DeleteHoliday)
+
+	me.Get("/time-off", handler.GetTimeOff)
+	me.Post("/time-off", handler.CreateTimeOff)
+	me.Delete("/time-off/:id", handler.DeleteTimeOff)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupCommentRoutes(app fiber.Router, handler *handler.CommentHandler) {
+	app.Get("/projects/:id/comments", handler.GetProjectComments)
+	app.Post("/projects/:id/comments", handler.CreateProjectComment)
+	app.Get("/milestones/:id/comments", handler.GetMilestoneComments)
+	app.Post("/milestones/:id/comments", handler.CreateMilestoneComment)
+	app.Get("/logs/:id/comments", handler.GetLogComments)
+	app.Post("/logs/:id/comments", handler.CreateLogComment)
+	app.Get("/insights/:id/comments", handler.GetInsightComments)
+	app.Post("/insights/:id/comments", handler.CreateInsightComment)
+
+	comments := app.Group("/comments")
+	comments.Put("/:id", handler.UpdateComment)
+	comments.Delete("/:id", handler.DeleteComment)
+}
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupDigestRoutes(app fiber.Router, handler *handler.DigestHandler) {
+	digests := app.Group("/digests")
+
+	digests.Get("/preview", handler.PreviewDigest)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupGoalRoutes(app fiber.Router, handler *handler.GoalHandler) {
+	goals := app.Group("/goals")
+
+	goals.Post("/", handler.CreateGoal)
+	goals.Get("/", handler.GetGoals)
+	goals.Get("/:id", handler.GetGoal)
+	goals.Put("/:id", handler.UpdateGoal)
+	goals.Delete("/:id", handler.DeleteGoal)
+	goals.Get("/:id/history", handler.GetGoalHistory)
+}
@@ -15,6 +15,25 @@ type RouteHandlers struct {
     Log       *handler.LogHandler
     Report    *handler.ReportHandler
     User      *handler.UserHandler
+    Schedule  *handler.ScheduleHandler
+    Analytics *handler.AnalyticsHandler
+    Task      *handler.TaskHandler
+    Sprint    *handler.SprintHandler
+    Timer     *handler.TimerHandler
+    Dashboard *handler.DashboardHandler
+    Goal      *handler.GoalHandler
+    Calendar  *handler.CalendarHandler
+    Planner   *handler.PlannerHandler
+    Search    *handler.SearchHandler
+    Tag       *handler.TagHandler
+    Billing   *handler.BillingHandler
+    Timesheet *handler.TimesheetHandler
+    Supervision *handler.SupervisionHandler
+    Comment   *handler.CommentHandler
+    Activity  *handler.ActivityHandler
+    Notification *handler.NotificationHandler
+    Digest    *handler.DigestHandler
+    Stream    *handler.StreamHandler
 }
 
 func SetupRoutes(app *fiber.App, handlers RouteHandlers) {
@@ -33,7 +52,24 @@ func SetupRoutes(app *fiber.App, handlers RouteHandlers) {
     setupAIInsightRoutes(protected, handlers.AIInsight)
     setupProjectRoutes(protected, handlers.Project, handlers.Milestone, handlers.Log, handlers.Report)
     setupMilestoneRoutes(protected, handlers.Milestone)
+    setupTaskRoutes(protected, handlers.Task)
     setupLogRoutes(protected, handlers.Log)
     setupReportRoutes(protected, handlers.Report)
-    setupUserRoutes(protected, handlers.User)
+    setupUserRoutes(protected, handlers.User, handlers.Dashboard)
+    setupScheduleRoutes(protected, handlers.Schedule)
+    setupAnalyticsRoutes(protected, handlers.Analytics)
+    setupSprintRoutes(protected, handlers.Sprint)
+    setupTimerRoutes(protected, handlers.Timer)
+    setupGoalRoutes(protected, handlers.Goal)
+    setupCalendarRoutes(protected, handlers.Calendar, handlers.Planner)
+    setupSearchRoutes(protected, handlers.Search)
+    setupTagRoutes(protected, handlers.Tag)
+    setupBillingRoutes(protected, handlers.Billing)
+    setupTimesheetRoutes(protected, handlers.Timesheet)
+    setupSupervisionRoutes(protected, handlers.Supervision)
+    setupCommentRoutes(protected, handlers.Comment)
+    setupActivityRoutes(protected, handlers.Activity)
+    setupNotificationRoutes(protected, handlers.Notification)
+    setupDigestRoutes(protected, handlers.Digest)
+    setupStreamRoutes(protected, handlers.Stream)
 }
\ No newline at end of file
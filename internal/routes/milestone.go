@@ -14,6 +14,16 @@ func setupMilestoneRoutes(app fiber.Router, handler *handler.MilestoneHandler) {
 	milestones.Put("/:id", handler.UpdateMilestone)
 	milestones.Delete("/:id", handler.DeleteMilestone)
 
+	// Lifecycle transitions
+	milestones.Patch("/:id/start", handler.StartMilestone)
+	milestones.Patch("/:id/complete", handler.CompleteMilestone)
+	milestones.Patch("/:id/reopen", handler.ReopenMilestone)
+	milestones.Patch("/:id/block", handler.BlockMilestone)
+	milestones.Patch("/:id/unblock", handler.UnblockMilestone)
+	milestones.Get("/:id/history", handler.GetMilestoneHistory)
 
-	// milestones.Patch("/:id/complete", handler.CompleteMilestone) // TODO
-}
\ No newline at end of file
+	// Dependencies
+	milestones.Post("/:id/dependencies", handler.AddDependency)
+	milestones.Get("/:id/dependencies", handler.GetDependencies)
+	milestones.Delete("/:id/dependencies/:dependsOnID", handler.RemoveDependency)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupNotificationRoutes(app fiber.Router, handler *handler.NotificationHandler) {
+	notifications := app.Group("/notifications")
+
+	notifications.Get("/", handler.GetNotifications)
+	notifications.Get("/unread-count", handler.GetUnreadCount)
+	notifications.Post("/read-all", handler.MarkAllRead)
+	notifications.Get("/preferences", handler.GetPreferences)
+	notifications.Put("/preferences", handler.UpdatePreferences)
+	notifications.Post("/preferences/webhook-secret", handler.RotateWebhookSecret)
+	notifications.Post("/:id/read", handler.MarkRead)
+}
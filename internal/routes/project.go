@@ -18,13 +18,24 @@ func setupProjectRoutes(
 	// Project CRUD
 	projects.Post("/", projectHandler.CreateProject)
 	projects.Get("/", projectHandler.ListProjectsByUser)
+	// trash didaftarkan sebelum /:id supaya tidak ditangkap sebagai ID
+	projects.Get("/trash", projectHandler.GetTrash)
 	projects.Get("/:id", projectHandler.GetProjectByID)
 	projects.Put("/:id", projectHandler.UpdateProject)
 	projects.Delete("/:id", projectHandler.DeleteProject)
+	projects.Get("/:id/history", projectHandler.GetProjectHistory)
+	projects.Post("/:id/archive", projectHandler.ArchiveProject)
+	projects.Post("/:id/unarchive", projectHandler.UnarchiveProject)
+	projects.Post("/:id/restore", projectHandler.RestoreProject)
 
 	// ✅ Nested: Milestones under Project
 	projects.Post("/:id/milestones", milestoneHandler.CreateMilestone)
 	projects.Get("/:id/milestones", milestoneHandler.GetMilestonesByProject)
+	projects.Put("/:id/milestones/order", milestoneHandler.ReorderMilestones)
+
+	// ✅ Nested: Kanban board under Project
+	projects.Get("/:id/board", milestoneHandler.GetBoard)
+	projects.Put("/:id/board/limits", milestoneHandler.UpdateWIPLimits)
 
 	// ✅ Nested: Logs under Project
 	projects.Post("/:id/logs", logHandler.CreateLog)
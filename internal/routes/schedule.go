@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupScheduleRoutes(app fiber.Router, handler *handler.ScheduleHandler) {
+	app.Get("/projects/:id/schedule", handler.GetProjectSchedule)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupSearchRoutes(app fiber.Router, handler *handler.SearchHandler) {
+	app.Get("/search", handler.Search)
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupSprintRoutes(app fiber.Router, handler *handler.SprintHandler) {
+
+	app.Post("/projects/:id/sprints", handler.CreateSprint)
+	app.Get("/projects/:id/sprints", handler.GetSprintsByProject)
+
+	sprints := app.Group("/sprints")
+
+	sprints.Get("/:id", handler.GetSprintByID)
+	sprints.Put("/:id", handler.UpdateSprint)
+	sprints.Delete("/:id", handler.DeleteSprint)
+	sprints.Post("/:id/start", handler.StartSprint)
+	sprints.Post("/:id/items", handler.AssignItems)
+	sprints.Post("/:id/items/remove", handler.UnassignItems)
+	sprints.Post("/:id/complete", handler.CompleteSprint)
+	sprints.Get("/:id/report", handler.GetSprintReport)
+}
@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupStreamRoutes(app fiber.Router, handler *handler.StreamHandler) {
+	app.Get("/stream", handler.StreamUser)
+	app.Get("/projects/:id/stream", handler.StreamProject)
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupSupervisionRoutes(app fiber.Router, handler *handler.SupervisionHandler) {
+	// dikelola pemilik project
+	app.Get("/projects/:id/supervisors", handler.GetSupervisors)
+	app.Post("/projects/:id/supervisors", handler.InviteSupervisor)
+	app.Delete("/projects/:id/supervisors/:supervisorID", handler.RevokeSupervisor)
+
+	supervision := app.Group("/supervision")
+
+	supervision.Get("/invites", handler.GetInvites)
+	supervision.Post("/invites/:id/accept", handler.AcceptInvite)
+	supervision.Post("/invites/:id/decline", handler.DeclineInvite)
+
+	// read-only untuk pemilik dan supervisor aktif
+	supervision.Get("/projects", handler.GetCohort)
+	supervision.Get("/projects/:id", handler.GetProject)
+	supervision.Get("/projects/:id/milestones", handler.GetMilestones)
+	supervision.Get("/projects/:id/logs", handler.GetLogs)
+	supervision.Get("/projects/:id/insights", handler.GetInsights)
+}
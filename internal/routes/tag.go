@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupTagRoutes(app fiber.Router, handler *handler.TagHandler) {
+	tags := app.Group("/tags")
+
+	tags.Get("/", handler.GetTags)
+	tags.Post("/", handler.CreateTag)
+	tags.Get("/categories", handler.GetCategories)
+	tags.Put("/:id", handler.UpdateTag)
+	tags.Delete("/:id", handler.DeleteTag)
+}
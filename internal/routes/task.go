@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupTaskRoutes(app fiber.Router, handler *handler.TaskHandler) {
+
+	tasks := app.Group("/milestones/:id/tasks")
+
+	tasks.Post("/", handler.CreateTask)
+	tasks.Get("/", handler.GetTasksByMilestone)
+	tasks.Put("/:taskID", handler.UpdateTask)
+	tasks.Delete("/:taskID", handler.DeleteTask)
+}
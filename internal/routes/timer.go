@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupTimerRoutes(app fiber.Router, handler *handler.TimerHandler) {
+	timer := app.Group("/timer")
+
+	timer.Get("/", handler.GetRunningTimer)
+	timer.Post("/start", handler.StartTimer)
+	timer.Post("/stop", handler.StopTimer)
+}
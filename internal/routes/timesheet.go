@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"devtracker/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupTimesheetRoutes(app fiber.Router, handler *handler.TimesheetHandler) {
+	timesheets := app.Group("/timesheets")
+
+	timesheets.Get("/", handler.GetMyTimesheets)
+	timesheets.Post("/", handler.SubmitTimesheet)
+	timesheets.Get("/week", handler.GetWeek)
+	timesheets.Get("/inbox", handler.GetInbox)
+	timesheets.Get("/:id", handler.GetTimesheet)
+	timesheets.Post("/:id/approve", handler.ApproveTimesheet)
+	timesheets.Post("/:id/reject", handler.RejectTimesheet)
+	timesheets.Post("/:id/comments", handler.AddComment)
+}
@@ -13,11 +13,11 @@ func setupAuthRoutes(app fiber.Router, handler *handler.UserHandler){
 	auth.Post("/login", handler.Login)
 }
 
-func setupUserRoutes(app fiber.Router, handler *handler.UserHandler)  {
+func setupUserRoutes(app fiber.Router, handler *handler.UserHandler, dashboardHandler *handler.DashboardHandler)  {
 	user := app.Group("/users")
 
 	user.Get("/me", handler.GetCurrentUser)
 	user.Put("/me", handler.UpdateProfile)
-	// user.Get("/dashboard", handler.GetDashboard)
+	user.Get("/dashboard", dashboardHandler.GetDashboard)
 	user.Delete("/me", handler.DeleteAccount)
 }
\ No newline at end of file
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+type ProjectRole string
+
+const (
+	RoleOwner      ProjectRole = "owner"
+	RoleSupervisor ProjectRole = "supervisor"
+)
+
+// AccessChecker menentukan peran user terhadap project. Pemilik bisa membaca dan mengubah;
+// supervisor aktif hanya membaca dan memberi komentar review.
+type AccessChecker struct {
+	projectRepo    repository.ProjectRepository
+	supervisorRepo repository.SupervisorRepository
+}
+
+func NewAccessChecker(projectRepo repository.ProjectRepository, supervisorRepo repository.SupervisorRepository) *AccessChecker {
+	return &AccessChecker{projectRepo: projectRepo, supervisorRepo: supervisorRepo}
+}
+
+// Role mengembalikan peran user di project, "" jika tidak punya akses.
+func (a *AccessChecker) Role(ctx context.Context, userID uuid.UUID, project *model.Project) (ProjectRole, error) {
+	if project.UserID == userID {
+		return RoleOwner, nil
+	}
+
+	active, err := a.supervisorRepo.IsActive(ctx, project.ID, userID)
+	if err != nil {
+		return "", err
+	}
+	if active {
+		return RoleSupervisor, nil
+	}
+	return "", nil
+}
+
+// CanView memastikan user adalah pemilik atau supervisor aktif project.
+func (a *AccessChecker) CanView(ctx context.Context, userID, projectID uuid.UUID) (*model.Project, ProjectRole, error) {
+	project, err := a.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, "", err
+	}
+	if project == nil {
+		return nil, "", util.ErrNotFound("project not found")
+	}
+
+	role, err := a.Role(ctx, userID, project)
+	if err != nil {
+		return nil, "", err
+	}
+	if role == "" {
+		return nil, "", util.ErrUnauthorized("you do not have access to this project")
+	}
+	return project, role, nil
+}
+
+// CanEdit memastikan user adalah pemilik project. Supervisor ditolak karena hanya bisa
+// membaca; user lain mendapat 404 supaya keberadaan project tidak bocor.
+func (a *AccessChecker) CanEdit(ctx context.Context, userID, projectID uuid.UUID) (*model.Project, error) {
+	project, err := a.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, util.ErrNotFound("project not found")
+	}
+
+	role, err := a.Role(ctx, userID, project)
+	if err != nil {
+		return nil, err
+	}
+	switch role {
+	case RoleOwner:
+		return project, nil
+	case RoleSupervisor:
+		return nil, util.ErrUnauthorized("supervisors have read-only access to this project")
+	}
+	return nil, util.ErrNotFound("project not found")
+}
+
+// Members mengembalikan pemilik project dan semua supervisor aktifnya.
+func (a *AccessChecker) Members(ctx context.Context, project *model.Project) ([]uuid.UUID, error) {
+	members := []uuid.UUID{project.UserID}
+	sups, err := a.supervisorRepo.FindByProject(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sup := range sups {
+		if sup.Status == model.SupervisorActive && sup.SupervisorID != nil {
+			members = append(members, *sup.SupervisorID)
+		}
+	}
+	return members, nil
+}
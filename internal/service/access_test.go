@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// memorySupervisorRepo menyimpan undangan di memori; IsActive membaca status terkini
+// sehingga accept/revoke langsung terlihat oleh AccessChecker.
+type memorySupervisorRepo struct {
+	repository.SupervisorRepository
+	invites map[uuid.UUID]*model.ProjectSupervisor
+}
+
+func (r *memorySupervisorRepo) FindByID(_ context.Context, id uuid.UUID) (*model.ProjectSupervisor, error) {
+	inv, ok := r.invites[id]
+	if !ok {
+		return nil, nil
+	}
+	cp := *inv
+	return &cp, nil
+}
+
+func (r *memorySupervisorRepo) Update(_ context.Context, s *model.ProjectSupervisor) error {
+	cp := *s
+	r.invites[s.ID] = &cp
+	return nil
+}
+
+func (r *memorySupervisorRepo) IsActive(_ context.Context, projectID, supervisorID uuid.UUID) (bool, error) {
+	for _, inv := range r.invites {
+		if inv.ProjectID == projectID && inv.Status == model.SupervisorActive &&
+			inv.SupervisorID != nil && *inv.SupervisorID == supervisorID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memorySupervisorRepo) FindByProject(_ context.Context, projectID uuid.UUID) ([]model.ProjectSupervisor, error) {
+	var res []model.ProjectSupervisor
+	for _, inv := range r.invites {
+		if inv.ProjectID == projectID {
+			res = append(res, *inv)
+		}
+	}
+	return res, nil
+}
+
+type singleProjectRepo struct {
+	repository.ProjectRepository
+	project *model.Project
+}
+
+func (r singleProjectRepo) FindByID(_ context.Context, id uuid.UUID) (*model.Project, error) {
+	if r.project == nil || r.project.ID != id {
+		return nil, nil
+	}
+	return r.project, nil
+}
+
+type emailUserRepo struct {
+	repository.UserRepository
+	users map[uuid.UUID]*model.User
+}
+
+func (r emailUserRepo) FindByID(_ context.Context, id uuid.UUID) (*model.User, error) {
+	return r.users[id], nil
+}
+
+func errStatus(err error) int {
+	var appErr *util.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Status
+	}
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return http.StatusOK
+}
+
+func TestAccessCheckerSupervisorLifecycle(t *testing.T) {
+	ctx := context.Background()
+	ownerID, supervisorID, strangerID := uuid.New(), uuid.New(), uuid.New()
+	project := &model.Project{ID: uuid.New(), UserID: ownerID}
+	invite := &model.ProjectSupervisor{ID: uuid.New(), ProjectID: project.ID, Email: "mentor@example.com", InvitedBy: ownerID, Status: model.SupervisorPending}
+
+	supervisors := &memorySupervisorRepo{invites: map[uuid.UUID]*model.ProjectSupervisor{invite.ID: invite}}
+	projects := singleProjectRepo{project: project}
+	access := NewAccessChecker(projects, supervisors)
+	svc := NewSupervisionService(supervisors, projects, emailUserRepo{users: map[uuid.UUID]*model.User{
+		supervisorID: {ID: supervisorID, Email: "Mentor@Example.com"},
+		strangerID:   {ID: strangerID, Email: "other@example.com"},
+	}}, nil, nil, access, events.NewBus())
+
+	type check struct {
+		name     string
+		userID   uuid.UUID
+		role     ProjectRole
+		viewWant int
+		editWant int
+	}
+	assert := func(stage string, checks []check) {
+		t.Helper()
+		for _, c := range checks {
+			_, role, err := access.CanView(ctx, c.userID, project.ID)
+			if got := errStatus(err); got != c.viewWant || role != c.role {
+				t.Errorf("%s/%s: CanView() = (%q, %d), want (%q, %d)", stage, c.name, role, got, c.role, c.viewWant)
+			}
+			_, err = access.CanEdit(ctx, c.userID, project.ID)
+			if got := errStatus(err); got != c.editWant {
+				t.Errorf("%s/%s: CanEdit() status = %d, want %d", stage, c.name, got, c.editWant)
+			}
+		}
+	}
+
+	owner := check{"owner", ownerID, RoleOwner, http.StatusOK, http.StatusOK}
+	outsider := func(name string, id uuid.UUID) check {
+		return check{name, id, "", http.StatusUnauthorized, http.StatusNotFound}
+	}
+
+	assert("pending", []check{owner, outsider("invitee", supervisorID), outsider("stranger", strangerID)})
+
+	if _, err := svc.RespondInvite(ctx, strangerID, invite.ID, true); errStatus(err) != http.StatusNotFound {
+		t.Fatalf("RespondInvite() by another email err = %v, want 404", err)
+	}
+	if _, err := svc.RespondInvite(ctx, supervisorID, invite.ID, true); err != nil {
+		t.Fatalf("RespondInvite() err = %v", err)
+	}
+	assert("accepted", []check{
+		owner,
+		{"supervisor is read-only", supervisorID, RoleSupervisor, http.StatusOK, http.StatusUnauthorized},
+		outsider("stranger", strangerID),
+	})
+
+	if _, err := svc.RespondInvite(ctx, supervisorID, invite.ID, true); errStatus(err) != http.StatusConflict {
+		t.Fatalf("RespondInvite() twice err = %v, want 409", err)
+	}
+	if err := svc.Revoke(ctx, strangerID, project.ID, invite.ID); errStatus(err) != http.StatusUnauthorized {
+		t.Fatalf("Revoke() by stranger err = %v, want 401", err)
+	}
+	if err := svc.Revoke(ctx, ownerID, project.ID, invite.ID); err != nil {
+		t.Fatalf("Revoke() err = %v", err)
+	}
+	assert("revoked", []check{owner, outsider("former supervisor", supervisorID)})
+}
+
+func TestAccessCheckerMissingProject(t *testing.T) {
+	access := NewAccessChecker(singleProjectRepo{}, &memorySupervisorRepo{})
+
+	if _, _, err := access.CanView(context.Background(), uuid.New(), uuid.New()); errStatus(err) != http.StatusNotFound {
+		t.Errorf("CanView() err = %v, want 404", err)
+	}
+	if _, err := access.CanEdit(context.Background(), uuid.New(), uuid.New()); errStatus(err) != http.StatusNotFound {
+		t.Errorf("CanEdit() err = %v, want 404", err)
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+var activityTypes = map[repository.ActivityType]bool{
+	repository.ActivityLogCreated:       true,
+	repository.ActivityMilestoneStatus:  true,
+	repository.ActivityInsightGenerated: true,
+	repository.ActivityReportGenerated:  true,
+}
+
+// ActivityService menyediakan feed aktivitas gabungan per project untuk pemilik dan supervisor.
+type ActivityService struct {
+	repo   repository.ActivityRepository
+	access *AccessChecker
+}
+
+func NewActivityService(repo repository.ActivityRepository, access *AccessChecker) *ActivityService {
+	return &ActivityService{repo: repo, access: access}
+}
+
+// Feed mengembalikan satu halaman aktivitas project, terbaru dulu kecuali q.Asc.
+func (s *ActivityService) Feed(ctx context.Context, userID, projectID uuid.UUID, q repository.ListQuery) (*repository.Page[repository.Activity], error) {
+	if _, _, err := s.access.CanView(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+	if q.Type != "" && !activityTypes[repository.ActivityType(q.Type)] {
+		return nil, util.ErrBadRequest("unsupported activity type")
+	}
+
+	page, err := s.repo.List(ctx, projectID, q)
+	if err != nil {
+		return nil, listError(err)
+	}
+	return page, nil
+}
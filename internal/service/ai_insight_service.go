@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
 	"devtracker/internal/repository"
 	"devtracker/pkg/util"
 
@@ -18,13 +19,17 @@ type AIInsightService struct {
 	repo repository.AIInsightRepository
 
 	analyticsSvc *AnalyticsService
+	goalSvc *GoalService
+	publisher events.Publisher
 }
 
 
-func NewAIInsightService(repo repository.AIInsightRepository, analyticsSvc *AnalyticsService) *AIInsightService{
+func NewAIInsightService(repo repository.AIInsightRepository, analyticsSvc *AnalyticsService, goalSvc *GoalService, publisher events.Publisher) *AIInsightService{
 	return &AIInsightService{
 		repo: repo,
 		analyticsSvc: analyticsSvc,
+		goalSvc: goalSvc,
+		publisher: publisher,
 	}
 }
 
@@ -51,16 +56,18 @@ func (s *AIInsightService) GenerateProgressInsight(ctx context.Context, projectI
         status = model.StatusOnTrack
     case "at-risk":
         content = fmt.Sprintf(
-            "⚠️ Perhatian! Progress: %.1f%%, tapi hanya %d hari tersisa. Project berisiko tertunda. Fokus pada milestone paling kritikal dan tingkatkan jam kerja.",
+            "⚠️ Perhatian! Progress: %.1f%%, tapi hanya %d hari kerja tersisa (%.1f jam tersedia, sisa pekerjaan ~%.1f jam). Project berisiko tertunda. Fokus pada milestone paling kritikal dan tingkatkan jam kerja.",
             metrics.ProgressPercent,
             metrics.DaysRemaining,
+            metrics.AvailableHours,
+            metrics.RemainingHours,
         )
         status = model.StatusAtRisk
     case "delayed":
         content = fmt.Sprintf(
-            "🔴 Terlambat! Progress baru %.1f%%, padahal deadline tinggal %d hari. Segera konsultasi dengan mentor/dosen dan buat rencana pemulihan proyek.",
+            "🔴 Terlambat! Progress baru %.1f%%, padahal deadline sudah lewat (sisa pekerjaan ~%.1f jam). Segera konsultasi dengan mentor/dosen dan buat rencana pemulihan proyek.",
             metrics.ProgressPercent,
-            metrics.DaysRemaining,
+            metrics.RemainingHours,
         )
         status = model.StatusDelayed
     default:
@@ -68,6 +75,32 @@ func (s *AIInsightService) GenerateProgressInsight(ctx context.Context, projectI
         status = model.InsightStatus("UNKNOWN")
     }
 
+    // Akurasi estimasi: jika milestone yang sudah selesai rata-rata jauh melebihi estimasi,
+    // sisa pekerjaan kemungkinan juga diremehkan.
+    if note, escalate := estimateAccuracyNote(metrics.EstimateAccuracy); note != "" {
+        content += " " + note
+        if escalate && status == model.StatusOnTrack {
+            status = model.StatusAtRisk
+        }
+    }
+
+    // Deadline yang sering dimundurkan menandakan estimasi/komitmen yang tidak realistis.
+    if note, escalate := deadlineSlippageNote(metrics.DeadlineSlippage); note != "" {
+        content += " " + note
+        if escalate && status == model.StatusOnTrack {
+            status = model.StatusAtRisk
+        }
+    }
+
+    // Capaian target jam (goal) yang terhubung ke project ini
+    goals, err := s.goalSvc.ProjectGoalSummary(ctx, projectID)
+    if err != nil {
+        return nil, err
+    }
+    for _, g := range goals {
+        content += " " + goalAttainmentNote(g)
+    }
+
     // 3. Simpan ke database
     // Perhatikan tipe kembalian di NewAIInsightService
     insight := &model.AIInsight{
@@ -81,9 +114,77 @@ func (s *AIInsightService) GenerateProgressInsight(ctx context.Context, projectI
         return nil, err
     }
 
+    s.publish(events.InsightCreated, insight)
     return insight, nil
 }
 
+// estimateAccuracyNote menerjemahkan statistik akurasi estimasi menjadi kalimat insight.
+// escalate bernilai true bila overrun cukup besar untuk menurunkan status on-track.
+func estimateAccuracyNote(acc EstimateAccuracy) (string, bool) {
+    if acc.SampleSize < 2 {
+        return "", false
+    }
+
+    switch {
+    case acc.OverrunRatio >= 1.5:
+        return fmt.Sprintf(
+            "📏 Milestone yang selesai rata-rata butuh %.0f%% lebih lama dari estimasi. Sisa pekerjaan kemungkinan juga di-underestimate, sesuaikan rencana.",
+            (acc.OverrunRatio-1)*100,
+        ), true
+    case acc.OverrunRatio >= 1+estimateTolerance:
+        return fmt.Sprintf(
+            "📏 Estimasi kamu rata-rata meleset %.0f%% lebih lama dari rencana. Tambahkan buffer pada estimasi berikutnya.",
+            (acc.OverrunRatio-1)*100,
+        ), false
+    case acc.OverrunRatio <= 1-estimateTolerance:
+        return fmt.Sprintf(
+            "📏 Milestone selesai %.0f%% lebih cepat dari estimasi. Estimasi bisa dibuat lebih ketat.",
+            (1-acc.OverrunRatio)*100,
+        ), false
+    }
+    return "", false
+}
+
+// deadlineSlippageNote: deadline yang dimundurkan dua kali atau lebih menurunkan status on-track.
+func deadlineSlippageNote(slip DeadlineSlippage) (string, bool) {
+    switch {
+    case slip.Postponements >= 2:
+        return fmt.Sprintf(
+            "📅 Deadline sudah dimundurkan %d kali (total %d hari). Pola ini menandakan rencana terlalu optimistis, kunci scope sebelum menggeser deadline lagi.",
+            slip.Postponements,
+            slip.TotalSlipDays,
+        ), true
+    case slip.Postponements == 1:
+        return fmt.Sprintf(
+            "📅 Deadline pernah dimundurkan %d hari. Pastikan deadline baru realistis.",
+            slip.TotalSlipDays,
+        ), false
+    }
+    return "", false
+}
+
+var goalPeriodLabels = map[model.GoalPeriod]string{
+    model.GoalDaily:   "hari",
+    model.GoalWeekly:  "minggu",
+    model.GoalMonthly: "bulan",
+}
+
+// goalAttainmentNote merangkum periode berjalan dan rasio hit/miss periode yang sudah selesai.
+func goalAttainmentNote(h GoalHistory) string {
+    label := goalPeriodLabels[h.Goal.Period]
+    note := fmt.Sprintf("🎯 Target %.1f jam/%s", h.Goal.TargetHours, label)
+
+    if n := len(h.Periods); n > 0 && h.Periods[n-1].InProgress {
+        current := h.Periods[n-1]
+        note += fmt.Sprintf(": %s ini baru %.1f jam (%.0f%%)", label, current.LoggedHours, current.Percent())
+    }
+
+    if done := h.Hits + h.Misses; done > 0 {
+        note += fmt.Sprintf(", tercapai %d dari %d %s terakhir", h.Hits, done, label)
+    }
+    return note + "."
+}
+
 func (s *AIInsightService) CreateInsight(ctx context.Context, projectID uuid.UUID, insightType model.InsightType, content string) (*model.AIInsight, error) {
 	if projectID == uuid.Nil{
 		return nil, util.ErrBadRequest("project ID cannot be empty")
@@ -103,6 +204,8 @@ insights:= &model.AIInsight{
 	if err := s.repo.Create(ctx, insights); err != nil {
 		return nil, err
 	}
+
+	s.publish(events.InsightCreated, insights)
 	return insights, nil
 }
 
@@ -118,16 +221,17 @@ func (s *AIInsightService) GetInsightByID(ctx context.Context, id uuid.UUID) (*m
 	return insight, nil
 }
 
-func (s *AIInsightService) GetInsightsByProject(ctx context.Context, projectID uuid.UUID) ([]model.AIInsight, error) {
+func (s *AIInsightService) GetInsightsByProject(ctx context.Context, projectID uuid.UUID, q repository.ListQuery) (*repository.Page[model.AIInsight], error) {
 	if projectID == uuid.Nil {
 		return nil, errors.New("project ID cannot be empty")
 	}
 
-	insights, err := s.repo.FindByProject(ctx, projectID)
+	q.ProjectID = &projectID
+	page, err := s.repo.List(ctx, q)
 	if err != nil {
-		return nil, err
+		return nil, listError(err)
 	}
-	return insights, nil
+	return page, nil
 }
 
 func (s *AIInsightService) GetInsightByProjectAndTypeAndDate(ctx context.Context, projectID uuid.UUID, insightType model.InsightType, date time.Time) (*model.AIInsight, error) {
@@ -182,6 +286,7 @@ func (s *AIInsightService) UpdateInsight(ctx context.Context, insight *model.AII
 		return nil, err
 	}
 
+	s.publish(events.InsightUpdated, insight)
 	return insight, nil
 }
 
@@ -197,5 +302,10 @@ func (s *AIInsightService) DeleteInsight(ctx context.Context, id uuid.UUID) erro
 	return nil
 }
 
-
-
+func (s *AIInsightService) publish(t events.Type, insight *model.AIInsight) {
+	s.publisher.Publish(events.Event{
+		Type:      t,
+		ProjectID: insight.ProjectID,
+		EntityID:  insight.ID,
+	})
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// LogFilter membatasi log yang dihitung oleh endpoint analytics. Di dalam Tags atau
+// Categories cukup salah satu yang cocok; Tags dan Categories digabung dengan AND.
+type LogFilter struct {
+	Tags       []string
+	Categories []model.LogCategory
+}
+
+// NewLogFilter menormalkan nama tag dan memvalidasi kategori dari query string.
+func NewLogFilter(tags, categories []string) (LogFilter, error) {
+	var f LogFilter
+	for _, t := range tags {
+		name, ok := normalizeTagName(t)
+		if !ok {
+			return f, errInvalidTagName
+		}
+		f.Tags = append(f.Tags, name)
+	}
+	for _, c := range categories {
+		category := model.LogCategory(c)
+		if !category.Valid() {
+			return f, errInvalidCategory
+		}
+		f.Categories = append(f.Categories, category)
+	}
+	return f, nil
+}
+
+func (f LogFilter) matches(l *model.Log) bool {
+	if len(f.Categories) > 0 {
+		found := false
+		for _, c := range f.Categories {
+			if l.Category == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Tags) > 0 {
+		for _, want := range f.Tags {
+			for _, have := range l.Tags {
+				if want == have {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+type BreakdownItem struct {
+	Key     string
+	Minutes int
+	Percent float64
+}
+
+// LogBreakdown membagi menit log (setelah filter) per kategori dan per tag. Satu log bisa
+// punya beberapa tag, jadi persentase per tag bisa berjumlah lebih dari 100.
+type LogBreakdown struct {
+	TotalMinutes    int
+	ByCategory      []BreakdownItem
+	ByTag           []BreakdownItem
+	UntaggedMinutes int
+}
+
+// filterLogs memuat tag setiap log, menerapkan filter dan menghitung breakdown dari log yang lolos.
+func (s *AnalyticsService) filterLogs(ctx context.Context, logs []model.Log, f LogFilter) ([]model.Log, LogBreakdown, error) {
+	ids := make([]uuid.UUID, len(logs))
+	for i := range logs {
+		ids[i] = logs[i].ID
+	}
+	names, err := s.tagRepo.FindNamesByLogs(ctx, ids)
+	if err != nil {
+		return nil, LogBreakdown{}, err
+	}
+
+	filtered := make([]model.Log, 0, len(logs))
+	for i := range logs {
+		logs[i].Tags = names[logs[i].ID]
+		if f.matches(&logs[i]) {
+			filtered = append(filtered, logs[i])
+		}
+	}
+
+	return filtered, logBreakdown(filtered), nil
+}
+
+func logBreakdown(logs []model.Log) LogBreakdown {
+	var b LogBreakdown
+	byCategory := make(map[string]int)
+	byTag := make(map[string]int)
+	for _, l := range logs {
+		b.TotalMinutes += l.DurationMinutes
+		category := l.Category
+		if category == "" {
+			category = model.CategoryOther
+		}
+		byCategory[string(category)] += l.DurationMinutes
+		if len(l.Tags) == 0 {
+			b.UntaggedMinutes += l.DurationMinutes
+		}
+		for _, t := range l.Tags {
+			byTag[t] += l.DurationMinutes
+		}
+	}
+
+	b.ByCategory = breakdownItems(byCategory, b.TotalMinutes)
+	b.ByTag = breakdownItems(byTag, b.TotalMinutes)
+	return b
+}
+
+func breakdownItems(minutes map[string]int, total int) []BreakdownItem {
+	items := make([]BreakdownItem, 0, len(minutes))
+	for key, m := range minutes {
+		item := BreakdownItem{Key: key, Minutes: m}
+		if total > 0 {
+			item.Percent = float64(m) / float64(total) * 100
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Minutes != items[j].Minutes {
+			return items[i].Minutes > items[j].Minutes
+		}
+		return items[i].Key < items[j].Key
+	})
+	return items
+}
+
+// TimeBreakdown menghitung pembagian waktu user per kategori dan tag dalam [from, to),
+// opsional dibatasi pada satu project.
+func (s *AnalyticsService) TimeBreakdown(ctx context.Context, userID uuid.UUID, from, to time.Time, projectID *uuid.UUID, f LogFilter) (*LogBreakdown, error) {
+	if !from.Before(to) {
+		return nil, util.ErrBadRequest("from must be before to")
+	}
+
+	logs, err := s.logRepo.FindByUserBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if projectID != nil {
+		scoped := logs[:0]
+		for _, l := range logs {
+			if l.ProjectID == *projectID {
+				scoped = append(scoped, l)
+			}
+		}
+		logs = scoped
+	}
+
+	_, breakdown, err := s.filterLogs(ctx, logs, f)
+	if err != nil {
+		return nil, err
+	}
+	return &breakdown, nil
+}
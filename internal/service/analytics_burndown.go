@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// BurnPoint adalah nilai scope di akhir satu hari. Satuan scope mengikuti basis
+// bobot project (lihat milestoneWeights).
+type BurnPoint struct {
+	Date        time.Time
+	Scope       float64
+	Completed   float64
+	Remaining   float64
+	LoggedHours float64 // kumulatif
+}
+
+// IdealPoint: burndown turun lurus dari scope awal ke 0 di deadline,
+// burnup naik lurus dari 0 ke scope saat ini.
+type IdealPoint struct {
+	Date      time.Time
+	Remaining float64
+	Completed float64
+}
+
+type ScopeMarker struct {
+	Date  time.Time
+	Kind  model.ScopeChangeKind
+	Name  string
+	Delta float64
+}
+
+type BurnChart struct {
+	ProjectID    uuid.UUID
+	SprintID     *uuid.UUID
+	Start        time.Time
+	Deadline     *time.Time
+	Points       []BurnPoint
+	Ideal        []IdealPoint
+	ScopeChanges []ScopeMarker
+	Breakdown    LogBreakdown // dari log yang dipakai untuk LoggedHours
+}
+
+// scopeEntry adalah satu unit scope (milestone atau item sprint) beserta masa hidupnya.
+type scopeEntry struct {
+	Name        string
+	Weight      float64
+	AddedAt     time.Time
+	RemovedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// ProjectBurnChart menyusun seri harian dari pembuatan project sampai hari ini,
+// termasuk milestone yang sudah dihapus (dari ScopeChange).
+func (s *AnalyticsService) ProjectBurnChart(ctx context.Context, userID, projectID uuid.UUID, filter LogFilter) (*BurnChart, error) {
+	project, _, err := s.access.CanView(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.milestoneRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.scopeRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := s.logRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, breakdown, err := s.filterLogs(ctx, logs, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// milestone yang sudah dihapus ikut menentukan basis bobot project
+	basis := make([]model.Milestone, 0, len(milestones)+len(changes))
+	basis = append(basis, milestones...)
+	for i := range changes {
+		if c := &changes[i]; c.Kind == model.ScopeRemoved {
+			basis = append(basis, model.Milestone{ID: c.MilestoneID, Weight: c.Weight, EstimatedHours: c.EstimatedHours})
+		}
+	}
+	weights := milestoneWeights(basis)
+
+	entries := make([]scopeEntry, 0, len(milestones)+len(changes))
+	for i := range milestones {
+		m := &milestones[i]
+		entry := scopeEntry{Name: m.Name, Weight: weights[m.ID], AddedAt: m.CreatedAt}
+		if m.Status == model.StatusDone {
+			completedAt := m.UpdatedAt
+			if m.CompletedAt != nil {
+				completedAt = *m.CompletedAt
+			}
+			entry.CompletedAt = &completedAt
+		}
+		entries = append(entries, entry)
+	}
+	for i := range changes {
+		c := &changes[i]
+		if c.Kind != model.ScopeRemoved {
+			continue
+		}
+		entries = append(entries, scopeEntry{
+			Name:        c.MilestoneName,
+			Weight:      weights[c.MilestoneID],
+			AddedAt:     c.MilestoneCreatedAt,
+			RemovedAt:   &c.ChangedAt,
+			CompletedAt: c.CompletedAt,
+		})
+	}
+
+	now := time.Now()
+	chart := buildBurnChart(project.CreatedAt, project.Deadline, now, entries, logs)
+	chart.ProjectID = projectID
+	chart.Breakdown = breakdown
+	return chart, nil
+}
+
+// SprintBurnChart menyusun seri harian selama rentang sprint. Item yang di-carry over
+// atau dikembalikan ke backlog dianggap keluar dari scope saat sprint ditutup.
+func (s *AnalyticsService) SprintBurnChart(ctx context.Context, userID, sprintID uuid.UUID, filter LogFilter) (*BurnChart, error) {
+	sprint, err := s.sprintRepo.FindByID(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint == nil {
+		return nil, util.ErrNotFound("sprint not found")
+	}
+	if _, _, err := s.access.CanView(ctx, userID, sprint.ProjectID); err != nil {
+		return nil, err
+	}
+
+	items, err := s.sprintRepo.FindItems(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.milestoneRepo.FindByProject(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.FindByProject(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := s.logRepo.FindByProject(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, _, err = s.filterLogs(ctx, logs, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := milestoneWeights(milestones)
+	milestoneByID := make(map[uuid.UUID]*model.Milestone, len(milestones))
+	for i := range milestones {
+		milestoneByID[milestones[i].ID] = &milestones[i]
+	}
+	taskByID := make(map[uuid.UUID]*model.Task, len(tasks))
+	taskCount := make(map[uuid.UUID]int)
+	for i := range tasks {
+		taskByID[tasks[i].ID] = &tasks[i]
+		taskCount[tasks[i].MilestoneID]++
+	}
+
+	entries := make([]scopeEntry, 0, len(items))
+	for _, item := range items {
+		var entry scopeEntry
+		var doneAt *time.Time
+
+		switch {
+		case item.MilestoneID != nil:
+			m, ok := milestoneByID[*item.MilestoneID]
+			if !ok {
+				continue
+			}
+			entry = scopeEntry{Name: m.Name, Weight: weights[m.ID]}
+			if m.Status == model.StatusDone {
+				doneAt = m.CompletedAt
+			}
+		case item.TaskID != nil:
+			t, ok := taskByID[*item.TaskID]
+			if !ok {
+				continue
+			}
+			// bobot task = bagian rata dari bobot milestone induknya
+			weight := 1.0
+			if m, ok := milestoneByID[t.MilestoneID]; ok {
+				weight = weights[m.ID] / float64(taskCount[t.MilestoneID])
+			}
+			entry = scopeEntry{Name: t.Title, Weight: weight}
+			if t.Done {
+				doneAt = t.CompletedAt
+			}
+		default:
+			continue
+		}
+
+		entry.AddedAt = item.AddedAt
+		switch item.Outcome {
+		case model.OutcomeCompleted:
+			if doneAt == nil {
+				doneAt = item.ClosedAt
+			}
+			entry.CompletedAt = doneAt
+		case model.OutcomeCarriedOver, model.OutcomeReturned:
+			entry.RemovedAt = item.ClosedAt
+		default:
+			entry.CompletedAt = doneAt
+		}
+		entries = append(entries, entry)
+	}
+
+	var sprintLogs []model.Log
+	for _, l := range logs {
+		if !l.LoggedAt.Before(sprint.StartDate) && !l.LoggedAt.After(sprint.EndDate) {
+			sprintLogs = append(sprintLogs, l)
+		}
+	}
+
+	until := time.Now()
+	if until.After(sprint.EndDate) {
+		until = sprint.EndDate
+	}
+
+	chart := buildBurnChart(sprint.StartDate, &sprint.EndDate, until, entries, sprintLogs)
+	chart.ProjectID = sprint.ProjectID
+	chart.SprintID = &sprint.ID
+	chart.Breakdown = logBreakdown(sprintLogs)
+	return chart, nil
+}
+
+func buildBurnChart(start time.Time, deadline *time.Time, until time.Time, entries []scopeEntry, logs []model.Log) *BurnChart {
+	loc := until.Location()
+	startDay := startOfDay(start, loc)
+	lastDay := startOfDay(until, loc)
+
+	chart := &BurnChart{
+		Start:        startDay,
+		Deadline:     deadline,
+		Points:       []BurnPoint{},
+		Ideal:        []IdealPoint{},
+		ScopeChanges: []ScopeMarker{},
+	}
+
+	sort.Slice(logs, func(i, j int) bool { return logs[i].LoggedAt.Before(logs[j].LoggedAt) })
+
+	logIdx := 0
+	loggedHours := 0.0
+	for day := startDay; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		cutoff := day.AddDate(0, 0, 1)
+		point := BurnPoint{Date: day}
+
+		for _, e := range entries {
+			if !e.AddedAt.Before(cutoff) || (e.RemovedAt != nil && e.RemovedAt.Before(cutoff)) {
+				continue
+			}
+			point.Scope += e.Weight
+			if e.CompletedAt != nil && e.CompletedAt.Before(cutoff) {
+				point.Completed += e.Weight
+			}
+		}
+		point.Remaining = point.Scope - point.Completed
+
+		for logIdx < len(logs) && logs[logIdx].LoggedAt.Before(cutoff) {
+			loggedHours += float64(logs[logIdx].DurationMinutes) / 60.0
+			logIdx++
+		}
+		point.LoggedHours = loggedHours
+
+		chart.Points = append(chart.Points, point)
+	}
+
+	if deadline != nil && len(chart.Points) > 0 {
+		initialScope := chart.Points[0].Scope
+		currentScope := chart.Points[len(chart.Points)-1].Scope
+		endDay := startOfDay(*deadline, loc)
+		totalDays := dayOffset(startDay, endDay)
+
+		for i := 0; i <= totalDays; i++ {
+			fraction := 1.0
+			if totalDays > 0 {
+				fraction = float64(i) / float64(totalDays)
+			}
+			chart.Ideal = append(chart.Ideal, IdealPoint{
+				Date:      startDay.AddDate(0, 0, i),
+				Remaining: initialScope * (1 - fraction),
+				Completed: currentScope * fraction,
+			})
+		}
+	}
+
+	// scope yang sudah ada di hari pertama bukan perubahan scope
+	for _, e := range entries {
+		if startOfDay(e.AddedAt, loc).After(startDay) {
+			chart.ScopeChanges = append(chart.ScopeChanges, ScopeMarker{Date: e.AddedAt, Kind: model.ScopeAdded, Name: e.Name, Delta: e.Weight})
+		}
+		if e.RemovedAt != nil {
+			chart.ScopeChanges = append(chart.ScopeChanges, ScopeMarker{Date: *e.RemovedAt, Kind: model.ScopeRemoved, Name: e.Name, Delta: -e.Weight})
+		}
+	}
+	sort.Slice(chart.ScopeChanges, func(i, j int) bool {
+		return chart.ScopeChanges[i].Date.Before(chart.ScopeChanges[j].Date)
+	})
+
+	return chart
+}
+
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// streakLookbackDays: current streak dihitung dari log setahun terakhir, terlepas dari tahun heatmap.
+const streakLookbackDays = 400
+
+type HeatmapDay struct {
+	Date    time.Time
+	Minutes int
+	Level   int // 0-4, relatif terhadap hari dengan menit terbanyak di tahun itu
+}
+
+type ProjectShare struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	Minutes     int
+	Percent     float64
+}
+
+type Heatmap struct {
+	UserID        uuid.UUID
+	Year          int
+	Timezone      string
+	TotalMinutes  int
+	ActiveDays    int
+	CurrentStreak int
+	LongestStreak int // dalam tahun yang diminta
+	Days          []HeatmapDay
+	ByWeekday     [7]int  // menit per hari, index 0 = Minggu (time.Weekday)
+	ByHour        [24]int // menit per jam mulai log
+	Projects      []ProjectShare
+	Breakdown     LogBreakdown
+}
+
+// UserHeatmap menghitung menit log per hari dalam satu tahun, dibucket
+// sesuai timezone user (bukan timezone koneksi database). Filter tidak berlaku
+// untuk CurrentStreak, yang selalu menghitung semua aktivitas.
+func (s *AnalyticsService) UserHeatmap(ctx context.Context, userID uuid.UUID, year int, filter LogFilter) (*Heatmap, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+
+	loc := user.Location()
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	to := from.AddDate(1, 0, 0)
+
+	logs, err := s.logRepo.FindByUserBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, breakdown, err := s.filterLogs(ctx, logs, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	heatmap := &Heatmap{
+		UserID:    userID,
+		Year:      year,
+		Timezone:  loc.String(),
+		Days:      []HeatmapDay{},
+		Projects:  []ProjectShare{},
+		Breakdown: breakdown,
+	}
+
+	minutesByDay := make(map[string]int)
+	minutesByProject := make(map[uuid.UUID]int)
+	for _, l := range logs {
+		local := l.LoggedAt.In(loc)
+		minutesByDay[local.Format("2006-01-02")] += l.DurationMinutes
+		minutesByProject[l.ProjectID] += l.DurationMinutes
+		heatmap.ByWeekday[local.Weekday()] += l.DurationMinutes
+		heatmap.ByHour[local.Hour()] += l.DurationMinutes
+		heatmap.TotalMinutes += l.DurationMinutes
+	}
+
+	maxMinutes := 0
+	for _, m := range minutesByDay {
+		if m > maxMinutes {
+			maxMinutes = m
+		}
+	}
+
+	streak := 0
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		minutes := minutesByDay[day.Format("2006-01-02")]
+		heatmap.Days = append(heatmap.Days, HeatmapDay{
+			Date:    day,
+			Minutes: minutes,
+			Level:   heatmapLevel(minutes, maxMinutes),
+		})
+
+		if minutes > 0 {
+			heatmap.ActiveDays++
+			streak++
+			if streak > heatmap.LongestStreak {
+				heatmap.LongestStreak = streak
+			}
+		} else {
+			streak = 0
+		}
+	}
+
+	heatmap.CurrentStreak, err = s.currentStreak(ctx, userID, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	for projectID, minutes := range minutesByProject {
+		share := ProjectShare{ProjectID: projectID, Minutes: minutes}
+		if project, err := s.projectRepo.FindByID(ctx, projectID); err == nil && project != nil {
+			share.ProjectName = project.Name
+		}
+		if heatmap.TotalMinutes > 0 {
+			share.Percent = float64(minutes) / float64(heatmap.TotalMinutes) * 100
+		}
+		heatmap.Projects = append(heatmap.Projects, share)
+	}
+	sort.Slice(heatmap.Projects, func(i, j int) bool {
+		return heatmap.Projects[i].Minutes > heatmap.Projects[j].Minutes
+	})
+
+	return heatmap, nil
+}
+
+// currentStreak menghitung hari berturut-turut dengan log sampai hari ini.
+// Hari ini yang belum ada log tidak memutus streak.
+func (s *AnalyticsService) currentStreak(ctx context.Context, userID uuid.UUID, loc *time.Location) (int, error) {
+	today := startOfDay(time.Now(), loc)
+	logs, err := s.logRepo.FindByUserBetween(ctx, userID, today.AddDate(0, 0, -streakLookbackDays), today.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	active := make(map[string]bool, len(logs))
+	for _, l := range logs {
+		if l.DurationMinutes > 0 {
+			active[l.LoggedAt.In(loc).Format("2006-01-02")] = true
+		}
+	}
+
+	day := today
+	if !active[day.Format("2006-01-02")] {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	streak := 0
+	for active[day.Format("2006-01-02")] {
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+	return streak, nil
+}
+
+// heatmapLevel membagi menit ke 4 tingkat seperti kalender kontribusi GitHub.
+func heatmapLevel(minutes, maxMinutes int) int {
+	if minutes <= 0 || maxMinutes <= 0 {
+		return 0
+	}
+	level := int(math.Ceil(float64(minutes) / float64(maxMinutes) * 4))
+	if level > 4 {
+		level = 4
+	}
+	return level
+}
@@ -2,17 +2,46 @@ package service
 
 import (
 	"context"
+	"devtracker/internal/domain/model"
 	"devtracker/internal/repository"
 	"devtracker/pkg/util"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// estimateTolerance: selisih aktual vs estimasi di bawah ini dianggap akurat.
+const estimateTolerance = 0.2
+
 type AnalyticsService struct {
 	logRepo repository.LogRepository
 	projectRepo repository.ProjectRepository
 	milestoneRepo repository.MilestoneRepository
+	taskRepo repository.TaskRepository
+	scopeRepo repository.ScopeChangeRepository
+	sprintRepo repository.SprintRepository
+	userRepo repository.UserRepository
+	calendarSvc *CalendarService
+	revisionRepo repository.ProjectRevisionRepository
+	tagRepo repository.TagRepository
+	access *AccessChecker
+}
+
+func NewAnalyticsService(logRepo repository.LogRepository, projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, taskRepo repository.TaskRepository, scopeRepo repository.ScopeChangeRepository, sprintRepo repository.SprintRepository, userRepo repository.UserRepository, calendarSvc *CalendarService, revisionRepo repository.ProjectRevisionRepository, tagRepo repository.TagRepository, access *AccessChecker) *AnalyticsService {
+	return &AnalyticsService{
+		logRepo: logRepo,
+		projectRepo: projectRepo,
+		milestoneRepo: milestoneRepo,
+		taskRepo: taskRepo,
+		scopeRepo: scopeRepo,
+		sprintRepo: sprintRepo,
+		userRepo: userRepo,
+		calendarSvc: calendarSvc,
+		revisionRepo: revisionRepo,
+		tagRepo: tagRepo,
+		access: access,
+	}
 }
 
 type ProgressMetrics struct {
@@ -22,9 +51,40 @@ type ProgressMetrics struct {
     DaysInactive      int
     MilestonesTotal   int
     MilestonesCompleted int
-    ProgressPercent   float64
-    DaysRemaining     int
+    ProgressPercent   float64 // berbobot, lihat milestoneWeights
+    DaysRemaining     int     // hari kerja sampai deadline, sudah dikurangi libur & cuti
+    AvailableHours    float64 // kapasitas jam kerja sampai deadline
+    RemainingHours    float64 // perkiraan jam kerja yang masih dibutuhkan
     PredictedCompletion string  // "on-track", "at-risk", "delayed"
+    EstimateAccuracy  EstimateAccuracy
+    DeadlineSlippage  DeadlineSlippage
+}
+
+// MilestoneEstimate membandingkan estimasi dengan jam aktual dari log yang terhubung ke milestone.
+type MilestoneEstimate struct {
+	Milestone       model.Milestone
+	Weight          float64
+	EstimatedHours  *float64
+	ActualHours     float64
+	VarianceHours   *float64
+	VariancePercent *float64
+}
+
+// EstimateAccuracy dihitung hanya dari milestone done yang punya estimasi.
+type EstimateAccuracy struct {
+	SampleSize          int
+	MeanAbsPercentError float64
+	OverrunRatio        float64 // total aktual / total estimasi; >1 berarti cenderung underestimate
+	Overruns            int
+	Underruns           int
+	WithinTolerance     int
+}
+
+type EstimateReport struct {
+	ProjectID  uuid.UUID
+	Milestones []MilestoneEstimate
+	Accuracy   EstimateAccuracy
+	Breakdown  LogBreakdown
 }
 
 func (s *AnalyticsService) CalculateProgress(ctx context.Context, projectID uuid.UUID)(*ProgressMetrics, error){
@@ -40,40 +100,48 @@ func (s *AnalyticsService) CalculateProgress(ctx context.Context, projectID uuid
 
 	milestones, _ := s.milestoneRepo.FindByProject(ctx, projectID);
 
+	tasks, _ := s.taskRepo.FindByProject(ctx, projectID)
+	taskProgress := taskCompletion(tasks)
+
 	totalHours := 0.0
 
 	for _, log := range logs {
 		totalHours += float64(log.DurationMinutes) / 60.0
 	}
 
-	completedMilestones := 0
-	for _, milestone := range milestones {
-		if milestone.Status == "done" {
-			completedMilestones++
-		}
-	}
-
-	milestoneTotal := len(milestones)
+	completedMilestones, progressPercent := weightedProgress(milestones, taskProgress)
 
-	if milestoneTotal == 0 {
-		milestoneTotal = 1
+	cal, err := s.calendarSvc.Load(ctx, project.UserID)
+	if err != nil {
+		return nil, err
 	}
+	remainingHours := remainingWorkHours(milestones, taskProgress, cal.Calendar.DailyHours)
 
-	progressPercent := float64(completedMilestones)/float64(milestoneTotal) * 100;
-
+	now := time.Now()
 	daysRemaining := 0
-    if project.Deadline != nil {
-        duration := time.Until(*project.Deadline) 
-   
-        if duration > 0 {
-            daysRemaining = int(duration.Hours() / 24)
-        }
-    }
+	availableHours := 0.0
+	if project.Deadline != nil && now.Before(*project.Deadline) {
+		avail := cal.Availability(now, *project.Deadline)
+		daysRemaining = avail.WorkingDays
+		availableHours = avail.Hours
+	}
 
+	revisions, err := s.revisionRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
 
 	prediction := "on-track"
 
-	if progressPercent < 50 && daysRemaining < 30 {
+	switch {
+	case project.Deadline == nil:
+		// tanpa deadline tidak ada kapasitas pembanding, pakai aturan lama
+		if progressPercent < 50 {
+			prediction = "at-risk"
+		}
+	case progressPercent < 100 && !now.Before(*project.Deadline):
+		prediction = "delayed"
+	case remainingHours > availableHours:
 		prediction = "at-risk"
 	}
 
@@ -83,7 +151,203 @@ func (s *AnalyticsService) CalculateProgress(ctx context.Context, projectID uuid
 		MilestonesCompleted: completedMilestones,
 		ProgressPercent: progressPercent,
 		DaysRemaining: daysRemaining,
+		AvailableHours: availableHours,
+		RemainingHours: remainingHours,
 		PredictedCompletion: prediction,
+		EstimateAccuracy: compareEstimates(milestones, logs).Accuracy,
+		DeadlineSlippage: deadlineSlippage(revisions),
 	}, nil
 }
 
+// CompareEstimates menghasilkan perbandingan estimasi vs aktual per milestone.
+// Jam aktual hanya dihitung dari log yang lolos filter.
+func (s *AnalyticsService) CompareEstimates(ctx context.Context, userID, projectID uuid.UUID, filter LogFilter) (*EstimateReport, error) {
+	if _, _, err := s.access.CanView(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.milestoneRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := s.logRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, breakdown, err := s.filterLogs(ctx, logs, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := compareEstimates(milestones, logs)
+	report.ProjectID = projectID
+	report.Breakdown = breakdown
+	return report, nil
+}
+
+// milestoneWeights memilih satu basis bobot untuk seluruh milestone project supaya
+// satuannya tidak tercampur: Weight eksplisit bila ada milestone yang mengisinya,
+// kalau tidak EstimatedHours, kalau tidak bobot rata. Milestone yang tidak punya nilai
+// pada basis terpilih mendapat rata-rata milestone lain.
+func milestoneWeights(milestones []model.Milestone) map[uuid.UUID]float64 {
+	positive := func(v *float64) bool { return v != nil && *v > 0 }
+
+	explicit, estimated := false, false
+	for i := range milestones {
+		explicit = explicit || positive(milestones[i].Weight)
+		estimated = estimated || positive(milestones[i].EstimatedHours)
+	}
+	basis := func(m *model.Milestone) *float64 {
+		switch {
+		case explicit:
+			return m.Weight
+		case estimated:
+			return m.EstimatedHours
+		}
+		return nil
+	}
+
+	sum, n := 0.0, 0
+	for i := range milestones {
+		if v := basis(&milestones[i]); positive(v) {
+			sum += *v
+			n++
+		}
+	}
+	fallback := 1.0
+	if n > 0 {
+		fallback = sum / float64(n)
+	}
+
+	res := make(map[uuid.UUID]float64, len(milestones))
+	for i := range milestones {
+		w := fallback
+		if v := basis(&milestones[i]); positive(v) {
+			w = *v
+		}
+		res[milestones[i].ID] = w
+	}
+	return res
+}
+
+// partialCreditCap membatasi kontribusi task pada milestone yang belum done, supaya
+// bobot penuh hanya didapat setelah milestone benar-benar ditutup.
+const partialCreditCap = 0.9
+
+// weightedProgress menghitung milestone done dan persentase progres berbobot.
+func weightedProgress(milestones []model.Milestone, taskProgress map[uuid.UUID]float64) (int, float64) {
+	completedMilestones := 0
+	totalWeight := 0.0
+	completedWeight := 0.0
+	weights := milestoneWeights(milestones)
+	for i := range milestones {
+		w := weights[milestones[i].ID]
+		totalWeight += w
+		if milestones[i].Status == model.StatusDone {
+			completedMilestones++
+			completedWeight += w
+		} else {
+			// milestone yang belum done tetap dihitung sebagian dari task yang sudah selesai
+			completedWeight += w * taskProgress[milestones[i].ID] * partialCreditCap
+		}
+	}
+
+	if totalWeight == 0 {
+		return completedMilestones, 0
+	}
+	return completedMilestones, completedWeight / totalWeight * 100
+}
+
+// remainingWorkHours memperkirakan sisa jam dari milestone yang belum done, dikurangi
+// fraksi task yang sudah selesai. Milestone tanpa estimasi dihitung satu hari kerja.
+func remainingWorkHours(milestones []model.Milestone, taskProgress map[uuid.UUID]float64, dailyHours float64) float64 {
+	remaining := 0.0
+	for i := range milestones {
+		m := &milestones[i]
+		if m.Status == model.StatusDone {
+			continue
+		}
+		est := dailyHours
+		if m.EstimatedHours != nil && *m.EstimatedHours > 0 {
+			est = *m.EstimatedHours
+		}
+		remaining += est * (1 - taskProgress[m.ID])
+	}
+	return remaining
+}
+
+// taskCompletion menghitung fraksi task selesai per milestone.
+func taskCompletion(tasks []model.Task) map[uuid.UUID]float64 {
+	total := make(map[uuid.UUID]int)
+	done := make(map[uuid.UUID]int)
+	for _, t := range tasks {
+		total[t.MilestoneID]++
+		if t.Done {
+			done[t.MilestoneID]++
+		}
+	}
+
+	res := make(map[uuid.UUID]float64, len(total))
+	for id, n := range total {
+		res[id] = float64(done[id]) / float64(n)
+	}
+	return res
+}
+
+func compareEstimates(milestones []model.Milestone, logs []model.Log) *EstimateReport {
+	actual := make(map[uuid.UUID]float64)
+	for _, l := range logs {
+		if l.MilestoneID != nil {
+			actual[*l.MilestoneID] += float64(l.DurationMinutes) / 60.0
+		}
+	}
+
+	report := &EstimateReport{Milestones: make([]MilestoneEstimate, 0, len(milestones))}
+
+	var sumAbsErr, sumEstimated, sumActual float64
+	weights := milestoneWeights(milestones)
+	for i := range milestones {
+		m := milestones[i]
+		entry := MilestoneEstimate{
+			Milestone:      m,
+			Weight:         weights[m.ID],
+			EstimatedHours: m.EstimatedHours,
+			ActualHours:    actual[m.ID],
+		}
+
+		if m.EstimatedHours != nil && *m.EstimatedHours > 0 {
+			variance := entry.ActualHours - *m.EstimatedHours
+			percent := variance / *m.EstimatedHours * 100
+			entry.VarianceHours = &variance
+			entry.VariancePercent = &percent
+
+			if m.Status == model.StatusDone {
+				acc := &report.Accuracy
+				acc.SampleSize++
+				sumAbsErr += math.Abs(percent)
+				sumEstimated += *m.EstimatedHours
+				sumActual += entry.ActualHours
+
+				switch {
+				case math.Abs(percent) <= estimateTolerance*100:
+					acc.WithinTolerance++
+				case variance > 0:
+					acc.Overruns++
+				default:
+					acc.Underruns++
+				}
+			}
+		}
+
+		report.Milestones = append(report.Milestones, entry)
+	}
+
+	if report.Accuracy.SampleSize > 0 {
+		report.Accuracy.MeanAbsPercentError = sumAbsErr / float64(report.Accuracy.SampleSize)
+		report.Accuracy.OverrunRatio = sumActual / sumEstimated
+	}
+
+	return report
+}
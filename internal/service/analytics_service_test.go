@@ -0,0 +1,96 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func TestMilestoneWeights(t *testing.T) {
+	val := func(v float64) *float64 { return &v }
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		milestones []model.Milestone
+		want       map[uuid.UUID]float64
+	}{
+		{
+			name:       "empty project",
+			milestones: nil,
+			want:       map[uuid.UUID]float64{},
+		},
+		{
+			name: "equal weights without weight or estimate",
+			milestones: []model.Milestone{
+				{ID: a}, {ID: b},
+			},
+			want: map[uuid.UUID]float64{a: 1, b: 1},
+		},
+		{
+			name: "explicit weights win over estimates",
+			milestones: []model.Milestone{
+				{ID: a, Weight: val(3), EstimatedHours: val(40)},
+				{ID: b, Weight: val(1)},
+				{ID: c, EstimatedHours: val(80)},
+			},
+			want: map[uuid.UUID]float64{a: 3, b: 1, c: 2},
+		},
+		{
+			name: "estimates when no weight set",
+			milestones: []model.Milestone{
+				{ID: a, EstimatedHours: val(10)},
+				{ID: b, EstimatedHours: val(30)},
+				{ID: c},
+			},
+			want: map[uuid.UUID]float64{a: 10, b: 30, c: 20},
+		},
+		{
+			name: "zero values are ignored",
+			milestones: []model.Milestone{
+				{ID: a, Weight: val(0), EstimatedHours: val(4)},
+				{ID: b, EstimatedHours: val(0)},
+			},
+			want: map[uuid.UUID]float64{a: 4, b: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := milestoneWeights(tt.milestones); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("milestoneWeights() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedProgress(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	pending := func(id uuid.UUID) model.Milestone { return model.Milestone{ID: id, Status: model.StatusInProgress} }
+	done := func(id uuid.UUID) model.Milestone { return model.Milestone{ID: id, Status: model.StatusDone} }
+
+	tests := []struct {
+		name        string
+		milestones  []model.Milestone
+		tasks       map[uuid.UUID]float64
+		wantDone    int
+		wantPercent float64
+	}{
+		{"no milestones", nil, nil, 0, 0},
+		{"all done", []model.Milestone{done(a), done(b)}, nil, 2, 100},
+		{"all tasks done but milestone open", []model.Milestone{pending(a)}, map[uuid.UUID]float64{a: 1}, 0, 90},
+		{"half tasks done", []model.Milestone{pending(a), done(b)}, map[uuid.UUID]float64{a: 0.5}, 1, 72.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDone, gotPercent := weightedProgress(tt.milestones, tt.tasks)
+			if gotDone != tt.wantDone || gotPercent != tt.wantPercent {
+				t.Errorf("weightedProgress() = (%d, %v), want (%d, %v)", gotDone, gotPercent, tt.wantDone, tt.wantPercent)
+			}
+		})
+	}
+}
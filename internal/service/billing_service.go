@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// BillingService mengelola client, penautan project ke client dan hourly rate.
+type BillingService struct {
+	clientRepo  repository.ClientRepository
+	rateRepo    repository.RateRepository
+	invoiceRepo repository.InvoiceRepository
+	projectRepo repository.ProjectRepository
+}
+
+func NewBillingService(clientRepo repository.ClientRepository, rateRepo repository.RateRepository, invoiceRepo repository.InvoiceRepository, projectRepo repository.ProjectRepository) *BillingService {
+	return &BillingService{
+		clientRepo:  clientRepo,
+		rateRepo:    rateRepo,
+		invoiceRepo: invoiceRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+func (s *BillingService) ListClients(ctx context.Context, userID uuid.UUID) ([]model.Client, error) {
+	return s.clientRepo.FindByUser(ctx, userID)
+}
+
+func (s *BillingService) GetClient(ctx context.Context, userID, id uuid.UUID) (*model.Client, error) {
+	client, err := s.clientRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil || client.UserID != userID {
+		return nil, util.ErrNotFound("client not found")
+	}
+	return client, nil
+}
+
+func (s *BillingService) CreateClient(ctx context.Context, userID uuid.UUID, client *model.Client) error {
+	client.ID = uuid.New()
+	client.UserID = userID
+	if err := validateClient(client); err != nil {
+		return err
+	}
+
+	if err := s.clientRepo.Create(ctx, client); err != nil {
+		if util.IsUniqueViolation(err) {
+			return util.ErrConflict("client name already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+// UpdateClient menyimpan client yang sudah diambil lewat GetClient. Invoice lama tidak
+// berubah karena menyimpan snapshot nama client dan currency.
+func (s *BillingService) UpdateClient(ctx context.Context, client *model.Client) error {
+	if err := validateClient(client); err != nil {
+		return err
+	}
+
+	if err := s.clientRepo.Update(ctx, client); err != nil {
+		if util.IsUniqueViolation(err) {
+			return util.ErrConflict("client name already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteClient ditolak jika client sudah pernah ditagih; project yang tertaut dilepas.
+func (s *BillingService) DeleteClient(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.GetClient(ctx, userID, id); err != nil {
+		return err
+	}
+
+	invoiced, err := s.invoiceRepo.ExistsForClient(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invoiced {
+		return util.ErrConflict("client has invoices and cannot be deleted")
+	}
+
+	return s.clientRepo.Delete(ctx, id)
+}
+
+// SetProjectClient menautkan project ke client (nil = bukan pekerjaan client).
+func (s *BillingService) SetProjectClient(ctx context.Context, userID, projectID uuid.UUID, clientID *uuid.UUID) (*model.Project, error) {
+	project, err := s.ownedProject(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Archived() {
+		return nil, errProjectArchived
+	}
+
+	if clientID != nil {
+		if _, err := s.GetClient(ctx, userID, *clientID); err != nil {
+			return nil, err
+		}
+	}
+
+	project.ClientID = clientID
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (s *BillingService) ListRates(ctx context.Context, userID uuid.UUID) ([]model.HourlyRate, error) {
+	return s.rateRepo.FindByOwner(ctx, userID)
+}
+
+// CreateRate menambah rate baru. Rate tidak diedit: perubahan tarif dicatat sebagai rate
+// baru dengan EffectiveFrom yang lebih baru supaya invoice periode lama tetap benar.
+func (s *BillingService) CreateRate(ctx context.Context, userID uuid.UUID, rate *model.HourlyRate) error {
+	if rate.RateCents <= 0 {
+		return util.ErrBadRequest("rate must be greater than zero")
+	}
+	if rate.EffectiveFrom.IsZero() {
+		return util.ErrBadRequest("effective_from is required")
+	}
+	if rate.ProjectID != nil {
+		if _, err := s.ownedProject(ctx, userID, *rate.ProjectID); err != nil {
+			return err
+		}
+	}
+
+	rate.ID = uuid.New()
+	rate.OwnerID = userID
+	rate.EffectiveFrom = calendarDate(rate.EffectiveFrom)
+	return s.rateRepo.Create(ctx, rate)
+}
+
+func (s *BillingService) DeleteRate(ctx context.Context, userID, id uuid.UUID) error {
+	rate, err := s.rateRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rate == nil || rate.OwnerID != userID {
+		return util.ErrNotFound("rate not found")
+	}
+	return s.rateRepo.Delete(ctx, id)
+}
+
+func (s *BillingService) ownedProject(ctx context.Context, userID, projectID uuid.UUID) (*model.Project, error) {
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil || project.UserID != userID {
+		return nil, util.ErrNotFound("project not found")
+	}
+	return project, nil
+}
+
+func validateClient(c *model.Client) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return util.ErrBadRequest("client name is required")
+	}
+
+	c.Currency = strings.ToUpper(strings.TrimSpace(c.Currency))
+	if c.Currency == "" {
+		c.Currency = "IDR"
+	}
+	if !currencyPattern.MatchString(c.Currency) {
+		return util.ErrBadRequest("currency must be a 3-letter ISO code")
+	}
+	return nil
+}
+
+// resolveRate memilih rate yang berlaku untuk log pada tanggal day: cakupan paling
+// spesifik menang, lalu EffectiveFrom terbaru. rates harus terurut EffectiveFrom desc.
+func resolveRate(rates []model.HourlyRate, projectID, userID uuid.UUID, day time.Time) *model.HourlyRate {
+	var best *model.HourlyRate
+	for i := range rates {
+		r := &rates[i]
+		if r.ProjectID != nil && *r.ProjectID != projectID {
+			continue
+		}
+		if r.UserID != nil && *r.UserID != userID {
+			continue
+		}
+		if calendarDate(r.EffectiveFrom).After(day) {
+			continue
+		}
+		if best == nil || r.Specificity() > best.Specificity() {
+			best = r
+		}
+	}
+	return best
+}
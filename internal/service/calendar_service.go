@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/ics"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// calendarScanLimit mencegah loop tanpa akhir saat mencari hari kerja berikutnya
+// (mis. cuti yang sangat panjang).
+const calendarScanLimit = 366 * 10
+
+const dateLayout = "2006-01-02"
+
+type CalendarService struct {
+	repo     repository.CalendarRepository
+	userRepo repository.UserRepository
+}
+
+type Availability struct {
+	WorkingDays int
+	Hours       float64
+}
+
+// WorkingCalendar adalah snapshot kalender kerja user (hari kerja, libur, cuti)
+// untuk perhitungan kapasitas. Semua tanggal dibaca di timezone user.
+type WorkingCalendar struct {
+	Calendar model.WorkCalendar
+	Location *time.Location
+	holidays map[string]string
+	timeOff  map[string]bool
+}
+
+func (w *WorkingCalendar) IsWorkingDay(t time.Time) bool {
+	day := t.In(w.Location)
+	if !w.Calendar.WorkDays.Includes(day.Weekday()) {
+		return false
+	}
+	key := day.Format(dateLayout)
+	if _, ok := w.holidays[key]; ok {
+		return false
+	}
+	return !w.timeOff[key]
+}
+
+func (w *WorkingCalendar) HoursOn(t time.Time) float64 {
+	if !w.IsWorkingDay(t) {
+		return 0
+	}
+	return w.Calendar.DailyHours
+}
+
+// Availability menghitung hari kerja dan jam tersedia dari tanggal from sampai to (inklusif).
+func (w *WorkingCalendar) Availability(from, to time.Time) Availability {
+	var res Availability
+	end := startOfDay(to, w.Location)
+	for day := startOfDay(from, w.Location); !day.After(end); day = day.AddDate(0, 0, 1) {
+		if w.IsWorkingDay(day) {
+			res.WorkingDays++
+			res.Hours += w.Calendar.DailyHours
+		}
+	}
+	return res
+}
+
+// NextWorkingDay mengembalikan hari kerja pertama pada atau setelah t.
+func (w *WorkingCalendar) NextWorkingDay(t time.Time) time.Time {
+	day := startOfDay(t, w.Location)
+	for i := 0; i < calendarScanLimit && !w.IsWorkingDay(day); i++ {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+func NewCalendarService(repo repository.CalendarRepository, userRepo repository.UserRepository) *CalendarService {
+	return &CalendarService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// Load mengambil kalender kerja lengkap user untuk perhitungan kapasitas.
+func (s *CalendarService) Load(ctx context.Context, userID uuid.UUID) (*WorkingCalendar, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+
+	cal, err := s.GetCalendar(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := s.repo.FindHolidays(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeOff, err := s.repo.FindTimeOff(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &WorkingCalendar{
+		Calendar: *cal,
+		Location: user.Location(),
+		holidays: make(map[string]string, len(holidays)),
+		timeOff:  make(map[string]bool),
+	}
+	for _, h := range holidays {
+		w.holidays[h.Date.Format(dateLayout)] = h.Name
+	}
+	for _, t := range timeOff {
+		for day := t.StartDate; !day.After(t.EndDate); day = day.AddDate(0, 0, 1) {
+			w.timeOff[day.Format(dateLayout)] = true
+		}
+	}
+	return w, nil
+}
+
+func (s *CalendarService) GetCalendar(ctx context.Context, userID uuid.UUID) (*model.WorkCalendar, error) {
+	cal, err := s.repo.FindCalendar(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cal == nil {
+		cal = &model.WorkCalendar{
+			UserID:     userID,
+			WorkDays:   model.DefaultWorkDays,
+			DailyHours: model.DefaultDailyHours,
+		}
+	}
+	return cal, nil
+}
+
+func (s *CalendarService) UpdateCalendar(ctx context.Context, userID uuid.UUID, workDays model.WorkDays, dailyHours float64) (*model.WorkCalendar, error) {
+	if workDays <= 0 || workDays >= 1<<7 {
+		return nil, util.ErrBadRequest("at least one valid work day is required")
+	}
+	if dailyHours <= 0 || dailyHours > 24 {
+		return nil, util.ErrBadRequest("daily hours must be between 0 and 24")
+	}
+
+	cal := &model.WorkCalendar{
+		UserID:     userID,
+		WorkDays:   workDays,
+		DailyHours: dailyHours,
+	}
+	if err := s.repo.SaveCalendar(ctx, cal); err != nil {
+		return nil, err
+	}
+	return cal, nil
+}
+
+func (s *CalendarService) GetHolidays(ctx context.Context, userID uuid.UUID) ([]model.Holiday, error) {
+	return s.repo.FindHolidays(ctx, userID)
+}
+
+func (s *CalendarService) AddHoliday(ctx context.Context, userID uuid.UUID, date time.Time, name string) (*model.Holiday, error) {
+	holiday := model.Holiday{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Date:      calendarDate(date),
+		Name:      name,
+		Source:    model.HolidayManual,
+		CreatedAt: time.Now(),
+	}
+
+	n, err := s.repo.CreateHolidays(ctx, []model.Holiday{holiday})
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, util.ErrConflict("a holiday already exists on this date")
+	}
+	return &holiday, nil
+}
+
+// ImportHolidays membaca file ICS; event beberapa hari dipecah per tanggal dan
+// tanggal yang sudah terdaftar dilewati.
+func (s *CalendarService) ImportHolidays(ctx context.Context, userID uuid.UUID, r io.Reader) (imported, skipped int, err error) {
+	events, err := ics.Parse(r)
+	if err != nil {
+		return 0, 0, util.ErrBadRequest(err.Error())
+	}
+
+	seen := make(map[string]bool)
+	var holidays []model.Holiday
+	now := time.Now()
+	for _, e := range events {
+		for _, day := range e.Days() {
+			key := day.Format(dateLayout)
+			if seen[key] {
+				skipped++
+				continue
+			}
+			seen[key] = true
+			holidays = append(holidays, model.Holiday{
+				ID:        uuid.New(),
+				UserID:    userID,
+				Date:      day,
+				Name:      e.Summary,
+				Source:    model.HolidayICS,
+				CreatedAt: now,
+			})
+		}
+	}
+
+	n, err := s.repo.CreateHolidays(ctx, holidays)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(n), skipped + len(holidays) - int(n), nil
+}
+
+func (s *CalendarService) DeleteHoliday(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.repo.DeleteHoliday(ctx, userID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return util.ErrNotFound("holiday not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *CalendarService) GetTimeOff(ctx context.Context, userID uuid.UUID) ([]model.TimeOff, error) {
+	return s.repo.FindTimeOff(ctx, userID)
+}
+
+func (s *CalendarService) AddTimeOff(ctx context.Context, userID uuid.UUID, start, end time.Time, reason string) (*model.TimeOff, error) {
+	start, end = calendarDate(start), calendarDate(end)
+	if end.Before(start) {
+		return nil, util.ErrBadRequest("end date must not be before start date")
+	}
+	if days := int(end.Sub(start).Hours()/24) + 1; days > calendarScanLimit {
+		return nil, util.ErrBadRequest(fmt.Sprintf("time off cannot exceed %d days", calendarScanLimit))
+	}
+
+	t := &model.TimeOff{
+		ID:        uuid.New(),
+		UserID:    userID,
+		StartDate: start,
+		EndDate:   end,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.CreateTimeOff(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *CalendarService) DeleteTimeOff(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.repo.DeleteTimeOff(ctx, userID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return util.ErrNotFound("time off not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// calendarDate membuang jam dari tanggal input; kolom date tidak menyimpan timezone.
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const maxCommentLength = 5000
+
+// mentionPattern menangkap @handle: email lengkap, bagian lokal email, atau nama tanpa spasi.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([\w.\-]+(?:@[\w\-]+(?:\.[\w\-]+)+)?)`)
+
+// CommentService mengelola komentar berutas dengan @mention. Pemilik project dan supervisor
+// aktif boleh membaca dan menulis; komentar hanya bisa diubah penulisnya.
+type CommentService struct {
+	repo          repository.CommentRepository
+	milestoneRepo repository.MilestoneRepository
+	logRepo       repository.LogRepository
+	insightRepo   repository.AIInsightRepository
+	userRepo      repository.UserRepository
+	access        *AccessChecker
+	uow           repository.UnitOfWork
+	publisher     events.Publisher
+}
+
+func NewCommentService(repo repository.CommentRepository, milestoneRepo repository.MilestoneRepository, logRepo repository.LogRepository, insightRepo repository.AIInsightRepository, userRepo repository.UserRepository, access *AccessChecker, uow repository.UnitOfWork, publisher events.Publisher) *CommentService {
+	return &CommentService{
+		repo:          repo,
+		milestoneRepo: milestoneRepo,
+		logRepo:       logRepo,
+		insightRepo:   insightRepo,
+		userRepo:      userRepo,
+		access:        access,
+		uow:           uow,
+		publisher:     publisher,
+	}
+}
+
+// CommentThread adalah komentar beserta balasannya (rekursif).
+type CommentThread struct {
+	Comment model.Comment
+	Replies []CommentThread
+}
+
+func (s *CommentService) ListComments(ctx context.Context, userID uuid.UUID, target model.CommentTarget, targetID uuid.UUID) ([]CommentThread, error) {
+	projectID, err := s.targetProject(ctx, target, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := s.access.CanView(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.repo.FindByTarget(ctx, target, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachMentions(ctx, comments); err != nil {
+		return nil, err
+	}
+	return buildThreads(comments), nil
+}
+
+// CreateComment menambah komentar; parentID diisi untuk membalas komentar lain di target yang sama.
+func (s *CommentService) CreateComment(ctx context.Context, userID uuid.UUID, target model.CommentTarget, targetID uuid.UUID, parentID *uuid.UUID, body string) (*model.Comment, error) {
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID, err := s.targetProject(ctx, target, targetID)
+	if err != nil {
+		return nil, err
+	}
+	project, _, err := s.access.CanView(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != nil {
+		parent, err := s.repo.FindByID(ctx, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil || parent.TargetType != target || parent.TargetID != targetID {
+			return nil, util.ErrBadRequest("parent comment not found on this target")
+		}
+	}
+
+	c := &model.Comment{
+		ID:         uuid.New(),
+		ProjectID:  projectID,
+		TargetType: target,
+		TargetID:   targetID,
+		ParentID:   parentID,
+		AuthorID:   userID,
+		Body:       body,
+		CreatedAt:  time.Now(),
+	}
+	c.Mentions, err = s.resolveMentions(ctx, project, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, c); err != nil {
+			return err
+		}
+		return s.repo.SetMentions(ctx, c.ID, c.Mentions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(events.CommentCreated, c)
+	return c, nil
+}
+
+func (s *CommentService) UpdateComment(ctx context.Context, userID, id uuid.UUID, body string) (*model.Comment, error) {
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.AuthorID != userID {
+		return nil, util.ErrUnauthorized("only the author can edit this comment")
+	}
+	// akses bisa dicabut setelah komentar ditulis
+	project, _, err := s.access.CanView(ctx, userID, c.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Body = body
+	c.Mentions, err = s.resolveMentions(ctx, project, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, c); err != nil {
+			return err
+		}
+		return s.repo.SetMentions(ctx, c.ID, c.Mentions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(events.CommentUpdated, c)
+	return c, nil
+}
+
+// DeleteComment menghapus komentar beserta balasannya; boleh oleh penulis atau pemilik project.
+func (s *CommentService) DeleteComment(ctx context.Context, userID, id uuid.UUID) error {
+	c, err := s.findComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, role, err := s.access.CanView(ctx, userID, c.ProjectID)
+	if err != nil {
+		return err
+	}
+	if c.AuthorID != userID && role != RoleOwner {
+		return util.ErrUnauthorized("you do not have permission to delete this comment")
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *CommentService) publish(t events.Type, c *model.Comment) {
+	s.publisher.Publish(events.Event{
+		Type:      t,
+		UserID:    c.AuthorID,
+		ProjectID: c.ProjectID,
+		EntityID:  c.ID,
+	})
+}
+
+func (s *CommentService) findComment(ctx context.Context, id uuid.UUID) (*model.Comment, error) {
+	c, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, util.ErrNotFound("comment not found")
+	}
+	return c, nil
+}
+
+// targetProject mengembalikan project pemilik target komentar.
+func (s *CommentService) targetProject(ctx context.Context, target model.CommentTarget, targetID uuid.UUID) (uuid.UUID, error) {
+	switch target {
+	case model.CommentOnProject:
+		// keberadaan project dicek oleh AccessChecker
+		return targetID, nil
+	case model.CommentOnMilestone:
+		m, err := s.milestoneRepo.FindByID(ctx, targetID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if m == nil {
+			return uuid.Nil, util.ErrNotFound("milestone not found")
+		}
+		return m.ProjectID, nil
+	case model.CommentOnLog:
+		l, err := s.logRepo.FindByID(ctx, targetID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if l == nil {
+			return uuid.Nil, util.ErrNotFound("log not found")
+		}
+		return l.ProjectID, nil
+	case model.CommentOnInsight:
+		i, err := s.insightRepo.FindByID(ctx, targetID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if i == nil {
+			return uuid.Nil, util.ErrNotFound("insight not found")
+		}
+		return i.ProjectID, nil
+	}
+	return uuid.Nil, util.ErrBadRequest("unsupported comment target")
+}
+
+// resolveMentions mencocokkan @handle di body dengan peserta project (pemilik dan supervisor
+// aktif). Handle yang tidak cocok dibiarkan sebagai teks biasa; penulis tidak di-mention.
+func (s *CommentService) resolveMentions(ctx context.Context, project *model.Project, authorID uuid.UUID, body string) ([]uuid.UUID, error) {
+	handles := parseMentions(body)
+	if len(handles) == 0 {
+		return nil, nil
+	}
+
+	participants, err := s.access.Members(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []uuid.UUID
+	for _, id := range participants {
+		if id == authorID {
+			continue
+		}
+		user, err := s.userRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil && mentionsUser(handles, user) {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
+
+func (s *CommentService) attachMentions(ctx context.Context, comments []model.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(comments))
+	for i := range comments {
+		ids[i] = comments[i].ID
+	}
+	mentions, err := s.repo.FindMentions(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	byComment := make(map[uuid.UUID][]uuid.UUID)
+	for _, m := range mentions {
+		byComment[m.CommentID] = append(byComment[m.CommentID], m.UserID)
+	}
+	for i := range comments {
+		comments[i].Mentions = byComment[comments[i].ID]
+	}
+	return nil
+}
+
+// parseMentions mengembalikan handle unik (huruf kecil) dari body komentar.
+func parseMentions(body string) map[string]bool {
+	handles := make(map[string]bool)
+	for _, m := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		// tanda baca di akhir kalimat bukan bagian dari handle
+		h := strings.ToLower(strings.TrimRight(m[1], ".-"))
+		if h != "" {
+			handles[h] = true
+		}
+	}
+	return handles
+}
+
+func mentionsUser(handles map[string]bool, user *model.User) bool {
+	email := strings.ToLower(user.Email)
+	local, _, _ := strings.Cut(email, "@")
+	name := strings.ToLower(strings.Join(strings.Fields(user.Name), ""))
+	return handles[email] || handles[local] || (name != "" && handles[name])
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", util.ErrBadRequest("comment body is required")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", util.ErrBadRequest("comment is too long")
+	}
+	return body, nil
+}
+
+// buildThreads menyusun komentar (terurut kronologis) menjadi pohon balasan.
+// Balasan yang parent-nya tidak ditemukan ditampilkan sebagai komentar utama.
+func buildThreads(comments []model.Comment) []CommentThread {
+	children := make(map[uuid.UUID][]model.Comment)
+	known := make(map[uuid.UUID]bool, len(comments))
+	for _, c := range comments {
+		known[c.ID] = true
+	}
+
+	var roots []model.Comment
+	for _, c := range comments {
+		if c.ParentID != nil && known[*c.ParentID] {
+			children[*c.ParentID] = append(children[*c.ParentID], c)
+		} else {
+			roots = append(roots, c)
+		}
+	}
+
+	var build func(list []model.Comment) []CommentThread
+	build = func(list []model.Comment) []CommentThread {
+		res := make([]CommentThread, 0, len(list))
+		for _, c := range list {
+			res = append(res, CommentThread{Comment: c, Replies: build(children[c.ID])})
+		}
+		return res
+	}
+	return build(roots)
+}
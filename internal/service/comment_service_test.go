@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		body string
+		want []string
+	}{
+		{"@budi tolong cek", []string{"budi"}},
+		{"cc @Budi.Santoso dan @sari.", []string{"budi.santoso", "sari"}},
+		{"halo @dosen@kampus.ac.id, sudah?", []string{"dosen@kampus.ac.id"}},
+		{"(@sari) @sari @SARI", []string{"sari"}},
+		{"kirim ke budi@example.com saja", nil},
+		{"@ kosong dan @-", nil},
+		{"tanpa mention", nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for h := range parseMentions(tt.body) {
+			got = append(got, h)
+		}
+		sort.Strings(got)
+		want := append([]string(nil), tt.want...)
+		sort.Strings(want)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("parseMentions(%q) = %q, want %q", tt.body, got, want)
+		}
+	}
+}
+
+func TestMentionsUser(t *testing.T) {
+	user := &model.User{Name: "Budi Santoso", Email: "Budi.S@Example.com"}
+
+	tests := []struct {
+		handle string
+		want   bool
+	}{
+		{"budi.s@example.com", true},
+		{"budi.s", true},
+		{"budisantoso", true},
+		{"budi", false},
+		{"example.com", false},
+	}
+
+	for _, tt := range tests {
+		if got := mentionsUser(map[string]bool{tt.handle: true}, user); got != tt.want {
+			t.Errorf("mentionsUser(%q) = %v, want %v", tt.handle, got, tt.want)
+		}
+	}
+}
+
+func TestResolveMentions(t *testing.T) {
+	ownerID, supervisorID, formerID, outsiderID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	project := &model.Project{ID: uuid.New(), UserID: ownerID}
+	supervisors := &memorySupervisorRepo{invites: map[uuid.UUID]*model.ProjectSupervisor{}}
+	for id, status := range map[uuid.UUID]model.SupervisorStatus{supervisorID: model.SupervisorActive, formerID: model.SupervisorRevoked} {
+		sup := id
+		inv := &model.ProjectSupervisor{ID: uuid.New(), ProjectID: project.ID, SupervisorID: &sup, Status: status}
+		supervisors.invites[inv.ID] = inv
+	}
+
+	s := &CommentService{
+		access: NewAccessChecker(singleProjectRepo{project: project}, supervisors),
+		userRepo: emailUserRepo{users: map[uuid.UUID]*model.User{
+			ownerID:      {ID: ownerID, Name: "Sari Dewi", Email: "sari@example.com"},
+			supervisorID: {ID: supervisorID, Name: "Pak Dosen", Email: "dosen@kampus.ac.id"},
+			formerID:     {ID: formerID, Name: "Mentor Lama", Email: "mentor@example.com"},
+			outsiderID:   {ID: outsiderID, Name: "Orang Lain", Email: "lain@example.com"},
+		}},
+	}
+
+	tests := []struct {
+		name   string
+		author uuid.UUID
+		body   string
+		want   []uuid.UUID
+	}{
+		{"owner mentions supervisor by email", ownerID, "@dosen@kampus.ac.id mohon review", []uuid.UUID{supervisorID}},
+		{"supervisor mentions owner by name", supervisorID, "@SariDewi revisi bagian ini", []uuid.UUID{ownerID}},
+		{"author is not mentioned", ownerID, "@sari catatan untuk diri sendiri", nil},
+		{"revoked supervisor and outsiders are ignored", ownerID, "@mentor @lain", nil},
+		{"no handles", ownerID, "tanpa mention", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := s.resolveMentions(context.Background(), project, tt.author, tt.body)
+		if err != nil {
+			t.Fatalf("%s: resolveMentions() err = %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: resolveMentions() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
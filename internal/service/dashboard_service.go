@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	dashboardCacheTTL    = 30 * time.Second
+	dashboardDueWindow   = 7 * 24 * time.Hour
+	dashboardRecentLimit = 10
+)
+
+type DashboardProject struct {
+	Project             model.Project
+	ProgressPercent     float64
+	MilestonesTotal     int
+	MilestonesCompleted int
+	InsightStatus       *model.InsightStatus
+	InsightAt           *time.Time
+}
+
+type DashboardMilestone struct {
+	Milestone   model.Milestone
+	ProjectName string
+}
+
+type ActivityKind string
+
+const (
+	ActivityLog             ActivityKind = "log"
+	ActivityMilestoneStatus ActivityKind = "milestone_status"
+)
+
+type ActivityItem struct {
+	Kind        ActivityKind
+	At          time.Time
+	ProjectID   uuid.UUID
+	ProjectName string
+	EntityID    uuid.UUID
+	Summary     string
+	Minutes     int
+}
+
+type Dashboard struct {
+	Projects       []DashboardProject
+	DueSoon        []DashboardMilestone
+	Overdue        []DashboardMilestone
+	HoursThisWeek  float64
+	HoursLastWeek  float64
+	RunningTimer   *model.Timer
+	Goals          []GoalProgress
+	RecentActivity []ActivityItem
+	GeneratedAt    time.Time
+}
+
+type DashboardService struct {
+	userRepo      repository.UserRepository
+	projectRepo   repository.ProjectRepository
+	milestoneRepo repository.MilestoneRepository
+	taskRepo      repository.TaskRepository
+	insightRepo   repository.AIInsightRepository
+	logRepo       repository.LogRepository
+	historyRepo   repository.MilestoneHistoryRepository
+	timerRepo     repository.TimerRepository
+	goalSvc       *GoalService
+	cache         *dashboardCache
+}
+
+func NewDashboardService(userRepo repository.UserRepository, projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, taskRepo repository.TaskRepository, insightRepo repository.AIInsightRepository, logRepo repository.LogRepository, historyRepo repository.MilestoneHistoryRepository, timerRepo repository.TimerRepository, goalSvc *GoalService, bus *events.Bus) *DashboardService {
+	s := &DashboardService{
+		userRepo:      userRepo,
+		projectRepo:   projectRepo,
+		milestoneRepo: milestoneRepo,
+		taskRepo:      taskRepo,
+		insightRepo:   insightRepo,
+		logRepo:       logRepo,
+		historyRepo:   historyRepo,
+		timerRepo:     timerRepo,
+		goalSvc:       goalSvc,
+		cache:         newDashboardCache(dashboardCacheTTL),
+	}
+
+	// perubahan log, milestone, timer, dan goal membuat dashboard yang tersimpan basi
+	bus.Subscribe(s.cache.invalidate)
+	return s
+}
+
+// GetDashboard mengumpulkan ringkasan user dengan jumlah query tetap
+// (tidak bertambah per project), lalu menyimpannya sebentar di cache.
+func (s *DashboardService) GetDashboard(ctx context.Context, userID uuid.UUID) (*Dashboard, error) {
+	if cached := s.cache.get(userID); cached != nil {
+		return cached, nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+
+	projects, err := s.projectRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	projects = activeProjects(projects)
+
+	projectIDs := make([]uuid.UUID, 0, len(projects))
+	projectNames := make(map[uuid.UUID]string, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID)
+		projectNames[p.ID] = p.Name
+	}
+
+	milestones, err := s.milestoneRepo.FindByProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.FindByProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	insights, err := s.insightRepo.FindLatestByProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	weekStart := startOfWeek(now, user.Location())
+	weekLogs, err := s.logRepo.FindByUserBetween(ctx, userID, weekStart.AddDate(0, 0, -7), weekStart.AddDate(0, 0, 7))
+	if err != nil {
+		return nil, err
+	}
+
+	recentLogs, err := s.logRepo.FindRecentByUser(ctx, userID, dashboardRecentLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	recentHistory, err := s.historyRepo.FindRecentByProjects(ctx, projectIDs, dashboardRecentLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	timer, err := s.timerRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := s.goalSvc.GetProgressByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := &Dashboard{
+		Projects:       make([]DashboardProject, 0, len(projects)),
+		DueSoon:        []DashboardMilestone{},
+		Overdue:        []DashboardMilestone{},
+		RunningTimer:   timer,
+		Goals:          goals,
+		RecentActivity: []ActivityItem{},
+		GeneratedAt:    now,
+	}
+
+	milestonesByProject := make(map[uuid.UUID][]model.Milestone, len(projects))
+	milestoneByID := make(map[uuid.UUID]model.Milestone, len(milestones))
+	for _, m := range milestones {
+		milestonesByProject[m.ProjectID] = append(milestonesByProject[m.ProjectID], m)
+		milestoneByID[m.ID] = m
+
+		if m.Status == model.StatusDone || m.DueDate == nil {
+			continue
+		}
+		entry := DashboardMilestone{Milestone: m, ProjectName: projectNames[m.ProjectID]}
+		switch {
+		case m.DueDate.Before(now):
+			dashboard.Overdue = append(dashboard.Overdue, entry)
+		case m.DueDate.Before(now.Add(dashboardDueWindow)):
+			dashboard.DueSoon = append(dashboard.DueSoon, entry)
+		}
+	}
+	sortByDueDate(dashboard.DueSoon)
+	sortByDueDate(dashboard.Overdue)
+
+	insightByProject := make(map[uuid.UUID]model.AIInsight, len(insights))
+	for _, in := range insights {
+		insightByProject[in.ProjectID] = in
+	}
+
+	taskProgress := taskCompletion(tasks)
+	for _, p := range projects {
+		completed, percent := weightedProgress(milestonesByProject[p.ID], taskProgress)
+		entry := DashboardProject{
+			Project:             p,
+			ProgressPercent:     percent,
+			MilestonesTotal:     len(milestonesByProject[p.ID]),
+			MilestonesCompleted: completed,
+		}
+		if in, ok := insightByProject[p.ID]; ok {
+			status := in.Status
+			generatedAt := in.GeneratedAt
+			entry.InsightStatus = &status
+			entry.InsightAt = &generatedAt
+		}
+		dashboard.Projects = append(dashboard.Projects, entry)
+	}
+
+	for _, l := range weekLogs {
+		hours := float64(l.DurationMinutes) / 60.0
+		if l.LoggedAt.Before(weekStart) {
+			dashboard.HoursLastWeek += hours
+		} else {
+			dashboard.HoursThisWeek += hours
+		}
+	}
+
+	for _, l := range recentLogs {
+		dashboard.RecentActivity = append(dashboard.RecentActivity, ActivityItem{
+			Kind:        ActivityLog,
+			At:          l.LoggedAt,
+			ProjectID:   l.ProjectID,
+			ProjectName: projectNames[l.ProjectID],
+			EntityID:    l.ID,
+			Summary:     l.Description,
+			Minutes:     l.DurationMinutes,
+		})
+	}
+	for _, h := range recentHistory {
+		m := milestoneByID[h.MilestoneID]
+		dashboard.RecentActivity = append(dashboard.RecentActivity, ActivityItem{
+			Kind:        ActivityMilestoneStatus,
+			At:          h.ChangedAt,
+			ProjectID:   m.ProjectID,
+			ProjectName: projectNames[m.ProjectID],
+			EntityID:    h.MilestoneID,
+			Summary:     m.Name + ": " + string(h.FromStatus) + " -> " + string(h.ToStatus),
+		})
+	}
+	sort.Slice(dashboard.RecentActivity, func(i, j int) bool {
+		return dashboard.RecentActivity[i].At.After(dashboard.RecentActivity[j].At)
+	})
+	if len(dashboard.RecentActivity) > dashboardRecentLimit {
+		dashboard.RecentActivity = dashboard.RecentActivity[:dashboardRecentLimit]
+	}
+
+	s.cache.set(userID, projectIDs, dashboard)
+	return dashboard, nil
+}
+
+func sortByDueDate(items []DashboardMilestone) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Milestone.DueDate.Before(*items[j].Milestone.DueDate)
+	})
+}
+
+// startOfWeek mengembalikan Senin 00:00 minggu berjalan di timezone user.
+func startOfWeek(t time.Time, loc *time.Location) time.Time {
+	day := startOfDay(t, loc)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+type dashboardCacheEntry struct {
+	dashboard *Dashboard
+	projects  map[uuid.UUID]bool
+	expiresAt time.Time
+}
+
+// dashboardCache adalah cache in-memory per user. Entri dibuang saat TTL habis
+// atau saat ada event untuk user/project yang tercakup di dashboard tersebut.
+type dashboardCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[uuid.UUID]dashboardCacheEntry
+}
+
+func newDashboardCache(ttl time.Duration) *dashboardCache {
+	return &dashboardCache{
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]dashboardCacheEntry),
+	}
+}
+
+func (c *dashboardCache) get(userID uuid.UUID) *Dashboard {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, userID)
+		return nil
+	}
+	return entry.dashboard
+}
+
+func (c *dashboardCache) set(userID uuid.UUID, projectIDs []uuid.UUID, d *Dashboard) {
+	projects := make(map[uuid.UUID]bool, len(projectIDs))
+	for _, id := range projectIDs {
+		projects[id] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[userID] = dashboardCacheEntry{
+		dashboard: d,
+		projects:  projects,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+}
+
+func (c *dashboardCache) invalidate(e events.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for userID, entry := range c.entries {
+		if userID == e.UserID || entry.projects[e.ProjectID] {
+			delete(c.entries, userID)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	htmltemplate "html/template"
+	"log"
+	"sort"
+	"strconv"
+	texttemplate "text/template"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/mail"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	digestDateFormat   = "Mon, 02 Jan 2006"
+	digestUpcomingDays = 7
+)
+
+//go:embed templates/digest.html.tmpl templates/digest.txt.tmpl
+var digestTemplates embed.FS
+
+var (
+	digestHTML = htmltemplate.Must(htmltemplate.ParseFS(digestTemplates, "templates/digest.html.tmpl"))
+	digestText = texttemplate.Must(texttemplate.ParseFS(digestTemplates, "templates/digest.txt.tmpl"))
+)
+
+// DigestService menyusun dan mengirim digest mingguan (Senin pagi waktu lokal user) berisi
+// jam kerja minggu lalu per project, milestone selesai, tenggat terdekat dan insight terbaru.
+type DigestService struct {
+	userRepo         repository.UserRepository
+	projectRepo      repository.ProjectRepository
+	logRepo          repository.LogRepository
+	milestoneRepo    repository.MilestoneRepository
+	insightRepo      repository.AIInsightRepository
+	notificationRepo repository.NotificationRepository
+	mailer           mail.Mailer
+}
+
+func NewDigestService(userRepo repository.UserRepository, projectRepo repository.ProjectRepository, logRepo repository.LogRepository, milestoneRepo repository.MilestoneRepository, insightRepo repository.AIInsightRepository, notificationRepo repository.NotificationRepository, mailer mail.Mailer) *DigestService {
+	return &DigestService{
+		userRepo:         userRepo,
+		projectRepo:      projectRepo,
+		logRepo:          logRepo,
+		milestoneRepo:    milestoneRepo,
+		insightRepo:      insightRepo,
+		notificationRepo: notificationRepo,
+		mailer:           mailer,
+	}
+}
+
+// Digest adalah data template; semua tanggal sudah diformat di timezone user.
+type Digest struct {
+	UserName     string
+	UserEmail    string
+	WeekStart    time.Time // Senin minggu pengiriman (lokal); periode yang diringkas adalah minggu sebelumnya
+	PeriodLabel  string
+	TotalMinutes int
+	TotalHours   string
+	Projects     []DigestProject
+	Completed    []DigestMilestone
+	Overdue      []DigestMilestone
+	Upcoming     []DigestMilestone
+}
+
+type DigestProject struct {
+	Name           string
+	Minutes        int
+	Hours          string
+	InsightStatus  string
+	InsightDate    string
+	InsightContent string
+}
+
+type DigestMilestone struct {
+	Name        string
+	ProjectName string
+	Date        string
+}
+
+// RenderedDigest adalah digest yang siap dikirim atau ditampilkan sebagai preview.
+type RenderedDigest struct {
+	Digest  *Digest
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Preview merender digest minggu yang memuat tanggal at tanpa mengirimnya.
+func (s *DigestService) Preview(ctx context.Context, userID uuid.UUID, at time.Time) (*RenderedDigest, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+
+	digest, err := s.build(ctx, user, startOfWeek(at, user.Location()))
+	if err != nil {
+		return nil, err
+	}
+	return renderDigest(digest)
+}
+
+// RunScheduler memeriksa digest yang jatuh tempo secara berkala sampai ctx dibatalkan.
+// Interval sebaiknya jauh lebih kecil dari satu jam agar DigestHour tepat waktu.
+func (s *DigestService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.SendDue(ctx, time.Now())
+		if err != nil {
+			log.Printf("weekly digest failed: %v", err)
+		} else if n > 0 {
+			log.Printf("weekly digest sent to %d user(s)", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue mengirim digest ke setiap pelanggan yang waktu lokalnya sudah melewati Senin
+// DigestHour dan belum menerima digest minggu ini. Digest diklaim sebelum dikirim, jadi
+// setiap minggu dikirim paling banyak sekali meski API berjalan di beberapa instance.
+func (s *DigestService) SendDue(ctx context.Context, now time.Time) (int, error) {
+	subs, err := s.notificationRepo.FindDigestSubscribers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, pref := range subs {
+		user, err := s.userRepo.FindByID(ctx, pref.UserID)
+		if err != nil {
+			return sent, err
+		}
+		if user == nil {
+			continue
+		}
+
+		weekStart := startOfWeek(now, user.Location())
+		week := calendarDate(weekStart)
+		if now.Before(weekStart.Add(time.Duration(pref.DigestHour) * time.Hour)) {
+			continue
+		}
+		if pref.DigestSentWeek != nil && !pref.DigestSentWeek.Before(week) {
+			continue
+		}
+
+		claimed, err := s.notificationRepo.ClaimDigest(ctx, user.ID, week)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.send(ctx, user, weekStart); err != nil {
+			// tidak dicoba ulang minggu ini; kegagalan satu user tidak menghentikan yang lain
+			log.Printf("weekly digest for user %s failed: %v", user.ID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (s *DigestService) send(ctx context.Context, user *model.User, weekStart time.Time) error {
+	digest, err := s.build(ctx, user, weekStart)
+	if err != nil {
+		return err
+	}
+	// user tanpa project aktif dan tanpa log tidak perlu digest kosong
+	if len(digest.Projects) == 0 {
+		return nil
+	}
+
+	rendered, err := renderDigest(digest)
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: rendered.Subject,
+		Text:    rendered.Text,
+		HTML:    rendered.HTML,
+	})
+}
+
+// build menyusun digest untuk minggu yang dimulai weekStart (Senin 00:00 lokal): log dan
+// milestone selesai diambil dari minggu sebelumnya, tenggat dari tujuh hari ke depan.
+func (s *DigestService) build(ctx context.Context, user *model.User, weekStart time.Time) (*Digest, error) {
+	loc := user.Location()
+	prevStart := weekStart.AddDate(0, 0, -7)
+	upcomingEnd := weekStart.AddDate(0, 0, digestUpcomingDays)
+
+	projects, err := s.projectRepo.FindByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	projectByID := make(map[uuid.UUID]*model.Project, len(projects))
+	var activeIDs []uuid.UUID
+	for i := range projects {
+		projectByID[projects[i].ID] = &projects[i]
+		if !projects[i].Archived() {
+			activeIDs = append(activeIDs, projects[i].ID)
+		}
+	}
+
+	logs, err := s.logRepo.FindByUserBetween(ctx, user.ID, prevStart, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	minutes := make(map[uuid.UUID]int)
+	total := 0
+	for _, l := range logs {
+		if projectByID[l.ProjectID] == nil {
+			continue
+		}
+		minutes[l.ProjectID] += l.DurationMinutes
+		total += l.DurationMinutes
+	}
+
+	milestones, err := s.milestoneRepo.FindByProjects(ctx, activeIDs)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.insightRepo.FindLatestByProjects(ctx, activeIDs)
+	if err != nil {
+		return nil, err
+	}
+	insights := make(map[uuid.UUID]*model.AIInsight, len(latest))
+	for i := range latest {
+		insights[latest[i].ProjectID] = &latest[i]
+	}
+
+	digest := &Digest{
+		UserName:     user.Name,
+		UserEmail:    user.Email,
+		WeekStart:    weekStart,
+		PeriodLabel:  prevStart.Format("02 Jan") + " - " + weekStart.AddDate(0, 0, -1).Format("02 Jan 2006"),
+		TotalMinutes: total,
+		TotalHours:   digestHours(total),
+		Projects:     []DigestProject{},
+		Completed:    []DigestMilestone{},
+		Overdue:      []DigestMilestone{},
+		Upcoming:     []DigestMilestone{},
+	}
+
+	// project aktif selalu tampil; project arsip hanya jika ada log minggu lalu
+	for _, p := range projects {
+		if p.Archived() && minutes[p.ID] == 0 {
+			continue
+		}
+		item := DigestProject{Name: p.Name, Minutes: minutes[p.ID], Hours: digestHours(minutes[p.ID])}
+		if in := insights[p.ID]; in != nil {
+			item.InsightStatus = string(in.Status)
+			item.InsightDate = in.GeneratedAt.In(loc).Format(digestDateFormat)
+			item.InsightContent = in.Content
+		}
+		digest.Projects = append(digest.Projects, item)
+	}
+	sort.SliceStable(digest.Projects, func(i, j int) bool {
+		if digest.Projects[i].Minutes != digest.Projects[j].Minutes {
+			return digest.Projects[i].Minutes > digest.Projects[j].Minutes
+		}
+		return digest.Projects[i].Name < digest.Projects[j].Name
+	})
+
+	sort.SliceStable(milestones, func(i, j int) bool {
+		return milestoneSortTime(milestones[i]).Before(milestoneSortTime(milestones[j]))
+	})
+	for _, m := range milestones {
+		entry := DigestMilestone{Name: m.Name, ProjectName: projectByID[m.ProjectID].Name}
+		switch {
+		case m.Status == model.StatusDone:
+			if m.CompletedAt != nil && !m.CompletedAt.Before(prevStart) && m.CompletedAt.Before(weekStart) {
+				entry.Date = m.CompletedAt.In(loc).Format(digestDateFormat)
+				digest.Completed = append(digest.Completed, entry)
+			}
+		case m.DueDate == nil:
+		case m.DueDate.Before(weekStart):
+			entry.Date = m.DueDate.In(loc).Format(digestDateFormat)
+			digest.Overdue = append(digest.Overdue, entry)
+		case m.DueDate.Before(upcomingEnd):
+			entry.Date = m.DueDate.In(loc).Format(digestDateFormat)
+			digest.Upcoming = append(digest.Upcoming, entry)
+		}
+	}
+
+	return digest, nil
+}
+
+func renderDigest(d *Digest) (*RenderedDigest, error) {
+	var text, html bytes.Buffer
+	if err := digestText.Execute(&text, d); err != nil {
+		return nil, err
+	}
+	if err := digestHTML.Execute(&html, d); err != nil {
+		return nil, err
+	}
+	return &RenderedDigest{
+		Digest:  d,
+		Subject: "Ringkasan mingguan DevTracker: " + d.TotalHours + " jam (" + d.PeriodLabel + ")",
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// milestoneSortTime mengurutkan milestone selesai berdasarkan CompletedAt dan lainnya berdasarkan DueDate.
+func milestoneSortTime(m model.Milestone) time.Time {
+	if m.Status == model.StatusDone && m.CompletedAt != nil {
+		return *m.CompletedAt
+	}
+	if m.DueDate != nil {
+		return *m.DueDate
+	}
+	return time.Time{}
+}
+
+func digestHours(minutes int) string {
+	return strconv.FormatFloat(float64(minutes)/60, 'f', 1, 64)
+}
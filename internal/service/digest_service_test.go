@@ -0,0 +1,153 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+)
+
+func TestDigestHours(t *testing.T) {
+	tests := []struct {
+		minutes int
+		want    string
+	}{
+		{0, "0.0"},
+		{30, "0.5"},
+		{60, "1.0"},
+		{95, "1.6"},
+		{605, "10.1"},
+	}
+
+	for _, tt := range tests {
+		if got := digestHours(tt.minutes); got != tt.want {
+			t.Errorf("digestHours(%d) = %q, want %q", tt.minutes, got, tt.want)
+		}
+	}
+}
+
+func TestMilestoneSortTime(t *testing.T) {
+	completed := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		milestone model.Milestone
+		want      time.Time
+	}{
+		{"done uses completed_at", model.Milestone{Status: model.StatusDone, CompletedAt: &completed, DueDate: &due}, completed},
+		{"done without completed_at uses due_date", model.Milestone{Status: model.StatusDone, DueDate: &due}, due},
+		{"open uses due_date", model.Milestone{Status: model.StatusPending, CompletedAt: &completed, DueDate: &due}, due},
+		{"no dates", model.Milestone{Status: model.StatusPending}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		if got := milestoneSortTime(tt.milestone); !got.Equal(tt.want) {
+			t.Errorf("%s: milestoneSortTime() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRenderDigest(t *testing.T) {
+	full := &Digest{
+		UserName:     "Budi <admin>",
+		PeriodLabel:  "04 Mar - 10 Mar 2024",
+		TotalMinutes: 330,
+		TotalHours:   "5.5",
+		Projects: []DigestProject{
+			{Name: "Toko & Kasir", Minutes: 300, Hours: "5.0", InsightStatus: "on_track", InsightDate: "Mon, 04 Mar 2024", InsightContent: "Progress stabil."},
+			{Name: "Landing Page", Minutes: 30, Hours: "0.5"},
+		},
+		Completed: []DigestMilestone{{Name: "Desain UI", ProjectName: "Toko & Kasir", Date: "Tue, 05 Mar 2024"}},
+		Overdue:   []DigestMilestone{{Name: "Integrasi API", ProjectName: "Toko & Kasir", Date: "Fri, 08 Mar 2024"}},
+		Upcoming:  []DigestMilestone{{Name: "Deploy", ProjectName: "Landing Page", Date: "Wed, 13 Mar 2024"}},
+	}
+	empty := &Digest{UserName: "Sari", PeriodLabel: "04 Mar - 10 Mar 2024", TotalHours: "0.0"}
+
+	tests := []struct {
+		name        string
+		digest      *Digest
+		subject     string
+		textWant    []string
+		textNotWant []string
+		htmlWant    []string
+		htmlNotWant []string
+	}{
+		{
+			name:    "full digest",
+			digest:  full,
+			subject: "Ringkasan mingguan DevTracker: 5.5 jam (04 Mar - 10 Mar 2024)",
+			textWant: []string{
+				"Halo Budi <admin>,",
+				"TOTAL JAM MINGGU LALU: 5.5 jam",
+				"- Toko & Kasir: 5.0 jam | status on_track (Mon, 04 Mar 2024)",
+				"- Landing Page: 0.5 jam\n",
+				"- Desain UI (Toko & Kasir) - Tue, 05 Mar 2024",
+				"TERLAMBAT\n- Integrasi API (Toko & Kasir) - tenggat Fri, 08 Mar 2024",
+				"- Deploy (Landing Page) - Wed, 13 Mar 2024",
+				"INSIGHT TERBARU: Toko & Kasir\nProgress stabil.",
+			},
+			textNotWant: []string{"Tidak ada milestone", "Tidak ada tenggat", "INSIGHT TERBARU: Landing Page"},
+			htmlWant: []string{
+				"Halo Budi &lt;admin&gt;,",
+				"<td>Toko &amp; Kasir</td>",
+				"on_track <small>(Mon, 04 Mar 2024)</small>",
+				"<td>-</td>",
+				"Terlambat</h3>",
+				"<li>Deploy <small>(Landing Page, Wed, 13 Mar 2024)</small></li>",
+			},
+			htmlNotWant: []string{"<admin>", "Belum ada project aktif."},
+		},
+		{
+			name:    "empty digest",
+			digest:  empty,
+			subject: "Ringkasan mingguan DevTracker: 0.0 jam (04 Mar - 10 Mar 2024)",
+			textWant: []string{
+				"TOTAL JAM MINGGU LALU: 0.0 jam",
+				"- Tidak ada milestone yang selesai minggu lalu.",
+				"- Tidak ada tenggat minggu ini.",
+			},
+			textNotWant: []string{"TERLAMBAT", "INSIGHT TERBARU"},
+			htmlWant: []string{
+				"Belum ada project aktif.",
+				"<li>Tidak ada milestone yang selesai minggu lalu.</li>",
+				"<li>Tidak ada tenggat minggu ini.</li>",
+			},
+			htmlNotWant: []string{"Terlambat", "Insight terbaru"},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := renderDigest(tt.digest)
+		if err != nil {
+			t.Fatalf("%s: renderDigest() error = %v", tt.name, err)
+		}
+		if got.Digest != tt.digest {
+			t.Errorf("%s: Digest not passed through", tt.name)
+		}
+		if got.Subject != tt.subject {
+			t.Errorf("%s: Subject = %q, want %q", tt.name, got.Subject, tt.subject)
+		}
+		for _, s := range tt.textWant {
+			if !strings.Contains(got.Text, s) {
+				t.Errorf("%s: Text missing %q\n%s", tt.name, s, got.Text)
+			}
+		}
+		for _, s := range tt.textNotWant {
+			if strings.Contains(got.Text, s) {
+				t.Errorf("%s: Text should not contain %q", tt.name, s)
+			}
+		}
+		for _, s := range tt.htmlWant {
+			if !strings.Contains(got.HTML, s) {
+				t.Errorf("%s: HTML missing %q\n%s", tt.name, s, got.HTML)
+			}
+		}
+		for _, s := range tt.htmlNotWant {
+			if strings.Contains(got.HTML, s) {
+				t.Errorf("%s: HTML should not contain %q", tt.name, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// goalHistoryMaxPeriods membatasi panjang history (mis. goal harian yang sudah berjalan lama).
+const goalHistoryMaxPeriods = 90
+
+type GoalService struct {
+	repo        repository.GoalRepository
+	logRepo     repository.LogRepository
+	userRepo    repository.UserRepository
+	projectRepo repository.ProjectRepository
+	publisher   events.Publisher
+}
+
+// GoalPeriodResult adalah capaian goal dalam satu periode. InProgress berarti
+// periode masih berjalan sehingga Hit belum final.
+type GoalPeriodResult struct {
+	Start       time.Time
+	End         time.Time
+	LoggedHours float64
+	TargetHours float64
+	Hit         bool
+	InProgress  bool
+}
+
+func (r GoalPeriodResult) Percent() float64 {
+	if r.TargetHours <= 0 {
+		return 0
+	}
+	return r.LoggedHours / r.TargetHours * 100
+}
+
+type GoalProgress struct {
+	Goal    model.Goal
+	Active  bool
+	Current *GoalPeriodResult
+}
+
+type GoalHistory struct {
+	Goal    model.Goal
+	Periods []GoalPeriodResult
+	Hits    int
+	Misses  int
+}
+
+func NewGoalService(repo repository.GoalRepository, logRepo repository.LogRepository, userRepo repository.UserRepository, projectRepo repository.ProjectRepository, publisher events.Publisher) *GoalService {
+	return &GoalService{
+		repo:        repo,
+		logRepo:     logRepo,
+		userRepo:    userRepo,
+		projectRepo: projectRepo,
+		publisher:   publisher,
+	}
+}
+
+func (s *GoalService) CreateGoal(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID, period model.GoalPeriod, targetHours float64, start time.Time, end *time.Time) (*model.Goal, error) {
+	goal := &model.Goal{
+		ID:          uuid.New(),
+		UserID:      userID,
+		ProjectID:   projectID,
+		Period:      period,
+		TargetHours: targetHours,
+		StartDate:   start,
+		EndDate:     end,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.validate(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	s.publish(goal)
+	return goal, nil
+}
+
+func (s *GoalService) GetGoalsByUser(ctx context.Context, userID uuid.UUID) ([]model.Goal, error) {
+	return s.repo.FindByUser(ctx, userID)
+}
+
+func (s *GoalService) GetGoal(ctx context.Context, userID, id uuid.UUID) (*model.Goal, error) {
+	goal, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if goal == nil {
+		return nil, util.ErrNotFound("goal not found")
+	}
+	if goal.UserID != userID {
+		return nil, util.ErrUnauthorized("you do not have permission to access this goal")
+	}
+	return goal, nil
+}
+
+func (s *GoalService) UpdateGoal(ctx context.Context, goal *model.Goal) error {
+	if err := s.validate(ctx, goal); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, goal); err != nil {
+		return err
+	}
+
+	s.publish(goal)
+	return nil
+}
+
+func (s *GoalService) DeleteGoal(ctx context.Context, userID, id uuid.UUID) error {
+	goal, err := s.GetGoal(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.publish(goal)
+	return nil
+}
+
+// GetProgressByUser menghitung capaian periode berjalan untuk semua goal user
+// dengan satu query log (rentang periode terpanjang).
+func (s *GoalService) GetProgressByUser(ctx context.Context, userID uuid.UUID) ([]GoalProgress, error) {
+	goals, err := s.repo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.currentProgress(ctx, userID, goals)
+}
+
+// GetHistory menghitung hit/miss per periode dari StartDate sampai periode berjalan.
+func (s *GoalService) GetHistory(ctx context.Context, userID, id uuid.UUID) (*GoalHistory, error) {
+	goal, err := s.GetGoal(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.userLocation(ctx, goal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.history(ctx, goal, loc, time.Now())
+}
+
+// ProjectGoalSummary merangkum goal aktif project untuk konten insight.
+func (s *GoalService) ProjectGoalSummary(ctx context.Context, projectID uuid.UUID) ([]GoalHistory, error) {
+	goals, err := s.repo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var res []GoalHistory
+	for i := range goals {
+		if !goalActive(&goals[i], now) {
+			continue
+		}
+		loc, err := s.userLocation(ctx, goals[i].UserID)
+		if err != nil {
+			return nil, err
+		}
+		h, err := s.history(ctx, &goals[i], loc, now)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *h)
+	}
+	return res, nil
+}
+
+func (s *GoalService) currentProgress(ctx context.Context, userID uuid.UUID, goals []model.Goal) ([]GoalProgress, error) {
+	res := make([]GoalProgress, 0, len(goals))
+	if len(goals) == 0 {
+		return res, nil
+	}
+
+	loc, err := s.userLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// gabungan periode bulanan dan mingguan berjalan mencakup semua periode goal saat ini
+	from := periodStart(now, model.GoalMonthly, loc)
+	to := periodEnd(from, model.GoalMonthly)
+	week := periodStart(now, model.GoalWeekly, loc)
+	if week.Before(from) {
+		from = week
+	}
+	if weekEnd := periodEnd(week, model.GoalWeekly); weekEnd.After(to) {
+		to = weekEnd
+	}
+	logs, err := s.logRepo.FindByUserBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range goals {
+		g := goals[i]
+		progress := GoalProgress{Goal: g, Active: goalActive(&g, now)}
+		if progress.Active {
+			start := periodStart(now, g.Period, loc)
+			result := periodResult(&g, logs, start, periodEnd(start, g.Period), now)
+			progress.Current = &result
+		}
+		res = append(res, progress)
+	}
+	return res, nil
+}
+
+func (s *GoalService) history(ctx context.Context, goal *model.Goal, loc *time.Location, now time.Time) (*GoalHistory, error) {
+	first := periodStart(goal.StartDate, goal.Period, loc)
+	last := periodStart(now, goal.Period, loc)
+	if goal.EndDate != nil && goal.EndDate.Before(now) {
+		last = periodStart(*goal.EndDate, goal.Period, loc)
+	}
+
+	var starts []time.Time
+	for start := first; !start.After(last); start = periodEnd(start, goal.Period) {
+		starts = append(starts, start)
+	}
+	if len(starts) > goalHistoryMaxPeriods {
+		starts = starts[len(starts)-goalHistoryMaxPeriods:]
+	}
+
+	result := &GoalHistory{Goal: *goal, Periods: []GoalPeriodResult{}}
+	if len(starts) == 0 {
+		return result, nil
+	}
+
+	logs, err := s.logRepo.FindByUserBetween(ctx, goal.UserID, starts[0], periodEnd(starts[len(starts)-1], goal.Period))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, start := range starts {
+		period := periodResult(goal, logs, start, periodEnd(start, goal.Period), now)
+		if !period.InProgress {
+			if period.Hit {
+				result.Hits++
+			} else {
+				result.Misses++
+			}
+		}
+		result.Periods = append(result.Periods, period)
+	}
+	return result, nil
+}
+
+func (s *GoalService) validate(ctx context.Context, goal *model.Goal) error {
+	switch goal.Period {
+	case model.GoalDaily, model.GoalWeekly, model.GoalMonthly:
+	default:
+		return util.ErrBadRequest(fmt.Sprintf("invalid period %q", goal.Period))
+	}
+
+	if goal.TargetHours <= 0 {
+		return util.ErrBadRequest("target hours must be greater than zero")
+	}
+
+	if goal.StartDate.IsZero() {
+		return util.ErrBadRequest("start date is required")
+	}
+
+	if goal.EndDate != nil && !goal.EndDate.After(goal.StartDate) {
+		return util.ErrBadRequest("end date must be after start date")
+	}
+
+	if goal.ProjectID != nil {
+		project, err := s.projectRepo.FindByID(ctx, *goal.ProjectID)
+		if err != nil {
+			return err
+		}
+		if project == nil {
+			return util.ErrNotFound("project not found")
+		}
+		if project.UserID != goal.UserID {
+			return util.ErrUnauthorized("you do not have permission to set goals on this project")
+		}
+	}
+	return nil
+}
+
+func (s *GoalService) userLocation(ctx context.Context, userID uuid.UUID) (*time.Location, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+	return user.Location(), nil
+}
+
+func (s *GoalService) publish(goal *model.Goal) {
+	e := events.Event{Type: events.GoalChanged, UserID: goal.UserID, EntityID: goal.ID}
+	if goal.ProjectID != nil {
+		e.ProjectID = *goal.ProjectID
+	}
+	s.publisher.Publish(e)
+}
+
+func goalActive(goal *model.Goal, at time.Time) bool {
+	if at.Before(goal.StartDate) {
+		return false
+	}
+	return goal.EndDate == nil || !at.After(*goal.EndDate)
+}
+
+func periodResult(goal *model.Goal, logs []model.Log, start, end, now time.Time) GoalPeriodResult {
+	result := GoalPeriodResult{
+		Start:       start,
+		End:         end,
+		TargetHours: goal.TargetHours,
+		InProgress:  now.Before(end),
+	}
+
+	for _, l := range logs {
+		if l.LoggedAt.Before(start) || !l.LoggedAt.Before(end) {
+			continue
+		}
+		if goal.ProjectID != nil && l.ProjectID != *goal.ProjectID {
+			continue
+		}
+		result.LoggedHours += float64(l.DurationMinutes) / 60.0
+	}
+
+	result.Hit = result.LoggedHours >= goal.TargetHours
+	return result
+}
+
+func periodStart(t time.Time, period model.GoalPeriod, loc *time.Location) time.Time {
+	switch period {
+	case model.GoalWeekly:
+		return startOfWeek(t, loc)
+	case model.GoalMonthly:
+		day := startOfDay(t, loc)
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return startOfDay(t, loc)
+	}
+}
+
+func periodEnd(start time.Time, period model.GoalPeriod) time.Time {
+	switch period {
+	case model.GoalWeekly:
+		return start.AddDate(0, 0, 7)
+	case model.GoalMonthly:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func TestPeriodBounds(t *testing.T) {
+	at := time.Date(2025, 1, 30, 15, 0, 0, 0, time.UTC) // Kamis
+
+	tests := []struct {
+		period    model.GoalPeriod
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{model.GoalDaily, time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{model.GoalWeekly, time.Date(2025, 1, 27, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)},
+		{model.GoalMonthly, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		start := periodStart(at, tt.period, time.UTC)
+		end := periodEnd(start, tt.period)
+		if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+			t.Errorf("%s: period = [%v, %v), want [%v, %v)", tt.period, start, end, tt.wantStart, tt.wantEnd)
+		}
+	}
+}
+
+func TestPeriodResult(t *testing.T) {
+	projectA, projectB := uuid.New(), uuid.New()
+	start := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	logs := []model.Log{
+		{ProjectID: projectA, DurationMinutes: 120, LoggedAt: start},
+		{ProjectID: projectB, DurationMinutes: 90, LoggedAt: start.AddDate(0, 0, 3)},
+		{ProjectID: projectA, DurationMinutes: 60, LoggedAt: start.Add(-time.Minute)}, // sebelum periode
+		{ProjectID: projectA, DurationMinutes: 60, LoggedAt: end},                     // akhir periode eksklusif
+	}
+
+	tests := []struct {
+		name       string
+		goal       model.Goal
+		now        time.Time
+		wantHours  float64
+		wantHit    bool
+		inProgress bool
+	}{
+		{"all projects reach target", model.Goal{TargetHours: 3.5}, end, 3.5, true, false},
+		{"project goal counts only its logs", model.Goal{ProjectID: &projectA, TargetHours: 3}, end, 2, false, false},
+		{"running period is in progress", model.Goal{TargetHours: 10}, start.AddDate(0, 0, 2), 3.5, false, true},
+	}
+
+	for _, tt := range tests {
+		got := periodResult(&tt.goal, logs, start, end, tt.now)
+		if got.LoggedHours != tt.wantHours || got.Hit != tt.wantHit || got.InProgress != tt.inProgress {
+			t.Errorf("%s: periodResult() = %+v, want hours %v hit %v in progress %v", tt.name, got, tt.wantHours, tt.wantHit, tt.inProgress)
+		}
+	}
+}
+
+type goalLogRepo struct {
+	repository.LogRepository
+	logs []model.Log
+}
+
+func (r goalLogRepo) FindByUserBetween(_ context.Context, _ uuid.UUID, from, to time.Time) ([]model.Log, error) {
+	var res []model.Log
+	for _, l := range r.logs {
+		if !l.LoggedAt.Before(from) && l.LoggedAt.Before(to) {
+			res = append(res, l)
+		}
+	}
+	return res, nil
+}
+
+func TestGoalHistory(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 9, 0, 0, 0, time.UTC) }
+	goal := &model.Goal{UserID: uuid.New(), Period: model.GoalDaily, TargetHours: 1, StartDate: day(10)}
+	s := &GoalService{logRepo: goalLogRepo{logs: []model.Log{
+		{DurationMinutes: 60, LoggedAt: day(10)},
+		{DurationMinutes: 30, LoggedAt: day(11)},
+		{DurationMinutes: 90, LoggedAt: day(12)},
+		{DurationMinutes: 15, LoggedAt: day(13)},
+	}}}
+
+	got, err := s.history(context.Background(), goal, time.UTC, day(13))
+	if err != nil {
+		t.Fatalf("history() err = %v", err)
+	}
+
+	wantHit := []bool{true, false, true, false}
+	if len(got.Periods) != len(wantHit) {
+		t.Fatalf("history() has %d periods, want %d", len(got.Periods), len(wantHit))
+	}
+	for i, p := range got.Periods {
+		if p.Hit != wantHit[i] {
+			t.Errorf("period %d hit = %v, want %v", i, p.Hit, wantHit[i])
+		}
+	}
+	if !got.Periods[3].InProgress {
+		t.Errorf("current period should be in progress")
+	}
+	// periode berjalan belum dihitung sebagai miss
+	if got.Hits != 2 || got.Misses != 1 {
+		t.Errorf("history() hits/misses = %d/%d, want 2/1", got.Hits, got.Misses)
+	}
+}
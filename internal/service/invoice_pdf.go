@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"devtracker/internal/domain/model"
+	"devtracker/pkg/pdf"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	pdfMargin     = 50.0
+	pdfLineHeight = 14.0
+	pdfBottom     = pdf.PageHeight - 80
+)
+
+// RenderPDF menghasilkan dokumen PDF invoice. Penerbit invoice adalah user pemilik project.
+func (s *InvoiceService) RenderPDF(ctx context.Context, userID, id uuid.UUID) (*model.Invoice, []byte, error) {
+	inv, err := s.GetInvoice(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issuer, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issuer == nil {
+		return nil, nil, util.ErrNotFound("user not found")
+	}
+
+	client, err := s.clientRepo.FindByID(ctx, inv.ClientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inv, renderInvoice(inv, issuer, client), nil
+}
+
+func renderInvoice(inv *model.Invoice, issuer *model.User, client *model.Client) []byte {
+	doc := pdf.New()
+	page := doc.AddPage()
+	right := pdf.PageWidth - pdfMargin
+
+	page.Text(pdfMargin, 70, pdf.Bold, 22, pdf.Left, "INVOICE")
+	page.Text(right, 62, pdf.Bold, 11, pdf.Right, inv.Number)
+	page.Text(right, 76, pdf.Regular, 9, pdf.Right, "Status: "+strings.ToUpper(string(inv.Status)))
+
+	y := 110.0
+	page.Text(pdfMargin, y, pdf.Bold, 9, pdf.Left, "Dari")
+	page.Text(300, y, pdf.Bold, 9, pdf.Left, "Kepada")
+	y += pdfLineHeight
+	page.Text(pdfMargin, y, pdf.Regular, 10, pdf.Left, issuer.Name)
+	page.Text(300, y, pdf.Regular, 10, pdf.Left, inv.ClientName)
+	y += pdfLineHeight
+	page.Text(pdfMargin, y, pdf.Regular, 10, pdf.Left, issuer.Email)
+
+	cy := y
+	if client != nil {
+		if client.Email != "" {
+			page.Text(300, cy, pdf.Regular, 10, pdf.Left, client.Email)
+			cy += pdfLineHeight
+		}
+		for _, l := range pdf.Wrap(pdf.Regular, 10, right-300, client.Address) {
+			if l == "" {
+				continue
+			}
+			page.Text(300, cy, pdf.Regular, 10, pdf.Left, l)
+			cy += pdfLineHeight
+		}
+	}
+	y = max(y+pdfLineHeight, cy) + 10
+
+	details := [][2]string{
+		{"Project", inv.ProjectName},
+		{"Periode", inv.PeriodStart.Format(dateLayout) + " s/d " + inv.PeriodEnd.Format(dateLayout)},
+		{"Tanggal invoice", inv.CreatedAt.Format(dateLayout)},
+	}
+	if inv.DueDate != nil {
+		details = append(details, [2]string{"Jatuh tempo", inv.DueDate.Format(dateLayout)})
+	}
+	if inv.RoundingMinutes > 1 {
+		details = append(details, [2]string{"Pembulatan", fmt.Sprintf("%d menit (%s) per log", inv.RoundingMinutes, inv.RoundingMode)})
+	}
+	for _, d := range details {
+		page.Text(pdfMargin, y, pdf.Bold, 9, pdf.Left, d[0])
+		page.Text(150, y, pdf.Regular, 9, pdf.Left, d[1])
+		y += pdfLineHeight
+	}
+	y += 16
+
+	// kolom tabel: deskripsi | log | jam | rate | jumlah
+	header := func(p *pdf.Page, y float64) float64 {
+		p.Text(pdfMargin, y, pdf.Bold, 9, pdf.Left, "Deskripsi")
+		p.Text(330, y, pdf.Bold, 9, pdf.Right, "Log")
+		p.Text(390, y, pdf.Bold, 9, pdf.Right, "Jam")
+		p.Text(470, y, pdf.Bold, 9, pdf.Right, "Rate/jam")
+		p.Text(right, y, pdf.Bold, 9, pdf.Right, "Jumlah")
+		p.Line(pdfMargin, y+5, right, y+5, 0.8)
+		return y + pdfLineHeight + 4
+	}
+	y = header(page, y)
+
+	for _, l := range inv.Lines {
+		desc := pdf.Wrap(pdf.Regular, 9, 250, l.Description)
+		if y+float64(len(desc))*pdfLineHeight > pdfBottom {
+			page = doc.AddPage()
+			y = header(page, 60)
+		}
+
+		page.Text(330, y, pdf.Regular, 9, pdf.Right, fmt.Sprint(l.LogCount))
+		page.Text(390, y, pdf.Regular, 9, pdf.Right, formatHours(l.BilledMinutes))
+		page.Text(470, y, pdf.Regular, 9, pdf.Right, formatMoney(inv.Currency, l.RateCents))
+		page.Text(right, y, pdf.Regular, 9, pdf.Right, formatMoney(inv.Currency, l.AmountCents))
+		for _, d := range desc {
+			page.Text(pdfMargin, y, pdf.Regular, 9, pdf.Left, d)
+			y += pdfLineHeight
+		}
+		y += 2
+	}
+
+	if y+4*pdfLineHeight > pdfBottom {
+		page = doc.AddPage()
+		y = 60
+	}
+	page.Line(330, y, right, y, 0.5)
+	y += pdfLineHeight
+
+	totals := [][2]string{
+		{"Subtotal", formatMoney(inv.Currency, inv.SubtotalCents)},
+		{fmt.Sprintf("Pajak (%s%%)", trimFloat(inv.TaxRate)), formatMoney(inv.Currency, inv.TaxCents)},
+	}
+	for _, t := range totals {
+		page.Text(470, y, pdf.Regular, 9, pdf.Right, t[0])
+		page.Text(right, y, pdf.Regular, 9, pdf.Right, t[1])
+		y += pdfLineHeight
+	}
+	page.Text(470, y+2, pdf.Bold, 11, pdf.Right, "Total")
+	page.Text(right, y+2, pdf.Bold, 11, pdf.Right, formatMoney(inv.Currency, inv.TotalCents))
+	y += 2 * pdfLineHeight
+
+	if inv.Notes != "" {
+		y += 10
+		page.Text(pdfMargin, y, pdf.Bold, 9, pdf.Left, "Catatan")
+		y += pdfLineHeight
+		for _, l := range pdf.Wrap(pdf.Regular, 9, right-pdfMargin, inv.Notes) {
+			if y > pdfBottom {
+				page = doc.AddPage()
+				y = 60
+			}
+			page.Text(pdfMargin, y, pdf.Regular, 9, pdf.Left, l)
+			y += pdfLineHeight
+		}
+	}
+
+	return doc.Bytes()
+}
+
+// formatMoney memformat nilai cents, mis. "IDR 1,250,000.00".
+func formatMoney(currency string, cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	whole := fmt.Sprint(cents / 100)
+	var b strings.Builder
+	for i, c := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	return fmt.Sprintf("%s %s%s.%02d", currency, sign, b.String(), cents%100)
+}
+
+func formatHours(minutes int) string {
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+func trimFloat(f float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
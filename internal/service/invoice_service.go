@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const unassignedLineDescription = "Pekerjaan umum (tanpa milestone)"
+
+type InvoiceService struct {
+	repo          repository.InvoiceRepository
+	clientRepo    repository.ClientRepository
+	rateRepo      repository.RateRepository
+	logRepo       repository.LogRepository
+	projectRepo   repository.ProjectRepository
+	milestoneRepo repository.MilestoneRepository
+	userRepo      repository.UserRepository
+	uow           repository.UnitOfWork
+}
+
+func NewInvoiceService(repo repository.InvoiceRepository, clientRepo repository.ClientRepository, rateRepo repository.RateRepository, logRepo repository.LogRepository, projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, userRepo repository.UserRepository, uow repository.UnitOfWork) *InvoiceService {
+	return &InvoiceService{
+		repo:          repo,
+		clientRepo:    clientRepo,
+		rateRepo:      rateRepo,
+		logRepo:       logRepo,
+		projectRepo:   projectRepo,
+		milestoneRepo: milestoneRepo,
+		userRepo:      userRepo,
+		uow:           uow,
+	}
+}
+
+// InvoiceRequest: periode berupa tanggal kalender di timezone user (inklusif).
+// RoundingMinutes 0 berarti tanpa pembulatan; pembulatan diterapkan per log.
+type InvoiceRequest struct {
+	ProjectID       uuid.UUID
+	PeriodStart     time.Time
+	PeriodEnd       time.Time
+	RoundingMinutes int
+	RoundingMode    model.RoundingMode
+	TaxRate         float64
+	DueDate         *time.Time
+	Notes           string
+}
+
+// GenerateInvoice membuat invoice draft dari log billable yang belum ditagih dalam periode,
+// dikelompokkan per milestone dan rate, lalu mengunci log tersebut.
+func (s *InvoiceService) GenerateInvoice(ctx context.Context, userID uuid.UUID, req InvoiceRequest) (*model.Invoice, error) {
+	if err := validateInvoiceRequest(&req); err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil || project.UserID != userID {
+		return nil, util.ErrNotFound("project not found")
+	}
+	if project.ClientID == nil {
+		return nil, util.ErrBadRequest("project is not linked to a client")
+	}
+
+	client, err := s.clientRepo.FindByID(ctx, *project.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, util.ErrBadRequest("project is not linked to a client")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+	loc := user.Location()
+
+	from := time.Date(req.PeriodStart.Year(), req.PeriodStart.Month(), req.PeriodStart.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(req.PeriodEnd.Year(), req.PeriodEnd.Month(), req.PeriodEnd.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+
+	// log dibaca dan dikunci di transaksi yang sama dengan LockToInvoice, supaya edit log
+	// yang berjalan bersamaan tidak membuat total invoice berbeda dari isi log
+	var inv *model.Invoice
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		logs, err := s.logRepo.FindUninvoiced(ctx, project.ID, from, to)
+		if err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return util.ErrBadRequest("no uninvoiced billable logs in this period")
+		}
+
+		rates, err := s.rateRepo.FindForProject(ctx, userID, project.ID)
+		if err != nil {
+			return err
+		}
+
+		milestones, err := s.milestoneRepo.FindByProject(ctx, project.ID)
+		if err != nil {
+			return err
+		}
+
+		lines, err := buildInvoiceLines(logs, rates, milestones, project.ID, loc, req.RoundingMinutes, req.RoundingMode)
+		if err != nil {
+			return err
+		}
+
+		inv = &model.Invoice{
+			ID:              uuid.New(),
+			UserID:          userID,
+			ClientID:        client.ID,
+			ClientName:      client.Name,
+			ProjectID:       project.ID,
+			ProjectName:     project.Name,
+			PeriodStart:     calendarDate(req.PeriodStart),
+			PeriodEnd:       calendarDate(req.PeriodEnd),
+			Status:          model.InvoiceDraft,
+			Currency:        client.Currency,
+			RoundingMinutes: req.RoundingMinutes,
+			RoundingMode:    req.RoundingMode,
+			TaxRate:         req.TaxRate,
+			Notes:           req.Notes,
+			DueDate:         req.DueDate,
+			Lines:           lines,
+		}
+		for _, l := range lines {
+			inv.SubtotalCents += l.AmountCents
+		}
+		inv.TaxCents = int64(math.Round(float64(inv.SubtotalCents) * req.TaxRate / 100))
+		inv.TotalCents = inv.SubtotalCents + inv.TaxCents
+
+		ids := make([]uuid.UUID, len(logs))
+		for i := range logs {
+			ids[i] = logs[i].ID
+		}
+
+		number, err := s.nextNumber(ctx, userID, time.Now().In(loc).Year())
+		if err != nil {
+			return err
+		}
+		inv.Number = number
+
+		if err := s.repo.Create(ctx, inv); err != nil {
+			return err
+		}
+
+		locked, err := s.logRepo.LockToInvoice(ctx, ids, inv.ID)
+		if err != nil {
+			return err
+		}
+		if locked != int64(len(ids)) {
+			return util.ErrConflict("some logs were invoiced or changed concurrently, please retry")
+		}
+		return nil
+	})
+	if err != nil {
+		if util.IsUniqueViolation(err) {
+			return nil, util.ErrConflict("invoice number already taken, please retry")
+		}
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+func (s *InvoiceService) ListInvoices(ctx context.Context, userID uuid.UUID, status model.InvoiceStatus) ([]model.Invoice, error) {
+	switch status {
+	case "", model.InvoiceDraft, model.InvoiceSent, model.InvoicePaid:
+	default:
+		return nil, util.ErrBadRequest("status must be draft, sent or paid")
+	}
+	return s.repo.FindByUser(ctx, userID, status)
+}
+
+func (s *InvoiceService) GetInvoice(ctx context.Context, userID, id uuid.UUID) (*model.Invoice, error) {
+	inv, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil || inv.UserID != userID {
+		return nil, util.ErrNotFound("invoice not found")
+	}
+	return inv, nil
+}
+
+// SendInvoice: draft -> sent. Setelah terkirim invoice tidak bisa dihapus.
+func (s *InvoiceService) SendInvoice(ctx context.Context, userID, id uuid.UUID) (*model.Invoice, error) {
+	return s.transition(ctx, userID, id, model.InvoiceDraft, model.InvoiceSent)
+}
+
+// MarkPaid: sent -> paid.
+func (s *InvoiceService) MarkPaid(ctx context.Context, userID, id uuid.UUID) (*model.Invoice, error) {
+	return s.transition(ctx, userID, id, model.InvoiceSent, model.InvoicePaid)
+}
+
+func (s *InvoiceService) transition(ctx context.Context, userID, id uuid.UUID, from, to model.InvoiceStatus) (*model.Invoice, error) {
+	inv, err := s.GetInvoice(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv.Status != from {
+		return nil, util.ErrConflict(fmt.Sprintf("invoice is %s, expected %s", inv.Status, from))
+	}
+
+	now := time.Now()
+	inv.Status = to
+	switch to {
+	case model.InvoiceSent:
+		inv.SentAt = &now
+	case model.InvoicePaid:
+		inv.PaidAt = &now
+	}
+
+	if err := s.repo.Update(ctx, inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// DeleteInvoice hanya untuk draft; log yang terkunci dilepas supaya bisa diedit/ditagih ulang.
+func (s *InvoiceService) DeleteInvoice(ctx context.Context, userID, id uuid.UUID) error {
+	inv, err := s.GetInvoice(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if inv.Status != model.InvoiceDraft {
+		return util.ErrConflict("only draft invoices can be deleted")
+	}
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.logRepo.ReleaseInvoice(ctx, id); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, id)
+	})
+}
+
+// nextNumber menghasilkan nomor berurutan per user per tahun, mis. INV-2025-0007.
+func (s *InvoiceService) nextNumber(ctx context.Context, userID uuid.UUID, year int) (string, error) {
+	prefix := fmt.Sprintf("INV-%d-", year)
+	latest, err := s.repo.LatestNumber(ctx, userID, prefix)
+	if err != nil {
+		return "", err
+	}
+
+	seq := 1
+	if latest != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(latest, prefix))
+		if err != nil {
+			return "", err
+		}
+		seq = n + 1
+	}
+	return fmt.Sprintf("%s%04d", prefix, seq), nil
+}
+
+func validateInvoiceRequest(req *InvoiceRequest) error {
+	if req.ProjectID == uuid.Nil {
+		return util.ErrBadRequest("project ID is required")
+	}
+	if req.PeriodStart.IsZero() || req.PeriodEnd.IsZero() {
+		return util.ErrBadRequest("period_start and period_end are required")
+	}
+	if req.PeriodEnd.Before(req.PeriodStart) {
+		return util.ErrBadRequest("period_end must not be before period_start")
+	}
+
+	switch req.RoundingMinutes {
+	case 0, 1, 5, 6, 10, 15, 30, 60:
+	default:
+		return util.ErrBadRequest("rounding_minutes must be one of 0, 1, 5, 6, 10, 15, 30, 60")
+	}
+	if req.RoundingMode == "" {
+		req.RoundingMode = model.RoundUp
+	}
+	switch req.RoundingMode {
+	case model.RoundUp, model.RoundNearest, model.RoundDown:
+	default:
+		return util.ErrBadRequest("rounding_mode must be up, nearest or down")
+	}
+
+	if req.TaxRate < 0 || req.TaxRate > 100 {
+		return util.ErrBadRequest("tax_rate must be between 0 and 100")
+	}
+	return nil
+}
+
+type invoiceLineKey struct {
+	milestoneID uuid.UUID // uuid.Nil = tanpa milestone
+	rateCents   int64
+}
+
+// buildInvoiceLines mengelompokkan log per (milestone, rate). Urutan baris mengikuti
+// urutan milestone di board, log tanpa milestone di akhir.
+func buildInvoiceLines(logs []model.Log, rates []model.HourlyRate, milestones []model.Milestone, projectID uuid.UUID, loc *time.Location, increment int, mode model.RoundingMode) ([]model.InvoiceLine, error) {
+	order := make(map[uuid.UUID]int, len(milestones))
+	names := make(map[uuid.UUID]string, len(milestones))
+	for _, m := range milestones {
+		order[m.ID] = m.OrderIdx
+		names[m.ID] = m.Name
+	}
+
+	lines := make(map[invoiceLineKey]*model.InvoiceLine)
+	for _, l := range logs {
+		day := calendarDate(l.LoggedAt.In(loc))
+		rate := resolveRate(rates, projectID, l.UserID, day)
+		if rate == nil {
+			return nil, util.ErrBadRequest("no hourly rate applies to a log on " + day.Format(dateLayout))
+		}
+
+		key := invoiceLineKey{rateCents: rate.RateCents}
+		if l.MilestoneID != nil {
+			key.milestoneID = *l.MilestoneID
+		}
+
+		line, ok := lines[key]
+		if !ok {
+			line = &model.InvoiceLine{ID: uuid.New(), RateCents: rate.RateCents, Description: unassignedLineDescription}
+			if l.MilestoneID != nil {
+				line.MilestoneID = l.MilestoneID
+				line.Description = names[*l.MilestoneID]
+				if line.Description == "" {
+					line.Description = "Milestone (dihapus)"
+				}
+			}
+			lines[key] = line
+		}
+
+		line.LogCount++
+		line.Minutes += l.DurationMinutes
+		line.BilledMinutes += roundMinutes(l.DurationMinutes, increment, mode)
+	}
+
+	keys := make([]invoiceLineKey, 0, len(lines))
+	for k := range lines {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if (a.milestoneID == uuid.Nil) != (b.milestoneID == uuid.Nil) {
+			return b.milestoneID == uuid.Nil
+		}
+		if order[a.milestoneID] != order[b.milestoneID] {
+			return order[a.milestoneID] < order[b.milestoneID]
+		}
+		if a.milestoneID != b.milestoneID {
+			return a.milestoneID.String() < b.milestoneID.String()
+		}
+		return a.rateCents > b.rateCents
+	})
+
+	res := make([]model.InvoiceLine, 0, len(keys))
+	for i, k := range keys {
+		line := lines[k]
+		line.Position = i + 1
+		// half-up ke satuan terkecil mata uang
+		line.AmountCents = (int64(line.BilledMinutes)*line.RateCents + 30) / 60
+		res = append(res, *line)
+	}
+	return res, nil
+}
+
+// roundMinutes membulatkan durasi ke kelipatan increment menit sesuai mode.
+func roundMinutes(minutes, increment int, mode model.RoundingMode) int {
+	if increment <= 1 {
+		return minutes
+	}
+	switch mode {
+	case model.RoundDown:
+		return minutes / increment * increment
+	case model.RoundNearest:
+		return (minutes + increment/2) / increment * increment
+	}
+	return (minutes + increment - 1) / increment * increment
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func TestRoundMinutes(t *testing.T) {
+	tests := []struct {
+		minutes   int
+		increment int
+		mode      model.RoundingMode
+		want      int
+	}{
+		{50, 1, model.RoundUp, 50},
+		{50, 0, model.RoundDown, 50},
+		{50, 15, model.RoundUp, 60},
+		{45, 15, model.RoundUp, 45},
+		{1, 15, model.RoundUp, 15},
+		{0, 15, model.RoundUp, 0},
+		{52, 15, model.RoundNearest, 45},
+		{53, 15, model.RoundNearest, 60},
+		{59, 15, model.RoundDown, 45},
+		{10, 15, model.RoundDown, 0},
+		{50, 15, model.RoundingMode(""), 60},
+	}
+
+	for _, tt := range tests {
+		if got := roundMinutes(tt.minutes, tt.increment, tt.mode); got != tt.want {
+			t.Errorf("roundMinutes(%d, %d, %q) = %d, want %d", tt.minutes, tt.increment, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestBuildInvoiceLines(t *testing.T) {
+	projectID, userID := uuid.New(), uuid.New()
+	design := model.Milestone{ID: uuid.New(), Name: "Desain", OrderIdx: 1000}
+	backend := model.Milestone{ID: uuid.New(), Name: "Backend", OrderIdx: 2000}
+	deleted := uuid.New()
+	day := func(month time.Month, d int) time.Time { return time.Date(2024, month, d, 10, 0, 0, 0, time.UTC) }
+
+	rates := []model.HourlyRate{
+		{RateCents: 6000, EffectiveFrom: day(time.January, 1)},
+		{ProjectID: &projectID, RateCents: 9000, EffectiveFrom: day(time.February, 1)},
+	}
+	log := func(milestoneID *uuid.UUID, at time.Time, minutes int) model.Log {
+		return model.Log{ID: uuid.New(), ProjectID: projectID, UserID: userID, MilestoneID: milestoneID, LoggedAt: at, DurationMinutes: minutes}
+	}
+	logs := []model.Log{
+		log(&design.ID, day(time.January, 15), 50),
+		log(&design.ID, day(time.January, 16), 5),
+		log(&design.ID, day(time.February, 2), 20),
+		log(&backend.ID, day(time.January, 20), 45),
+		log(nil, day(time.January, 10), 10),
+		log(&deleted, day(time.January, 11), 30),
+	}
+
+	got, err := buildInvoiceLines(logs, rates, []model.Milestone{design, backend}, projectID, time.UTC, 15, model.RoundUp)
+	if err != nil {
+		t.Fatalf("buildInvoiceLines: %v", err)
+	}
+
+	type line struct {
+		description   string
+		rateCents     int64
+		logCount      int
+		minutes       int
+		billedMinutes int
+		amountCents   int64
+	}
+	want := []line{
+		{"Milestone (dihapus)", 6000, 1, 30, 30, 3000},
+		{"Desain", 9000, 1, 20, 30, 4500},
+		{"Desain", 6000, 2, 55, 75, 7500},
+		{"Backend", 6000, 1, 45, 45, 4500},
+		{unassignedLineDescription, 6000, 1, 10, 15, 1500},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		gl := line{g.Description, g.RateCents, g.LogCount, g.Minutes, g.BilledMinutes, g.AmountCents}
+		if gl != w || g.Position != i+1 {
+			t.Errorf("line %d = %+v (position %d), want %+v", i+1, gl, g.Position, w)
+		}
+	}
+
+	early := []model.Log{log(nil, time.Date(2023, time.December, 31, 10, 0, 0, 0, time.UTC), 30)}
+	if _, err := buildInvoiceLines(early, rates, nil, projectID, time.UTC, 15, model.RoundUp); err == nil {
+		t.Error("expected error for log without applicable rate")
+	}
+}
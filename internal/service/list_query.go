@@ -0,0 +1,19 @@
+package service
+
+import (
+	"errors"
+
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+)
+
+// listError menerjemahkan error ListQuery dari repository menjadi 400.
+func listError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrInvalidCursor):
+		return util.ErrBadRequest("invalid cursor")
+	case errors.Is(err, repository.ErrInvalidSort):
+		return util.ErrBadRequest("unsupported sort field")
+	}
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
 	"devtracker/internal/repository"
 	"devtracker/pkg/util"
 
@@ -14,17 +15,51 @@ import (
 
 type LogService struct {
 	repo repository.LogRepository
+	taskRepo repository.TaskRepository
+	milestoneRepo repository.MilestoneRepository
+	tagRepo repository.TagRepository
+	access *AccessChecker
+	guard projectGuard
+	weekGuard timesheetGuard
+	uow repository.UnitOfWork
+	publisher events.Publisher
 }
 
-func NewLogService(repo repository.LogRepository) *LogService {
+func NewLogService(repo repository.LogRepository, taskRepo repository.TaskRepository, milestoneRepo repository.MilestoneRepository, projectRepo repository.ProjectRepository, tagRepo repository.TagRepository, timesheetRepo repository.TimesheetRepository, userRepo repository.UserRepository, access *AccessChecker, uow repository.UnitOfWork, publisher events.Publisher) *LogService {
 	return &LogService{
 		repo: repo,
+		taskRepo: taskRepo,
+		milestoneRepo: milestoneRepo,
+		tagRepo: tagRepo,
+		access: access,
+		guard: projectGuard{projectRepo},
+		weekGuard: timesheetGuard{projectRepo, timesheetRepo, userRepo},
+		uow: uow,
+		publisher: publisher,
 	}
 }
 
 
-func (s *LogService) CreateLog(ctx context.Context, projectId, userID uuid.UUID, milestoneID *uuid.UUID,  Description string, durationMinutes int, loggedAt time.Time) (*model.Log, error){
+// CreateLog menyimpan log beserta tag-nya. Tag = tags eksplisit + #hashtag di deskripsi;
+// category kosong berarti "other".
+func (s *LogService) CreateLog(ctx context.Context, projectId, userID uuid.UUID, milestoneID, taskID *uuid.UUID,  Description string, durationMinutes int, loggedAt time.Time, category model.LogCategory, tags []string, billable bool) (*model.Log, error){
+	var log *model.Log
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		log, err = s.createLog(ctx, projectId, userID, milestoneID, taskID, Description, durationMinutes, loggedAt, category, tags, billable)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(events.LogCreated, log)
+	return log, nil
+}
 
+// createLog memvalidasi dan menyimpan log tanpa publish event, supaya bisa dipakai
+// di dalam UnitOfWork (event baru dikirim setelah transaksi commit).
+func (s *LogService) createLog(ctx context.Context, projectId, userID uuid.UUID, milestoneID, taskID *uuid.UUID,  Description string, durationMinutes int, loggedAt time.Time, category model.LogCategory, tags []string, billable bool) (*model.Log, error){
 
 	if projectId == uuid.Nil {
 		return nil, util.ErrBadRequest("project ID is required")
@@ -41,14 +76,45 @@ func (s *LogService) CreateLog(ctx context.Context, projectId, userID uuid.UUID,
 		return nil, util.ErrBadRequest("duration must be greater than zero")
 	}
 
+	if category == "" {
+		category = model.CategoryOther
+	}
+	if !category.Valid() {
+		return nil, errInvalidCategory
+	}
+
+	tagNames, err := logTagNames(tags, Description)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.access.CanEdit(ctx, userID, projectId); err != nil {
+		return nil, err
+	}
+	if err := s.guard.ensureWritable(ctx, projectId); err != nil {
+		return nil, err
+	}
+
+	if err := s.weekGuard.ensureOpen(ctx, projectId, userID, loggedAt); err != nil {
+		return nil, err
+	}
+
+	milestoneID, err = s.resolveTaskMilestone(ctx, projectId, milestoneID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	log := &model.Log{
 		ID:              uuid.New(),
 		ProjectID:       projectId,
 		MilestoneID: milestoneID,
+		TaskID:      taskID,
 		UserID:          userID,
 		Description:            Description,
 		DurationMinutes: durationMinutes,
 		LoggedAt:        loggedAt,
+		Category:        category,
+		Billable:        billable,
 		CreatedAt:       time.Now(),
 	}
 
@@ -56,11 +122,15 @@ func (s *LogService) CreateLog(ctx context.Context, projectId, userID uuid.UUID,
 		return nil, err
 	}
 
+	if err := s.saveTags(ctx, log, tagNames); err != nil {
+		return nil, err
+	}
+
 	return log, nil
 }
 
 
-func (s *LogService) GetLogByID(ctx context.Context, id uuid.UUID) (*model.Log, error) {
+func (s *LogService) GetLogByID(ctx context.Context, userID, id uuid.UUID) (*model.Log, error) {
 	if id == uuid.Nil {
 		return nil, util.ErrBadRequest("log ID is required")
 	}
@@ -69,44 +139,61 @@ func (s *LogService) GetLogByID(ctx context.Context, id uuid.UUID) (*model.Log,
 	if err != nil {
 		return nil, err
 	}
+	if log == nil {
+		return nil, util.ErrNotFound("log not found")
+	}
+	if _, _, err := s.access.CanView(ctx, userID, log.ProjectID); err != nil {
+		return nil, err
+	}
+
+	names, err := s.tagRepo.FindNamesByLogs(ctx, []uuid.UUID{log.ID})
+	if err != nil {
+		return nil, err
+	}
+	log.Tags = names[log.ID]
 	return log, nil
 }
 
-func (s *LogService) GetLogsByUser(ctx context.Context, userID uuid.UUID) ([]model.Log, error) {
+func (s *LogService) GetLogsByUser(ctx context.Context, userID uuid.UUID, q repository.ListQuery) (*repository.Page[model.Log], error) {
 	if userID == uuid.Nil {
 		return nil, util.ErrBadRequest("user ID is required")
 	}
 
-	logs, err := s.repo.FindByUser(ctx, userID)
+	q.UserID = &userID
+	page, err := s.repo.List(ctx, q)
 	if err != nil {
+		return nil, listError(err)
+	}
+	if err := s.attachTags(ctx, page.Items); err != nil {
 		return nil, err
 	}
-	return logs, nil
+	return page, nil
 }
 
-func (s *LogService) GetLogsByProject(ctx context.Context, projectID uuid.UUID) ([]model.Log, error) {
+func (s *LogService) GetLogsByProject(ctx context.Context, userID, projectID uuid.UUID, q repository.ListQuery) (*repository.Page[model.Log], error) {
 
 	if projectID == uuid.Nil {
 		return nil, util.ErrBadRequest("project ID is required")
 	}
-
-	logs, err := s.repo.FindByProject(ctx, projectID)
-	if err != nil {
+	if _, _, err := s.access.CanView(ctx, userID, projectID); err != nil {
 		return nil, err
 	}
-	return logs, nil
-}
 
-func (s *LogService) UpdateLog(ctx context.Context, userId uuid.UUID, log *model.Log) error {
-	orig, err := s.repo.FindByID(ctx, log.ID)
+	q.ProjectID = &projectID
+	page, err := s.repo.List(ctx, q)
 	if err != nil {
-		return err
+		return nil, listError(err)
 	}
-
-	if orig.UserID != userId{
-		return util.ErrUnauthorized("you do not have permission to update this log")
+	if err := s.attachTags(ctx, page.Items); err != nil {
+		return nil, err
 	}
+	return page, nil
+}
 
+// UpdateLog menyimpan perubahan log. log.Tags menggantikan tag yang ada dan selalu
+// digabung dengan #hashtag dari deskripsi baru. Log dibaca ulang dengan FOR UPDATE di dalam
+// transaksi supaya tidak bisa lolos bersamaan dengan log yang sedang ditagihkan ke invoice.
+func (s *LogService) UpdateLog(ctx context.Context, userId uuid.UUID, log *model.Log) error {
 	if log.ID == uuid.Nil {
 		return util.ErrBadRequest("log ID is required")
 	}
@@ -119,21 +206,192 @@ func (s *LogService) UpdateLog(ctx context.Context, userId uuid.UUID, log *model
 		return util.ErrBadRequest("duration must be greater than zero")
 	}
 
-	orig.Description = log.Description;
-	orig.DurationMinutes = log.DurationMinutes;
-	orig.LoggedAt = log.LoggedAt;
-	orig.MilestoneID = log.MilestoneID;
+	if log.Category != "" && !log.Category.Valid() {
+		return errInvalidCategory
+	}
 
+	tagNames, err := logTagNames(log.Tags, log.Description)
+	if err != nil {
+		return err
+	}
 
-	return s.repo.Update(ctx, orig)
+	var orig *model.Log
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		orig, err = s.lockOwnLog(ctx, userId, log.ID, "you do not have permission to update this log")
+		if err != nil {
+			return err
+		}
+
+		// minggu asal dan minggu tujuan (jika LoggedAt dipindah) harus belum diajukan
+		if err := s.weekGuard.ensureOpen(ctx, orig.ProjectID, orig.UserID, orig.LoggedAt); err != nil {
+			return err
+		}
+		if err := s.weekGuard.ensureOpen(ctx, orig.ProjectID, orig.UserID, log.LoggedAt); err != nil {
+			return err
+		}
+
+		milestoneID, err := s.resolveTaskMilestone(ctx, orig.ProjectID, log.MilestoneID, log.TaskID)
+		if err != nil {
+			return err
+		}
+
+		if log.Category != "" {
+			orig.Category = log.Category
+		}
+		orig.Description = log.Description
+		orig.DurationMinutes = log.DurationMinutes
+		orig.LoggedAt = log.LoggedAt
+		orig.Billable = log.Billable
+		orig.MilestoneID = milestoneID
+		orig.TaskID = log.TaskID
+
+		if err := s.repo.Update(ctx, orig); err != nil {
+			return err
+		}
+		return s.saveTags(ctx, orig, tagNames)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.MilestoneID = orig.MilestoneID
+	log.Category = orig.Category
+	log.Tags = orig.Tags
+	log.UpdatedAt = orig.UpdatedAt
+	s.publish(events.LogUpdated, orig)
+	return nil
 }
 
-func (s *LogService) DeleteLog(ctx context.Context, id uuid.UUID) error {
+func (s *LogService) DeleteLog(ctx context.Context, userID, id uuid.UUID) error {
 	if id == uuid.Nil {
 		return util.ErrBadRequest("log ID is required")
 	}
 
-	return s.repo.Delete(ctx, id)
+	var log *model.Log
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		log, err = s.lockOwnLog(ctx, userID, id, "you do not have permission to delete this log")
+		if err != nil {
+			return err
+		}
+		if err := s.weekGuard.ensureOpen(ctx, log.ProjectID, log.UserID, log.LoggedAt); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publish(events.LogDeleted, log)
+	return nil
+}
+
+// lockOwnLog mengunci log milik userID sampai transaksi selesai dan menolak log yang
+// sudah masuk invoice atau berada di project yang tidak bisa diubah.
+func (s *LogService) lockOwnLog(ctx context.Context, userID, id uuid.UUID, denied string) (*model.Log, error) {
+	log, err := s.repo.FindByIDForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if log == nil {
+		return nil, util.ErrNotFound("log not found")
+	}
+	if log.UserID != userID {
+		return nil, util.ErrUnauthorized(denied)
+	}
+	if log.InvoiceID != nil {
+		return nil, errLogInvoiced
+	}
+	if err := s.guard.ensureWritable(ctx, log.ProjectID); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+func (s *LogService) publish(t events.Type, log *model.Log) {
+	s.publisher.Publish(events.Event{
+		Type:      t,
+		UserID:    log.UserID,
+		ProjectID: log.ProjectID,
+		EntityID:  log.ID,
+	})
+}
+
+// resolveTaskMilestone memastikan task yang ditautkan ke log berada di milestone yang sama.
+// Jika milestone tidak diisi, milestone diambil dari task.
+func (s *LogService) resolveTaskMilestone(ctx context.Context, projectID uuid.UUID, milestoneID, taskID *uuid.UUID) (*uuid.UUID, error) {
+	if taskID != nil {
+		task, err := s.taskRepo.FindByID(ctx, *taskID)
+		if err != nil {
+			return nil, err
+		}
+		if task == nil {
+			return nil, util.ErrBadRequest("task not found")
+		}
+
+		if milestoneID != nil && *milestoneID != task.MilestoneID {
+			return nil, util.ErrBadRequest("task does not belong to the given milestone")
+		}
+		milestoneID = &task.MilestoneID
+	}
+
+	if milestoneID == nil {
+		return nil, nil
+	}
+
+	// milestone (langsung atau lewat task) harus milik project yang sama dengan log
+	milestone, err := s.milestoneRepo.FindByID(ctx, *milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if milestone == nil {
+		return nil, util.ErrBadRequest("milestone not found")
+	}
+	if milestone.ProjectID != projectID {
+		return nil, util.ErrBadRequest("milestone does not belong to the log's project")
+	}
+
+	return milestoneID, nil
 }
 
+var errLogInvoiced = util.ErrConflict("log is locked by an invoice; delete the draft invoice to edit it")
 
+var errInvalidCategory = util.ErrBadRequest("category must be one of coding, meeting, research, review, learning, admin, other")
+
+// saveTags membuat tag yang belum ada lalu mengganti tautan tag milik log.
+func (s *LogService) saveTags(ctx context.Context, log *model.Log, names []string) error {
+	tags, err := s.tagRepo.FindOrCreate(ctx, log.UserID, names)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(tags))
+	for i := range tags {
+		ids[i] = tags[i].ID
+	}
+	if err := s.tagRepo.SetLogTags(ctx, log.ID, ids); err != nil {
+		return err
+	}
+
+	log.Tags = names
+	return nil
+}
+
+// attachTags mengisi Tags untuk setiap log dengan satu query.
+func (s *LogService) attachTags(ctx context.Context, logs []model.Log) error {
+	ids := make([]uuid.UUID, len(logs))
+	for i := range logs {
+		ids[i] = logs[i].ID
+	}
+
+	names, err := s.tagRepo.FindNamesByLogs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range logs {
+		logs[i].Tags = names[logs[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"devtracker/internal/domain/model"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// milestoneRankGap adalah jarak OrderIdx antar milestone, sehingga insert di tengah
+// cukup mengambil nilai di antara dua tetangga tanpa menulis ulang semua baris.
+const milestoneRankGap = 1000
+
+var boardColumns = []model.MilestoneStatus{
+	model.StatusPending,
+	model.StatusInProgress,
+	model.StatusDone,
+}
+
+type BoardColumn struct {
+	Status     model.MilestoneStatus
+	Limit      *int
+	Milestones []model.Milestone
+}
+
+func (c BoardColumn) OverLimit() bool {
+	return c.Limit != nil && len(c.Milestones) > *c.Limit
+}
+
+type Board struct {
+	ProjectID uuid.UUID
+	Columns   []BoardColumn
+}
+
+// ReorderMilestones menyusun ulang milestone project sesuai urutan ids.
+// ids harus memuat setiap milestone project tepat satu kali.
+func (s *MilestoneService) ReorderMilestones(ctx context.Context, userID, projectID uuid.UUID, ids []uuid.UUID) ([]model.Milestone, error) {
+	if _, err := s.access.CanEdit(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+	if err := s.guard.ensureWritable(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.repo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) != len(milestones) {
+		return nil, util.ErrBadRequest("milestone_ids must contain every milestone of the project exactly once")
+	}
+
+	byID := make(map[uuid.UUID]model.Milestone, len(milestones))
+	for _, m := range milestones {
+		byID[m.ID] = m
+	}
+
+	ordered := make([]model.Milestone, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		m, ok := byID[id]
+		if !ok || seen[id] {
+			return nil, util.ErrBadRequest(fmt.Sprintf("milestone %s is duplicated or does not belong to the project", id))
+		}
+		seen[id] = true
+		ordered = append(ordered, m)
+	}
+
+	changes := rerankMilestones(ordered)
+	if len(changes) > 0 {
+		if err := s.repo.UpdateOrder(ctx, projectID, changes); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range ordered {
+		if rank, ok := changes[ordered[i].ID]; ok {
+			ordered[i].OrderIdx = rank
+		}
+	}
+	return ordered, nil
+}
+
+func (s *MilestoneService) GetBoard(ctx context.Context, userID, projectID uuid.UUID) (*Board, error) {
+	if _, _, err := s.access.CanView(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.repo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := s.limitRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &Board{ProjectID: projectID}
+	index := make(map[model.MilestoneStatus]int, len(boardColumns))
+	for i, status := range boardColumns {
+		index[status] = i
+		board.Columns = append(board.Columns, BoardColumn{Status: status, Milestones: []model.Milestone{}})
+	}
+
+	for _, l := range limits {
+		if i, ok := index[l.Status]; ok {
+			limit := l.Limit
+			board.Columns[i].Limit = &limit
+		}
+	}
+
+	for _, m := range milestones {
+		col := boardColumnFor(m.Status)
+		board.Columns[index[col]].Milestones = append(board.Columns[index[col]].Milestones, m)
+	}
+
+	return board, nil
+}
+
+// SetWIPLimits mengganti WIP limit project; nilai nil berarti kolom tanpa limit.
+func (s *MilestoneService) SetWIPLimits(ctx context.Context, userID, projectID uuid.UUID, limits map[model.MilestoneStatus]*int) error {
+	if _, err := s.access.CanEdit(ctx, userID, projectID); err != nil {
+		return err
+	}
+	if err := s.guard.ensureWritable(ctx, projectID); err != nil {
+		return err
+	}
+
+	var rows []model.BoardColumnLimit
+	for _, status := range boardColumns {
+		limit := limits[status]
+		if limit == nil {
+			continue
+		}
+		if *limit < 1 {
+			return util.ErrBadRequest(fmt.Sprintf("WIP limit for %s must be at least 1", status))
+		}
+		rows = append(rows, model.BoardColumnLimit{ProjectID: projectID, Status: status, Limit: *limit})
+	}
+
+	return s.limitRepo.Replace(ctx, projectID, rows)
+}
+
+// ensureWIPCapacity menolak memindahkan milestone ke kolom in_progress yang sudah penuh.
+func (s *MilestoneService) ensureWIPCapacity(ctx context.Context, m *model.Milestone, next model.MilestoneStatus) error {
+	if next != model.StatusInProgress {
+		return nil
+	}
+
+	limits, err := s.limitRepo.FindByProject(ctx, m.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range limits {
+		if l.Status != model.StatusInProgress {
+			continue
+		}
+
+		milestones, err := s.repo.FindByProject(ctx, m.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for _, other := range milestones {
+			if other.ID != m.ID && boardColumnFor(other.Status) == model.StatusInProgress {
+				count++
+			}
+		}
+		if count >= l.Limit {
+			return util.ErrConflict(fmt.Sprintf("WIP limit reached: in_progress allows %d milestones", l.Limit))
+		}
+	}
+	return nil
+}
+
+// nextRank mengembalikan OrderIdx untuk milestone baru di akhir daftar.
+func (s *MilestoneService) nextRank(ctx context.Context, projectID uuid.UUID) (int, error) {
+	milestones, err := s.repo.FindByProject(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	max := 0
+	for _, m := range milestones {
+		if m.OrderIdx > max {
+			max = m.OrderIdx
+		}
+	}
+	return max + milestoneRankGap, nil
+}
+
+// milestone blocked tetap ditampilkan di kolom in_progress
+func boardColumnFor(status model.MilestoneStatus) model.MilestoneStatus {
+	if status == model.StatusBlocked {
+		return model.StatusInProgress
+	}
+	return status
+}
+
+// rerankMilestones memberi rank baru seminimal mungkin: milestone yang urutan rank-nya
+// sudah naik (longest increasing subsequence) dipertahankan, sisanya disisipkan di celah.
+// Jika celah tidak cukup, semua rank dibangun ulang dengan jarak milestoneRankGap.
+func rerankMilestones(ordered []model.Milestone) map[uuid.UUID]int {
+	n := len(ordered)
+	ranks := make([]int, n)
+	for i, m := range ordered {
+		ranks[i] = m.OrderIdx
+	}
+
+	keep := longestIncreasing(ranks)
+	next := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		if i == n-1 {
+			next[i] = -1
+		} else if keep[i+1] {
+			next[i] = i + 1
+		} else {
+			next[i] = next[i+1]
+		}
+	}
+
+	result := make([]int, n)
+	changes := make(map[uuid.UUID]int)
+	prev := 0
+	for i := 0; i < n; {
+		if keep[i] {
+			result[i] = ranks[i]
+			prev = ranks[i]
+			i++
+			continue
+		}
+
+		// kumpulkan satu run milestone yang perlu rank baru
+		end := next[i]
+		count := n - i
+		hi := prev + milestoneRankGap*(count+1)
+		if end != -1 {
+			count = end - i
+			hi = ranks[end]
+		}
+
+		step := (hi - prev) / (count + 1)
+		if step < 1 {
+			return rebalanceMilestones(ordered)
+		}
+
+		for j := 0; j < count; j++ {
+			rank := prev + step*(j+1)
+			result[i+j] = rank
+			changes[ordered[i+j].ID] = rank
+		}
+		prev = result[i+count-1]
+		i += count
+	}
+
+	return changes
+}
+
+func rebalanceMilestones(ordered []model.Milestone) map[uuid.UUID]int {
+	changes := make(map[uuid.UUID]int, len(ordered))
+	for i, m := range ordered {
+		rank := (i + 1) * milestoneRankGap
+		if m.OrderIdx != rank {
+			changes[m.ID] = rank
+		}
+	}
+	return changes
+}
+
+// longestIncreasing menandai posisi yang membentuk subsequence naik terpanjang.
+func longestIncreasing(values []int) []bool {
+	n := len(values)
+	keep := make([]bool, n)
+	if n == 0 {
+		return keep
+	}
+
+	length := make([]int, n)
+	parent := make([]int, n)
+	best := 0
+	for i := 0; i < n; i++ {
+		length[i] = 1
+		parent[i] = -1
+		for j := 0; j < i; j++ {
+			if values[j] < values[i] && length[j]+1 > length[i] {
+				length[i] = length[j] + 1
+				parent[i] = j
+			}
+		}
+		if length[i] > length[best] {
+			best = i
+		}
+	}
+
+	for i := best; i != -1; i = parent[i] {
+		keep[i] = true
+	}
+	return keep
+}
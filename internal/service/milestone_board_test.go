@@ -0,0 +1,56 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func TestLongestIncreasing(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []bool
+	}{
+		{"empty", []int{}, []bool{}},
+		{"already sorted", []int{1000, 2000, 3000}, []bool{true, true, true}},
+		{"last moved to front", []int{3000, 1000, 2000}, []bool{false, true, true}},
+		{"middle out of place", []int{2000, 1000, 3000}, []bool{true, false, true}},
+		{"descending keeps first", []int{3000, 2000, 1000}, []bool{true, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := longestIncreasing(tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("longestIncreasing(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRerankMilestones(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	m := func(id uuid.UUID, rank int) model.Milestone { return model.Milestone{ID: id, OrderIdx: rank} }
+
+	tests := []struct {
+		name    string
+		ordered []model.Milestone
+		want    map[uuid.UUID]int
+	}{
+		{"unchanged order", []model.Milestone{m(a, 1000), m(b, 2000), m(c, 3000)}, map[uuid.UUID]int{}},
+		{"move to front uses gap", []model.Milestone{m(c, 3000), m(a, 1000), m(b, 2000)}, map[uuid.UUID]int{c: 500}},
+		{"move to end appends", []model.Milestone{m(b, 2000), m(c, 3000), m(a, 1000)}, map[uuid.UUID]int{a: 4000}},
+		{"no gap rebalances", []model.Milestone{m(b, 2), m(a, 1), m(c, 3)}, map[uuid.UUID]int{b: 1000, a: 2000, c: 3000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rerankMilestones(tt.ordered); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rerankMilestones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,43 +2,131 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
 	"devtracker/internal/repository"
 	"devtracker/pkg/util"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type MilestoneService struct {
 	repo repository.MilestoneRepository
+	historyRepo repository.MilestoneHistoryRepository
+	depRepo repository.MilestoneDependencyRepository
+	limitRepo repository.BoardLimitRepository
+	scopeRepo repository.ScopeChangeRepository
+	projectRepo repository.ProjectRepository
+	access *AccessChecker
+	guard projectGuard
+	uow repository.UnitOfWork
+	publisher events.Publisher
 }
 
 
-func NewMilestoneService(repo repository.MilestoneRepository) *MilestoneService{
+func NewMilestoneService(repo repository.MilestoneRepository, historyRepo repository.MilestoneHistoryRepository, depRepo repository.MilestoneDependencyRepository, limitRepo repository.BoardLimitRepository, scopeRepo repository.ScopeChangeRepository, projectRepo repository.ProjectRepository, access *AccessChecker, uow repository.UnitOfWork, publisher events.Publisher) *MilestoneService{
 	return &MilestoneService{
+		guard: projectGuard{projectRepo},
+		uow: uow,
 		repo: repo,
+		historyRepo: historyRepo,
+		depRepo: depRepo,
+		limitRepo: limitRepo,
+		scopeRepo: scopeRepo,
+		projectRepo: projectRepo,
+		access: access,
+		publisher: publisher,
 	}
 }
 
+// milestoneTransitions adalah state machine status milestone: status asal -> aksi -> status tujuan.
+// Target unblock ditentukan saat runtime dari status sebelum milestone diblokir.
+var milestoneTransitions = map[model.MilestoneStatus]map[model.MilestoneAction]model.MilestoneStatus{
+	model.StatusPending: {
+		model.ActionStart:    model.StatusInProgress,
+		model.ActionComplete: model.StatusDone,
+		model.ActionBlock:    model.StatusBlocked,
+	},
+	model.StatusInProgress: {
+		model.ActionComplete: model.StatusDone,
+		model.ActionBlock:    model.StatusBlocked,
+	},
+	model.StatusDone: {
+		model.ActionReopen: model.StatusInProgress,
+	},
+	model.StatusBlocked: {
+		model.ActionUnblock: model.StatusPending,
+	},
+}
+
+var milestoneActionOrder = []model.MilestoneAction{
+	model.ActionStart,
+	model.ActionComplete,
+	model.ActionReopen,
+	model.ActionBlock,
+	model.ActionUnblock,
+}
+
+// AllowedMilestoneActions mengembalikan aksi yang valid dari status saat ini.
+func AllowedMilestoneActions(status model.MilestoneStatus) []model.MilestoneAction {
+	actions := []model.MilestoneAction{}
+	for _, a := range milestoneActionOrder {
+		if _, ok := milestoneTransitions[status][a]; ok {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
 
-func (s *MilestoneService) CreateMilestone(ctx context.Context, projectID uuid.UUID, name string, orderIdx int, status model.MilestoneStatus, dueDate *time.Time) (*model.Milestone, error) {
+
+func (s *MilestoneService) CreateMilestone(ctx context.Context, userID, projectID uuid.UUID, name string, orderIdx int, status model.MilestoneStatus, dueDate *time.Time, estimatedHours, weight *float64) (*model.Milestone, error) {
 
 	if name == "" {
 		return nil, util.ErrBadRequest("name required")
 	}
 
+	if estimatedHours != nil && *estimatedHours < 0 {
+		return nil, util.ErrBadRequest("estimated hours cannot be negative")
+	}
+
+	if weight != nil && *weight <= 0 {
+		return nil, util.ErrBadRequest("weight must be greater than zero")
+	}
+
+	// done dan blocked hanya bisa dicapai lewat transisi agar history dan alasan blokir tercatat
+	if status == "" {
+		status = model.StatusPending
+	}
+	if status != model.StatusPending && status != model.StatusInProgress {
+		return nil, util.ErrBadRequest("initial status must be pending or in_progress")
+	}
+
+	if _, err := s.access.CanEdit(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+	if err := s.guard.ensureWritable(ctx, projectID); err != nil {
+		return nil, err
+	}
+
 	if dueDate != nil && dueDate.Before(time.Now()) {
 		return nil, util.ErrBadRequest("due date cannot be in the past")
 	}
 
 	
 
-	var completedAt *time.Time
-	if status == model.StatusDone {
-		now := time.Now()
-		completedAt = &now
+	// tanpa order_idx eksplisit, milestone baru ditaruh di akhir dengan jarak rank
+	if orderIdx == 0 {
+		rank, err := s.nextRank(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		orderIdx = rank
 	}
 
 	milestone := &model.Milestone{
@@ -48,22 +136,49 @@ func (s *MilestoneService) CreateMilestone(ctx context.Context, projectID uuid.U
 		OrderIdx:  orderIdx,
 		Status:    status,
 		DueDate:   dueDate,
-		CompletedAt: completedAt,
+		EstimatedHours: estimatedHours,
+		Weight:    weight,
 		CreatedAt: time.Now(),
 	}
 
-	if err := s.repo.Create(ctx, milestone); err != nil {
-		if util.IsUniqueViolation(err) {
-			return nil, util.ErrConflict("milestone with this name already exists")
+	// milestone yang langsung dimulai dicatat sebagai aksi start dari pending
+	var history *model.MilestoneStatusHistory
+	if status == model.StatusInProgress {
+		if err := s.ensureWIPCapacity(ctx, milestone, status); err != nil {
+			return nil, err
+		}
+		history = newStatusHistory(milestone.ID, userID, model.ActionStart, model.StatusPending, status, "")
+	}
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, milestone); err != nil {
+			if util.IsUniqueViolation(err) {
+				return util.ErrConflict("milestone with this name already exists")
+			}
+			return err
+		}
+		if history != nil {
+			if err := s.historyRepo.Create(ctx, history); err != nil {
+				return err
+			}
 		}
+		return s.scopeRepo.Create(ctx, newScopeChange(milestone, model.ScopeAdded))
+	})
+	if err != nil {
 		return nil, err
 	}
+
+	s.publish(events.MilestoneCreated, userID, milestone)
 	return milestone, nil
 	
 } 
 
 
 func (s *MilestoneService) GetMilestonesByProject(ctx context.Context, userId, projectID uuid.UUID) ([]model.Milestone, error){
+	if _, _, err := s.access.CanView(ctx, userId, projectID); err != nil {
+		return nil, err
+	}
+
 	milestones, err := s.repo.FindByProject(ctx, projectID)
 	if err != nil {
 		return nil, err
@@ -72,32 +187,432 @@ func (s *MilestoneService) GetMilestonesByProject(ctx context.Context, userId, p
 }
 
 
-func (s *MilestoneService) GetMilestoneByID(ctx context.Context, id uuid.UUID) (*model.Milestone, error) {
+func (s *MilestoneService) GetMilestoneByID(ctx context.Context, userID, id uuid.UUID) (*model.Milestone, error) {
+	milestone, err := s.findMilestone(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := s.access.CanView(ctx, userID, milestone.ProjectID); err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+func (s *MilestoneService) findMilestone(ctx context.Context, id uuid.UUID) (*model.Milestone, error) {
 	milestone, err := s.repo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if milestone == nil {
+		return nil, util.ErrNotFound("milestone not found")
+	}
+	return milestone, nil
+}
+
+// editableMilestone memuat milestone dan memastikan userID pemilik project-nya
+// dan project tersebut masih bisa diubah.
+func (s *MilestoneService) editableMilestone(ctx context.Context, userID, id uuid.UUID) (*model.Milestone, error) {
+	milestone, err := s.findMilestone(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.access.CanEdit(ctx, userID, milestone.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := s.guard.ensureWritable(ctx, milestone.ProjectID); err != nil {
+		return nil, err
+	}
 	return milestone, nil
 }
 
-func (s *MilestoneService) UpdateMilestone(ctx context.Context, m *model.Milestone) error {
+func (s *MilestoneService) UpdateMilestone(ctx context.Context, userID uuid.UUID, m *model.Milestone) error {
 	if m.Name == "" {
 		return util.ErrBadRequest("name required")
 	}
 	if m.DueDate != nil && m.DueDate.Before(time.Now()) {
 		return util.ErrBadRequest("due date cannot be in the past")
 	}
-	if m.Status == model.StatusDone && m.CompletedAt == nil {
-		now := time.Now()
-		m.CompletedAt = &now
+	if m.EstimatedHours != nil && *m.EstimatedHours < 0 {
+		return util.ErrBadRequest("estimated hours cannot be negative")
+	}
+	if m.Weight != nil && *m.Weight <= 0 {
+		return util.ErrBadRequest("weight must be greater than zero")
 	}
-	return s.repo.Update(ctx, m)
+
+	orig, err := s.editableMilestone(ctx, userID, m.ID)
+	if err != nil {
+		return err
+	}
+	// project tidak bisa dipindah lewat update
+	m.ProjectID = orig.ProjectID
+
+	// Perubahan status lewat update biasa tetap harus melewati state machine.
+	var history *model.MilestoneStatusHistory
+	if m.Status != orig.Status {
+		var unblockTo model.MilestoneStatus
+		if orig.Status == model.StatusBlocked {
+			if unblockTo, err = s.statusBeforeBlock(ctx, orig.ID); err != nil {
+				return err
+			}
+		}
+		action, ok := s.actionFor(orig.Status, m.Status, unblockTo)
+		if !ok {
+			return util.ErrConflict(fmt.Sprintf("cannot change status from %s to %s", orig.Status, m.Status))
+		}
+		if action == model.ActionBlock {
+			return util.ErrBadRequest("use the block endpoint to block a milestone with a reason")
+		}
+		if requiresPrerequisites(orig.Status, m.Status) {
+			if err := s.ensurePrerequisitesDone(ctx, orig); err != nil {
+				return err
+			}
+		}
+		if err := s.ensureWIPCapacity(ctx, orig, m.Status); err != nil {
+			return err
+		}
+		history = newStatusHistory(m.ID, userID, action, orig.Status, m.Status, "")
+	}
+
+	applyStatus(m, m.Status, m.BlockedReason)
+	m.CompletedAt = completedAtFor(orig, m.Status)
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, m); err != nil {
+			return err
+		}
+		if history != nil {
+			return s.historyRepo.Create(ctx, history)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publish(events.MilestoneUpdated, userID, m)
+	return nil
 }
 
+// TransitionMilestone menjalankan satu aksi lifecycle dan mencatatnya di history.
+func (s *MilestoneService) TransitionMilestone(ctx context.Context, userID, id uuid.UUID, action model.MilestoneAction, reason string) (*model.Milestone, error) {
+	m, err := s.editableMilestone(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	next, ok := milestoneTransitions[m.Status][action]
+	if !ok {
+		return nil, util.ErrConflict(fmt.Sprintf("cannot %s a milestone with status %s", action, m.Status))
+	}
 
-func (s *MilestoneService) DeleteMilestone(ctx context.Context, id uuid.UUID) error {
-	if err := s.repo.Delete(ctx, id); err != nil {
-		return util.ErrNotFound("milestone not found")
+	if action == model.ActionBlock && reason == "" {
+		return nil, util.ErrBadRequest("reason is required to block a milestone")
+	}
+
+	if action == model.ActionUnblock {
+		next, err = s.statusBeforeBlock(ctx, m.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if requiresPrerequisites(m.Status, next) {
+		if err := s.ensurePrerequisitesDone(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.ensureWIPCapacity(ctx, m, next); err != nil {
+		return nil, err
+	}
+
+	from := m.Status
+	m.CompletedAt = completedAtFor(m, next)
+	applyStatus(m, next, reason)
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, m); err != nil {
+			return err
+		}
+		return s.historyRepo.Create(ctx, newStatusHistory(m.ID, userID, action, from, next, reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(events.MilestoneUpdated, userID, m)
+	return m, nil
+}
+
+func (s *MilestoneService) GetStatusHistory(ctx context.Context, userID, id uuid.UUID) ([]model.MilestoneStatusHistory, error) {
+	if id == uuid.Nil {
+		return nil, util.ErrBadRequest("milestone ID is required")
+	}
+	if _, err := s.GetMilestoneByID(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	return s.historyRepo.FindByMilestone(ctx, id)
+}
+
+
+func (s *MilestoneService) DeleteMilestone(ctx context.Context, userID, id uuid.UUID) error {
+	m, err := s.editableMilestone(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Delete(ctx, id); err != nil {
+			return util.ErrNotFound("milestone not found")
+		}
+		// dicatat supaya burndown tetap menghitung scope milestone ini sampai saat dihapus
+		return s.scopeRepo.Create(ctx, newScopeChange(m, model.ScopeRemoved))
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publish(events.MilestoneDeleted, userID, m)
+	return nil
+}
+
+// AddDependency menandai bahwa milestoneID baru bisa dimulai setelah dependsOnID selesai.
+func (s *MilestoneService) AddDependency(ctx context.Context, userID, milestoneID, dependsOnID uuid.UUID) (*model.MilestoneDependency, error) {
+	if milestoneID == dependsOnID {
+		return nil, util.ErrBadRequest("a milestone cannot depend on itself")
+	}
+
+	m, err := s.editableMilestone(ctx, userID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	prereq, err := s.repo.FindByID(ctx, dependsOnID)
+	if err != nil {
+		return nil, err
+	}
+	if prereq == nil {
+		return nil, util.ErrNotFound("prerequisite milestone not found")
+	}
+
+	if prereq.ProjectID != m.ProjectID {
+		return nil, util.ErrBadRequest("milestones must belong to the same project")
+	}
+
+	dep := &model.MilestoneDependency{
+		ID:          uuid.New(),
+		ProjectID:   m.ProjectID,
+		MilestoneID: milestoneID,
+		DependsOnID: dependsOnID,
+		CreatedAt:   time.Now(),
+	}
+
+	// lock project membuat cek siklus dan insert atomik: dua insert A->B dan B->A
+	// yang berjalan bersamaan tidak bisa sama-sama lolos cek
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.projectRepo.Lock(ctx, m.ProjectID); err != nil {
+			return err
+		}
+
+		deps, err := s.depRepo.FindByProject(ctx, m.ProjectID)
+		if err != nil {
+			return err
+		}
+		if createsCycle(deps, milestoneID, dependsOnID) {
+			return util.ErrConflict("dependency would create a cycle")
+		}
+
+		if err := s.depRepo.Create(ctx, dep); err != nil {
+			if util.IsUniqueViolation(err) {
+				return util.ErrConflict("dependency already exists")
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dep, nil
+}
+
+func (s *MilestoneService) GetDependencies(ctx context.Context, userID, milestoneID uuid.UUID) ([]model.MilestoneDependency, error) {
+	if milestoneID == uuid.Nil {
+		return nil, util.ErrBadRequest("milestone ID is required")
+	}
+	if _, err := s.GetMilestoneByID(ctx, userID, milestoneID); err != nil {
+		return nil, err
+	}
+
+	return s.depRepo.FindByMilestone(ctx, milestoneID)
+}
+
+func (s *MilestoneService) RemoveDependency(ctx context.Context, userID, milestoneID, dependsOnID uuid.UUID) error {
+	if _, err := s.editableMilestone(ctx, userID, milestoneID); err != nil {
+		return err
+	}
+
+	if err := s.depRepo.Delete(ctx, milestoneID, dependsOnID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return util.ErrNotFound("dependency not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// ensurePrerequisitesDone menolak memulai milestone yang prasyaratnya belum selesai.
+func (s *MilestoneService) ensurePrerequisitesDone(ctx context.Context, m *model.Milestone) error {
+	deps, err := s.depRepo.FindByMilestone(ctx, m.ID)
+	if err != nil {
+		return err
+	}
+	if len(deps) == 0 {
+		return nil
+	}
+
+	var pending []string
+	for _, d := range deps {
+		prereq, err := s.repo.FindByID(ctx, d.DependsOnID)
+		if err != nil {
+			return err
+		}
+		if prereq != nil && prereq.Status != model.StatusDone {
+			pending = append(pending, prereq.Name)
+		}
+	}
+
+	if len(pending) > 0 {
+		return util.ErrConflict("prerequisite milestones are not done: " + strings.Join(pending, ", "))
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// createsCycle memeriksa apakah milestoneID sudah bisa dicapai dari dependsOnID
+// lewat rantai prasyarat yang ada; jika ya, edge baru akan membentuk siklus.
+func createsCycle(deps []model.MilestoneDependency, milestoneID, dependsOnID uuid.UUID) bool {
+	prereqs := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range deps {
+		prereqs[d.MilestoneID] = append(prereqs[d.MilestoneID], d.DependsOnID)
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	stack := []uuid.UUID{dependsOnID}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if cur == milestoneID {
+			return true
+		}
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		stack = append(stack, prereqs[cur]...)
+	}
+	return false
+}
+
+// actionFor mencari aksi yang membawa milestone dari from ke to. unblockTo adalah status
+// sebelum diblokir (statusBeforeBlock); unblock hanya boleh kembali ke status itu.
+func (s *MilestoneService) actionFor(from, to, unblockTo model.MilestoneStatus) (model.MilestoneAction, bool) {
+	for _, a := range milestoneActionOrder {
+		target, ok := milestoneTransitions[from][a]
+		if !ok {
+			continue
+		}
+		if a == model.ActionUnblock {
+			target = unblockTo
+		}
+		if target == to {
+			return a, true
+		}
+	}
+	return "", false
+}
+
+// requiresPrerequisites: memulai atau menyelesaikan milestone dari pending, atau unblock
+// kembali ke in_progress, hanya boleh jika semua prasyarat sudah done.
+func requiresPrerequisites(from, to model.MilestoneStatus) bool {
+	if from != model.StatusPending && from != model.StatusBlocked {
+		return false
+	}
+	return to == model.StatusInProgress || to == model.StatusDone
+}
+
+func (s *MilestoneService) statusBeforeBlock(ctx context.Context, id uuid.UUID) (model.MilestoneStatus, error) {
+	last, err := s.historyRepo.FindLatest(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if last != nil && last.ToStatus == model.StatusBlocked && last.FromStatus != model.StatusBlocked {
+		return last.FromStatus, nil
+	}
+	return model.StatusPending, nil
+}
+
+func applyStatus(m *model.Milestone, status model.MilestoneStatus, reason string) {
+	m.Status = status
+	if status == model.StatusBlocked {
+		m.BlockedReason = reason
+	} else {
+		m.BlockedReason = ""
+	}
+}
+
+// completedAtFor menjaga CompletedAt: diisi saat masuk done, dikosongkan saat keluar dari done.
+func completedAtFor(orig *model.Milestone, next model.MilestoneStatus) *time.Time {
+	if next != model.StatusDone {
+		return nil
+	}
+	if orig.Status == model.StatusDone && orig.CompletedAt != nil {
+		return orig.CompletedAt
+	}
+	now := time.Now()
+	return &now
+}
+
+func newStatusHistory(milestoneID, userID uuid.UUID, action model.MilestoneAction, from, to model.MilestoneStatus, reason string) *model.MilestoneStatusHistory {
+	var changedBy *uuid.UUID
+	if userID != uuid.Nil {
+		changedBy = &userID
+	}
+
+	return &model.MilestoneStatusHistory{
+		ID:          uuid.New(),
+		MilestoneID: milestoneID,
+		Action:      action,
+		FromStatus:  from,
+		ToStatus:    to,
+		Reason:      reason,
+		ChangedBy:   changedBy,
+		ChangedAt:   time.Now(),
+	}
+}
+
+func newScopeChange(m *model.Milestone, kind model.ScopeChangeKind) *model.ScopeChange {
+	return &model.ScopeChange{
+		ID:                 uuid.New(),
+		ProjectID:          m.ProjectID,
+		MilestoneID:        m.ID,
+		MilestoneName:      m.Name,
+		Kind:               kind,
+		Weight:             m.Weight,
+		EstimatedHours:     m.EstimatedHours,
+		MilestoneCreatedAt: m.CreatedAt,
+		CompletedAt:        m.CompletedAt,
+		ChangedAt:          time.Now(),
+	}
+}
+
+func (s *MilestoneService) publish(t events.Type, userID uuid.UUID, m *model.Milestone) {
+	s.publisher.Publish(events.Event{
+		Type:      t,
+		UserID:    userID,
+		ProjectID: m.ProjectID,
+		EntityID:  m.ID,
+	})
+}
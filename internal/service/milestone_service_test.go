@@ -0,0 +1,176 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func TestAllowedMilestoneActions(t *testing.T) {
+	tests := []struct {
+		status model.MilestoneStatus
+		want   []model.MilestoneAction
+	}{
+		{model.StatusPending, []model.MilestoneAction{model.ActionStart, model.ActionComplete, model.ActionBlock}},
+		{model.StatusInProgress, []model.MilestoneAction{model.ActionComplete, model.ActionBlock}},
+		{model.StatusDone, []model.MilestoneAction{model.ActionReopen}},
+		{model.StatusBlocked, []model.MilestoneAction{model.ActionUnblock}},
+		{model.MilestoneStatus("unknown"), []model.MilestoneAction{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			got := AllowedMilestoneActions(tt.status)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AllowedMilestoneActions(%s) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMilestoneActionFor(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      model.MilestoneStatus
+		to        model.MilestoneStatus
+		unblockTo model.MilestoneStatus
+		want      model.MilestoneAction
+		wantOK    bool
+	}{
+		{"start", model.StatusPending, model.StatusInProgress, "", model.ActionStart, true},
+		{"complete from pending", model.StatusPending, model.StatusDone, "", model.ActionComplete, true},
+		{"complete from in_progress", model.StatusInProgress, model.StatusDone, "", model.ActionComplete, true},
+		{"block", model.StatusInProgress, model.StatusBlocked, "", model.ActionBlock, true},
+		{"reopen", model.StatusDone, model.StatusInProgress, "", model.ActionReopen, true},
+		{"unblock to pending", model.StatusBlocked, model.StatusPending, model.StatusPending, model.ActionUnblock, true},
+		{"unblock to in_progress", model.StatusBlocked, model.StatusInProgress, model.StatusInProgress, model.ActionUnblock, true},
+		{"unblock past the status before block", model.StatusBlocked, model.StatusInProgress, model.StatusPending, "", false},
+		{"unblock below the status before block", model.StatusBlocked, model.StatusPending, model.StatusInProgress, "", false},
+		{"in_progress back to pending", model.StatusInProgress, model.StatusPending, "", "", false},
+		{"done back to pending", model.StatusDone, model.StatusPending, "", "", false},
+		{"blocked straight to done", model.StatusBlocked, model.StatusDone, model.StatusPending, "", false},
+	}
+
+	s := &MilestoneService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := s.actionFor(tt.from, tt.to, tt.unblockTo)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("actionFor(%s, %s, %s) = (%q, %v), want (%q, %v)", tt.from, tt.to, tt.unblockTo, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRequiresPrerequisites(t *testing.T) {
+	tests := []struct {
+		from model.MilestoneStatus
+		to   model.MilestoneStatus
+		want bool
+	}{
+		{model.StatusPending, model.StatusInProgress, true},
+		{model.StatusPending, model.StatusDone, true},
+		{model.StatusBlocked, model.StatusInProgress, true},
+		{model.StatusBlocked, model.StatusPending, false},
+		{model.StatusPending, model.StatusBlocked, false},
+		{model.StatusInProgress, model.StatusDone, false},
+		{model.StatusDone, model.StatusInProgress, false},
+	}
+
+	for _, tt := range tests {
+		if got := requiresPrerequisites(tt.from, tt.to); got != tt.want {
+			t.Errorf("requiresPrerequisites(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCompletedAtFor(t *testing.T) {
+	earlier := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		orig     model.Milestone
+		next     model.MilestoneStatus
+		wantNil  bool
+		wantKeep bool
+	}{
+		{"leaving done clears", model.Milestone{Status: model.StatusDone, CompletedAt: &earlier}, model.StatusInProgress, true, false},
+		{"staying done keeps", model.Milestone{Status: model.StatusDone, CompletedAt: &earlier}, model.StatusDone, false, true},
+		{"entering done stamps now", model.Milestone{Status: model.StatusInProgress}, model.StatusDone, false, false},
+		{"not done stays empty", model.Milestone{Status: model.StatusPending}, model.StatusInProgress, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := completedAtFor(&tt.orig, tt.next)
+			switch {
+			case tt.wantNil:
+				if got != nil {
+					t.Errorf("got %v, want nil", *got)
+				}
+			case tt.wantKeep:
+				if got == nil || !got.Equal(earlier) {
+					t.Errorf("got %v, want %v", got, earlier)
+				}
+			default:
+				if got == nil || time.Since(*got) > time.Minute {
+					t.Errorf("got %v, want current time", got)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyStatusBlockedReason(t *testing.T) {
+	tests := []struct {
+		status model.MilestoneStatus
+		reason string
+		want   string
+	}{
+		{model.StatusBlocked, "waiting for data", "waiting for data"},
+		{model.StatusPending, "waiting for data", ""},
+		{model.StatusDone, "", ""},
+	}
+
+	for _, tt := range tests {
+		m := &model.Milestone{Status: model.StatusInProgress, BlockedReason: "old"}
+		applyStatus(m, tt.status, tt.reason)
+		if m.Status != tt.status || m.BlockedReason != tt.want {
+			t.Errorf("applyStatus(%s, %q) = (%s, %q), want (%s, %q)", tt.status, tt.reason, m.Status, m.BlockedReason, tt.status, tt.want)
+		}
+	}
+}
+
+func TestCreatesCycle(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	dep := func(m, on uuid.UUID) model.MilestoneDependency {
+		return model.MilestoneDependency{MilestoneID: m, DependsOnID: on}
+	}
+
+	tests := []struct {
+		name      string
+		deps      []model.MilestoneDependency
+		milestone uuid.UUID
+		dependsOn uuid.UUID
+		want      bool
+	}{
+		{"empty graph", nil, a, b, false},
+		{"direct reverse edge", []model.MilestoneDependency{dep(b, a)}, a, b, true},
+		{"transitive chain", []model.MilestoneDependency{dep(b, c), dep(c, a)}, a, b, true},
+		{"parallel branch", []model.MilestoneDependency{dep(b, c), dep(d, a)}, a, b, false},
+		{"diamond without cycle", []model.MilestoneDependency{dep(b, c), dep(b, d), dep(c, d)}, a, b, false},
+		{"diamond closing cycle", []model.MilestoneDependency{dep(b, c), dep(b, d), dep(d, a)}, a, b, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createsCycle(tt.deps, tt.milestone, tt.dependsOn); got != tt.want {
+				t.Errorf("createsCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/pkg/mail"
+	"devtracker/pkg/util"
+)
+
+// NotificationChannel mengirim notifikasi ke luar aplikasi. Channel in-app adalah tabel
+// notifications itu sendiri; channel dipanggil setelah notifikasi tersimpan.
+type NotificationChannel interface {
+	Name() string
+	Enabled(pref *model.NotificationPreference) bool
+	Send(ctx context.Context, user *model.User, pref *model.NotificationPreference, n *model.Notification) error
+}
+
+// EmailChannel mengirim notifikasi sebagai email teks ke alamat akun user.
+type EmailChannel struct {
+	mailer mail.Mailer
+}
+
+func NewEmailChannel(mailer mail.Mailer) *EmailChannel {
+	return &EmailChannel{mailer: mailer}
+}
+
+func (c *EmailChannel) Name() string { return "email" }
+
+func (c *EmailChannel) Enabled(pref *model.NotificationPreference) bool { return pref.Email }
+
+func (c *EmailChannel) Send(ctx context.Context, user *model.User, _ *model.NotificationPreference, n *model.Notification) error {
+	return c.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "[DevTracker] " + n.Title,
+		Text:    n.Body + "\n\n-- \nAtur notifikasi di pengaturan akun DevTracker Anda.\n",
+	})
+}
+
+// WebhookChannel mengirim notifikasi sebagai JSON (POST) ke WebhookURL user. Body
+// ditandatangani HMAC-SHA256 dengan WebhookSecret milik user tersebut di header
+// X-DevTracker-Signature, jadi penerima hanya bisa memverifikasi kiriman untuknya sendiri.
+type WebhookChannel struct {
+	client *http.Client
+}
+
+var (
+	// errWebhookTarget dikembalikan saat webhook mengarah ke alamat internal.
+	errWebhookTarget = errors.New("webhook target address is not allowed")
+	errWebhookSecret = errors.New("webhook secret is not set")
+)
+
+// NewWebhookChannel membuat client yang hanya bisa menghubungi alamat publik: IP hasil
+// resolve DNS dicek tepat sebelum connect (tahan DNS rebinding), proxy dari environment
+// tidak dipakai, dan redirect tidak diikuti.
+func NewWebhookChannel() *WebhookChannel {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: webhookDialControl}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+		MaxIdleConns:        10,
+		IdleConnTimeout:     30 * time.Second,
+	}
+	return &WebhookChannel{
+		client: &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// webhookDialControl dipanggil dengan alamat IP yang akan di-connect (setelah DNS).
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || blockedWebhookIP(ip) {
+		return errWebhookTarget
+	}
+	return nil
+}
+
+// webhookBlockedNets melengkapi pengecekan net.IP: "this network" 0.0.0.0/8 dan
+// shared address space (CGNAT) 100.64.0.0/10.
+var webhookBlockedNets = []*net.IPNet{
+	mustCIDR("0.0.0.0/8"),
+	mustCIDR("100.64.0.0/10"),
+}
+
+func mustCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// blockedWebhookIP: loopback, jaringan privat, CGNAT, 0.0.0.0/8, link-local (termasuk
+// metadata cloud 169.254.169.254), unspecified dan multicast.
+func blockedWebhookIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return true
+	}
+	for _, n := range webhookBlockedNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWebhookURL memeriksa URL webhook saat preferensi disimpan. Hostname tetap dicek
+// ulang saat pengiriman karena hasil DNS bisa berubah.
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return util.ErrBadRequest("webhook_url must be an http(s) URL")
+	}
+	if u.User != nil {
+		return util.ErrBadRequest("webhook_url must not contain credentials")
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return util.ErrBadRequest("webhook_url must point to a public host")
+	}
+	if ip := net.ParseIP(host); ip != nil && blockedWebhookIP(ip) {
+		return util.ErrBadRequest("webhook_url must point to a public host")
+	}
+	return nil
+}
+
+type webhookPayload struct {
+	ID        string  `json:"id"`
+	Kind      string  `json:"kind"`
+	Title     string  `json:"title"`
+	Body      string  `json:"body"`
+	ProjectID *string `json:"project_id,omitempty"`
+	EntityID  *string `json:"entity_id,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+func (c *WebhookChannel) Name() string { return "webhook" }
+
+func (c *WebhookChannel) Enabled(pref *model.NotificationPreference) bool {
+	return pref.WebhookURL != ""
+}
+
+func (c *WebhookChannel) Send(ctx context.Context, _ *model.User, pref *model.NotificationPreference, n *model.Notification) error {
+	if pref.WebhookSecret == "" {
+		return errWebhookSecret
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		ID:        n.ID.String(),
+		Kind:      string(n.Kind),
+		Title:     n.Title,
+		Body:      n.Body,
+		ProjectID: util.UUIDPtrToStringPtr(n.ProjectID),
+		EntityID:  util.UUIDPtrToStringPtr(n.EntityID),
+		CreatedAt: n.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pref.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-DevTracker-Event", string(n.Kind))
+	req.Header.Set("X-DevTracker-Signature", signWebhook(pref.WebhookSecret, body))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// signWebhook menghasilkan nilai header X-DevTracker-Signature: "sha256=" + HMAC hex.
+func signWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookSecret membuat secret acak 256-bit (hex) untuk satu user.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,577 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	notificationEventTimeout = 30 * time.Second
+	notificationSendTimeout  = 10 * time.Second // per kiriman channel luar
+	maxReminderDays          = 30
+)
+
+// NotificationService mengelola notification center user, preferensinya, dan produsen
+// notifikasi: event domain dari bus (insight, undangan, mention) serta pengingat terjadwal
+// (DueDate milestone dan hari tanpa log).
+type NotificationService struct {
+	repo           repository.NotificationRepository
+	userRepo       repository.UserRepository
+	projectRepo    repository.ProjectRepository
+	milestoneRepo  repository.MilestoneRepository
+	logRepo        repository.LogRepository
+	insightRepo    repository.AIInsightRepository
+	supervisorRepo repository.SupervisorRepository
+	commentRepo    repository.CommentRepository
+	access         *AccessChecker
+	channels       []NotificationChannel
+}
+
+func NewNotificationService(repo repository.NotificationRepository, userRepo repository.UserRepository, projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, logRepo repository.LogRepository, insightRepo repository.AIInsightRepository, supervisorRepo repository.SupervisorRepository, commentRepo repository.CommentRepository, access *AccessChecker, channels ...NotificationChannel) *NotificationService {
+	return &NotificationService{
+		repo:           repo,
+		userRepo:       userRepo,
+		projectRepo:    projectRepo,
+		milestoneRepo:  milestoneRepo,
+		logRepo:        logRepo,
+		insightRepo:    insightRepo,
+		supervisorRepo: supervisorRepo,
+		commentRepo:    commentRepo,
+		access:         access,
+		channels:       channels,
+	}
+}
+
+// NotificationPreferenceUpdate hanya mengubah field yang diisi.
+type NotificationPreferenceUpdate struct {
+	Email         *bool
+	WebhookURL    *string
+	MutedKinds    *[]model.NotificationKind
+	DueSoonDays   *int
+	IdleDays      *int
+	DigestEnabled *bool
+	DigestHour    *int
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, userID uuid.UUID, q repository.ListQuery) (*repository.Page[model.Notification], error) {
+	if q.Status != "" && q.Status != "read" && q.Status != "unread" {
+		return nil, util.ErrBadRequest("status must be read or unread")
+	}
+	if q.Type != "" && !model.NotificationKind(q.Type).Valid() {
+		return nil, util.ErrBadRequest("unsupported notification kind")
+	}
+
+	page, err := s.repo.List(ctx, userID, q)
+	if err != nil {
+		return nil, listError(err)
+	}
+	return page, nil
+}
+
+func (s *NotificationService) UnreadCount(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.repo.CountUnread(ctx, userID)
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	found, err := s.repo.MarkRead(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return util.ErrNotFound("notification not found")
+	}
+	return nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.repo.MarkAllRead(ctx, userID)
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, userID uuid.UUID) (*model.NotificationPreference, error) {
+	pref, err := s.repo.FindPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pref == nil {
+		pref = model.DefaultNotificationPreference(userID)
+	}
+	return pref, nil
+}
+
+// UpdatePreferences mengembalikan secret webhook baru (sekali saja) jika webhook baru
+// diaktifkan; selain itu secret kosong.
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID uuid.UUID, upd NotificationPreferenceUpdate) (*model.NotificationPreference, string, error) {
+	pref, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var secret string
+	if upd.Email != nil {
+		pref.Email = *upd.Email
+	}
+	if upd.WebhookURL != nil {
+		if *upd.WebhookURL != "" {
+			if err := validateWebhookURL(*upd.WebhookURL); err != nil {
+				return nil, "", err
+			}
+		}
+		pref.WebhookURL = *upd.WebhookURL
+		if pref.WebhookURL == "" {
+			pref.WebhookSecret = ""
+		} else if pref.WebhookSecret == "" {
+			if secret, err = newWebhookSecret(); err != nil {
+				return nil, "", err
+			}
+			pref.WebhookSecret = secret
+		}
+	}
+	if upd.MutedKinds != nil {
+		for _, k := range *upd.MutedKinds {
+			if !k.Valid() {
+				return nil, "", util.ErrBadRequest("unsupported notification kind: " + string(k))
+			}
+		}
+		pref.MutedKinds = *upd.MutedKinds
+	}
+	if upd.DueSoonDays != nil {
+		if *upd.DueSoonDays < 1 || *upd.DueSoonDays > maxReminderDays {
+			return nil, "", util.ErrBadRequest(fmt.Sprintf("due_soon_days must be between 1 and %d", maxReminderDays))
+		}
+		pref.DueSoonDays = *upd.DueSoonDays
+	}
+	if upd.IdleDays != nil {
+		if *upd.IdleDays < 0 || *upd.IdleDays > maxReminderDays {
+			return nil, "", util.ErrBadRequest(fmt.Sprintf("idle_days must be between 0 and %d", maxReminderDays))
+		}
+		pref.IdleDays = *upd.IdleDays
+	}
+	if upd.DigestEnabled != nil {
+		pref.DigestEnabled = *upd.DigestEnabled
+	}
+	if upd.DigestHour != nil {
+		if *upd.DigestHour < 0 || *upd.DigestHour > 23 {
+			return nil, "", util.ErrBadRequest("digest_hour must be between 0 and 23")
+		}
+		pref.DigestHour = *upd.DigestHour
+	}
+
+	if err := s.repo.SavePreference(ctx, pref); err != nil {
+		return nil, "", err
+	}
+	return pref, secret, nil
+}
+
+// RotateWebhookSecret mengganti secret webhook user dan mengembalikannya (sekali saja).
+// Kiriman berikutnya langsung ditandatangani dengan secret baru.
+func (s *NotificationService) RotateWebhookSecret(ctx context.Context, userID uuid.UUID) (string, error) {
+	pref, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if pref.WebhookURL == "" {
+		return "", util.ErrBadRequest("webhook_url is not set")
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+	pref.WebhookSecret = secret
+	if err := s.repo.SavePreference(ctx, pref); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// notify menyimpan notifikasi untuk user lalu meneruskannya ke channel yang diaktifkan.
+// Jenis yang di-mute dan notifikasi dengan DedupeKey yang sudah ada dilewati.
+func (s *NotificationService) notify(ctx context.Context, n *model.Notification) error {
+	pref, err := s.GetPreferences(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+	if pref.Muted(n.Kind) {
+		return nil
+	}
+
+	n.ID = uuid.New()
+	n.CreatedAt = time.Now()
+	created, err := s.repo.Create(ctx, n)
+	if err != nil || !created {
+		return err
+	}
+
+	var user *model.User
+	for _, ch := range s.channels {
+		if !ch.Enabled(pref) {
+			continue
+		}
+		if user == nil {
+			if user, err = s.userRepo.FindByID(ctx, n.UserID); err != nil || user == nil {
+				return err
+			}
+		}
+		// kegagalan channel luar tidak membatalkan notifikasi in-app
+		if err := s.send(ctx, ch, user, pref, n); err != nil {
+			log.Printf("notification %s via %s failed: %v", n.ID, ch.Name(), err)
+		}
+	}
+	return nil
+}
+
+// send membatasi satu kiriman dengan timeout sendiri supaya endpoint yang lambat tidak
+// menahan notifikasi lain.
+func (s *NotificationService) send(ctx context.Context, ch NotificationChannel, user *model.User, pref *model.NotificationPreference, n *model.Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationSendTimeout)
+	defer cancel()
+	return ch.Send(ctx, user, pref, n)
+}
+
+// Subscribe mendaftarkan produsen notifikasi berbasis event ke bus. Handler bus bersifat
+// sinkron, jadi pemrosesan dilakukan di goroutine terpisah.
+func (s *NotificationService) Subscribe(bus *events.Bus) {
+	bus.Subscribe(func(e events.Event) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), notificationEventTimeout)
+			defer cancel()
+
+			if err := s.handleEvent(ctx, e); err != nil {
+				log.Printf("notification for %s %s failed: %v", e.Type, e.EntityID, err)
+			}
+		}()
+	},
+		events.InsightCreated, events.InsightUpdated,
+		events.SupervisorInvited, events.SupervisorAccepted, events.SupervisorDeclined,
+		events.CommentCreated, events.CommentUpdated,
+	)
+}
+
+func (s *NotificationService) handleEvent(ctx context.Context, e events.Event) error {
+	switch e.Type {
+	case events.InsightCreated, events.InsightUpdated:
+		return s.onInsight(ctx, e.EntityID)
+	case events.SupervisorInvited:
+		return s.onInvited(ctx, e.EntityID)
+	case events.SupervisorAccepted, events.SupervisorDeclined:
+		return s.onInviteResponse(ctx, e.EntityID)
+	case events.CommentCreated, events.CommentUpdated:
+		return s.onComment(ctx, e.EntityID)
+	}
+	return nil
+}
+
+// onInsight memberi tahu pemilik dan supervisor aktif saat status insight project berubah.
+// Insight pertama hanya dilaporkan jika statusnya AT_RISK atau DELAYED.
+func (s *NotificationService) onInsight(ctx context.Context, id uuid.UUID) error {
+	insight, err := s.insightRepo.FindByID(ctx, id)
+	if err != nil || insight == nil {
+		return err
+	}
+	if insight.Status == "" || insight.Status == "UNKNOWN" {
+		return nil
+	}
+
+	prev, err := s.insightRepo.FindLatestBefore(ctx, insight.ProjectID, insight.GeneratedAt)
+	if err != nil {
+		return err
+	}
+	if prev == nil && insight.Status == model.StatusOnTrack {
+		return nil
+	}
+	if prev != nil && prev.Status == insight.Status {
+		return nil
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, insight.ProjectID)
+	if err != nil || project == nil {
+		return err
+	}
+	recipients, err := s.access.Members(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("%s sekarang %s", project.Name, insight.Status)
+	body := insight.Content
+	if prev != nil {
+		body = fmt.Sprintf("Status berubah dari %s ke %s. %s", prev.Status, insight.Status, insight.Content)
+	}
+	for _, userID := range recipients {
+		err := s.notify(ctx, &model.Notification{
+			UserID:    userID,
+			Kind:      model.NotifyInsightStatus,
+			Title:     title,
+			Body:      body,
+			ProjectID: &project.ID,
+			EntityID:  &insight.ID,
+			DedupeKey: dedupeKey("insight", insight.ID, insight.Status),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onInvited memberi tahu pemilik email undangan jika sudah punya akun.
+func (s *NotificationService) onInvited(ctx context.Context, id uuid.UUID) error {
+	inv, err := s.supervisorRepo.FindByID(ctx, id)
+	if err != nil || inv == nil || inv.Status != model.SupervisorPending {
+		return err
+	}
+	invitee, err := s.userRepo.FindByEmail(ctx, inv.Email)
+	if err != nil || invitee == nil {
+		return err
+	}
+	project, err := s.projectRepo.FindByID(ctx, inv.ProjectID)
+	if err != nil || project == nil {
+		return err
+	}
+
+	inviterName := "Pemilik project"
+	if inviter, err := s.userRepo.FindByID(ctx, inv.InvitedBy); err == nil && inviter != nil {
+		inviterName = inviter.Name
+	}
+
+	return s.notify(ctx, &model.Notification{
+		UserID:    invitee.ID,
+		Kind:      model.NotifyInvitationReceived,
+		Title:     fmt.Sprintf("Undangan supervisor untuk %s", project.Name),
+		Body:      fmt.Sprintf("%s mengundang Anda sebagai supervisor project %s. Terima atau tolak undangan di menu supervisi.", inviterName, project.Name),
+		ProjectID: &project.ID,
+		EntityID:  &inv.ID,
+		// undangan ulang ke email yang sama memakai baris yang sama dengan CreatedAt baru
+		DedupeKey: dedupeKey("invitation", inv.ID, inv.CreatedAt.Unix()),
+	})
+}
+
+// onInviteResponse memberi tahu pengundang saat undangan diterima atau ditolak.
+func (s *NotificationService) onInviteResponse(ctx context.Context, id uuid.UUID) error {
+	inv, err := s.supervisorRepo.FindByID(ctx, id)
+	if err != nil || inv == nil || inv.RespondedAt == nil {
+		return err
+	}
+
+	var kind model.NotificationKind
+	var verb string
+	switch inv.Status {
+	case model.SupervisorActive:
+		kind, verb = model.NotifyInvitationAccepted, "menerima"
+	case model.SupervisorDeclined:
+		kind, verb = model.NotifyInvitationDeclined, "menolak"
+	default:
+		return nil
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, inv.ProjectID)
+	if err != nil || project == nil {
+		return err
+	}
+
+	return s.notify(ctx, &model.Notification{
+		UserID:    inv.InvitedBy,
+		Kind:      kind,
+		Title:     fmt.Sprintf("%s %s undangan supervisor", inv.Email, verb),
+		Body:      fmt.Sprintf("%s %s undangan sebagai supervisor project %s.", inv.Email, verb, project.Name),
+		ProjectID: &project.ID,
+		EntityID:  &inv.ID,
+		DedupeKey: dedupeKey("invitation", inv.ID, inv.Status, inv.RespondedAt.Unix()),
+	})
+}
+
+// onComment memberi tahu user yang di-@mention. Mention yang sudah diberi tahu tidak
+// dikirim ulang saat komentar diedit.
+func (s *NotificationService) onComment(ctx context.Context, id uuid.UUID) error {
+	c, err := s.commentRepo.FindByID(ctx, id)
+	if err != nil || c == nil {
+		return err
+	}
+	mentions, err := s.commentRepo.FindMentions(ctx, []uuid.UUID{c.ID})
+	if err != nil || len(mentions) == 0 {
+		return err
+	}
+
+	authorName := "Seseorang"
+	if author, err := s.userRepo.FindByID(ctx, c.AuthorID); err == nil && author != nil {
+		authorName = author.Name
+	}
+
+	for _, m := range mentions {
+		err := s.notify(ctx, &model.Notification{
+			UserID:    m.UserID,
+			Kind:      model.NotifyCommentMention,
+			Title:     fmt.Sprintf("%s menyebut Anda di komentar %s", authorName, c.TargetType),
+			Body:      c.Body,
+			ProjectID: &c.ProjectID,
+			EntityID:  &c.ID,
+			DedupeKey: dedupeKey("mention", c.ID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunReminders menjalankan pengingat terjadwal secara berkala sampai ctx dibatalkan.
+func (s *NotificationService) RunReminders(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RemindDueDates(ctx, time.Now()); err != nil {
+			log.Printf("due date reminders failed: %v", err)
+		}
+		if err := s.RemindIdleUsers(ctx, time.Now()); err != nil {
+			log.Printf("idle reminders failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RemindDueDates memberi tahu pemilik project tentang milestone yang jatuh tempo dalam
+// DueSoonDays hari atau sudah lewat. Pengingat dikirim sekali per DueDate; jika DueDate
+// dimundurkan, pengingat dikirim lagi. Kegagalan satu milestone dicatat di log dan tidak
+// menghentikan pengingat lainnya; project yang diarsipkan atau di trash dilewati.
+func (s *NotificationService) RemindDueDates(ctx context.Context, now time.Time) error {
+	milestones, err := s.milestoneRepo.FindOpenDueBefore(ctx, now.AddDate(0, 0, maxReminderDays))
+	if err != nil {
+		return err
+	}
+
+	projects := make(map[uuid.UUID]*model.Project)
+	owners := make(map[uuid.UUID]*reminderTarget)
+	for i := range milestones {
+		m := &milestones[i]
+
+		project, ok := projects[m.ProjectID]
+		if !ok {
+			if project, err = s.projectRepo.FindByID(ctx, m.ProjectID); err != nil {
+				log.Printf("due date reminder for milestone %s failed: %v", m.ID, err)
+				continue
+			}
+			projects[m.ProjectID] = project
+		}
+		if project == nil || project.Archived() {
+			continue
+		}
+
+		owner, ok := owners[project.UserID]
+		if !ok {
+			if owner, err = s.reminderTarget(ctx, project.UserID); err != nil {
+				log.Printf("due date reminder for milestone %s failed: %v", m.ID, err)
+				continue
+			}
+			owners[project.UserID] = owner
+		}
+		if owner == nil {
+			continue
+		}
+
+		due := *m.DueDate
+		dueText := due.In(owner.loc).Format("02 Jan 2006 15:04 MST")
+		n := &model.Notification{
+			UserID:    owner.userID,
+			ProjectID: &project.ID,
+			EntityID:  &m.ID,
+		}
+		switch {
+		case due.Before(now):
+			n.Kind = model.NotifyMilestoneOverdue
+			n.Title = fmt.Sprintf("Milestone %s terlambat", m.Name)
+			n.Body = fmt.Sprintf("Milestone %s di project %s melewati tenggat %s.", m.Name, project.Name, dueText)
+		case due.Sub(now) <= time.Duration(owner.pref.DueSoonDays)*24*time.Hour:
+			n.Kind = model.NotifyMilestoneDueSoon
+			n.Title = fmt.Sprintf("Milestone %s segera jatuh tempo", m.Name)
+			n.Body = fmt.Sprintf("Milestone %s di project %s jatuh tempo %s.", m.Name, project.Name, dueText)
+		default:
+			continue
+		}
+		n.DedupeKey = dedupeKey(string(n.Kind), m.ID, due.Unix())
+
+		if err := s.notify(ctx, n); err != nil {
+			log.Printf("due date reminder for milestone %s failed: %v", m.ID, err)
+		}
+	}
+	return nil
+}
+
+// RemindIdleUsers mengingatkan user dengan project aktif yang belum mencatat log selama
+// IdleDays hari. Satu pengingat per periode kosong: log baru memulai periode berikutnya.
+// Kegagalan untuk satu user dicatat di log dan user berikutnya tetap diproses.
+func (s *NotificationService) RemindIdleUsers(ctx context.Context, now time.Time) error {
+	lastLogs, err := s.logRepo.FindLastLogByActiveUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ll := range lastLogs {
+		target, err := s.reminderTarget(ctx, ll.UserID)
+		if err != nil {
+			log.Printf("idle reminder for user %s failed: %v", ll.UserID, err)
+			continue
+		}
+		if target == nil || target.pref.IdleDays == 0 {
+			continue
+		}
+
+		idle := now.Sub(ll.LastLoggedAt)
+		if idle < time.Duration(target.pref.IdleDays)*24*time.Hour {
+			continue
+		}
+
+		days := int(idle.Hours() / 24)
+		err = s.notify(ctx, &model.Notification{
+			UserID:    ll.UserID,
+			Kind:      model.NotifyNoTimeLogged,
+			Title:     fmt.Sprintf("Belum ada log selama %d hari", days),
+			Body:      fmt.Sprintf("Log terakhir Anda tercatat %s. Catat progres hari ini agar insight project tetap akurat.", ll.LastLoggedAt.In(target.loc).Format("02 Jan 2006")),
+			DedupeKey: dedupeKey(string(model.NotifyNoTimeLogged), ll.LastLoggedAt.Unix()),
+		})
+		if err != nil {
+			log.Printf("idle reminder for user %s failed: %v", ll.UserID, err)
+		}
+	}
+	return nil
+}
+
+type reminderTarget struct {
+	userID uuid.UUID
+	loc    *time.Location
+	pref   *model.NotificationPreference
+}
+
+func (s *NotificationService) reminderTarget(ctx context.Context, userID uuid.UUID) (*reminderTarget, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	pref, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &reminderTarget{userID: userID, loc: user.Location(), pref: pref}, nil
+}
+
+func dedupeKey(parts ...interface{}) *string {
+	key := fmt.Sprint(parts[0])
+	for _, p := range parts[1:] {
+		key += ":" + fmt.Sprint(p)
+	}
+	return &key
+}
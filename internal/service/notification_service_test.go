@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type reminderNotificationRepo struct {
+	repository.NotificationRepository
+	created []model.Notification
+}
+
+func (r *reminderNotificationRepo) FindPreference(context.Context, uuid.UUID) (*model.NotificationPreference, error) {
+	return nil, nil
+}
+
+func (r *reminderNotificationRepo) Create(_ context.Context, n *model.Notification) (bool, error) {
+	r.created = append(r.created, *n)
+	return true, nil
+}
+
+type reminderUserRepo struct {
+	repository.UserRepository
+	failing uuid.UUID
+}
+
+func (r reminderUserRepo) FindByID(_ context.Context, id uuid.UUID) (*model.User, error) {
+	if id == r.failing {
+		return nil, errors.New("connection reset")
+	}
+	return &model.User{ID: id, Email: "dev@example.com"}, nil
+}
+
+type reminderLogRepo struct {
+	repository.LogRepository
+	lastLogs []repository.UserLastLog
+}
+
+func (r reminderLogRepo) FindLastLogByActiveUsers(context.Context) ([]repository.UserLastLog, error) {
+	return r.lastLogs, nil
+}
+
+// deadlineChannel mencatat deadline context yang diterima setiap kiriman.
+type deadlineChannel struct{ deadlines []time.Time }
+
+func (c *deadlineChannel) Name() string                               { return "webhook" }
+func (c *deadlineChannel) Enabled(*model.NotificationPreference) bool { return true }
+func (c *deadlineChannel) Send(ctx context.Context, _ *model.User, _ *model.NotificationPreference, _ *model.Notification) error {
+	deadline, _ := ctx.Deadline()
+	c.deadlines = append(c.deadlines, deadline)
+	return errors.New("endpoint unavailable")
+}
+
+func TestRemindIdleUsersContinuesAfterFailure(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	failing, first, second := uuid.New(), uuid.New(), uuid.New()
+
+	notifications := &reminderNotificationRepo{}
+	s := &NotificationService{
+		repo:     notifications,
+		userRepo: reminderUserRepo{failing: failing},
+		logRepo: reminderLogRepo{lastLogs: []repository.UserLastLog{
+			{UserID: first, LastLoggedAt: now.AddDate(0, 0, -5)},
+			{UserID: failing, LastLoggedAt: now.AddDate(0, 0, -5)},
+			{UserID: second, LastLoggedAt: now.AddDate(0, 0, -4)},
+			{UserID: uuid.New(), LastLoggedAt: now.AddDate(0, 0, -1)},
+		}},
+	}
+
+	if err := s.RemindIdleUsers(context.Background(), now); err != nil {
+		t.Fatalf("RemindIdleUsers() err = %v", err)
+	}
+
+	got := map[uuid.UUID]bool{}
+	for _, n := range notifications.created {
+		got[n.UserID] = true
+	}
+	if len(notifications.created) != 2 || !got[first] || !got[second] {
+		t.Errorf("reminders sent to %v, want %s and %s", got, first, second)
+	}
+}
+
+func TestNotifySendTimeout(t *testing.T) {
+	ch := &deadlineChannel{}
+	s := &NotificationService{
+		repo:     &reminderNotificationRepo{},
+		userRepo: reminderUserRepo{},
+		channels: []NotificationChannel{ch, ch},
+	}
+
+	start := time.Now()
+	err := s.notify(context.Background(), &model.Notification{UserID: uuid.New(), Kind: model.NotifyNoTimeLogged})
+	if err != nil {
+		t.Fatalf("notify() err = %v, channel failures must not fail the notification", err)
+	}
+	if len(ch.deadlines) != 2 {
+		t.Fatalf("sends = %d, want 2", len(ch.deadlines))
+	}
+	for i, d := range ch.deadlines {
+		if d.IsZero() || d.Sub(start) > notificationSendTimeout+time.Second {
+			t.Errorf("send %d deadline = %v, want within %v", i, d, notificationSendTimeout)
+		}
+	}
+}
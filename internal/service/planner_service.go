@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// planMaxWeeks membatasi horizon rencana; pekerjaan di luar horizon dianggap belum terjadwal.
+const planMaxWeeks = 52
+
+// planEpsilon menghindari sisa pecahan jam akibat pembulatan float.
+const planEpsilon = 1e-6
+
+type PlannerService struct {
+	projectRepo   repository.ProjectRepository
+	milestoneRepo repository.MilestoneRepository
+	taskRepo      repository.TaskRepository
+	calendarSvc   *CalendarService
+}
+
+type PlanProject struct {
+	Project         model.Project
+	RemainingHours  float64
+	PlannedHours    float64 // jam yang teralokasi dalam horizon
+	HoursByDeadline float64 // jam yang teralokasi sebelum deadline
+	ShortfallHours  float64
+	ProjectedFinish *time.Time // nil jika tidak selesai dalam horizon
+	SlipDays        int        // hari kalender keterlambatan dari deadline
+	Feasible        bool
+}
+
+type PlanAllocation struct {
+	ProjectID uuid.UUID
+	Hours     float64
+}
+
+type PlanWeek struct {
+	WeekStart     time.Time
+	CapacityHours float64
+	PlannedHours  float64
+	Allocations   []PlanAllocation
+}
+
+// CapacityPlan adalah alokasi jam per project per minggu dengan urutan earliest-deadline-first.
+type CapacityPlan struct {
+	GeneratedAt         time.Time
+	Timezone            string
+	DailyHours          float64
+	TotalRemainingHours float64
+	OverCommitted       bool
+	FirstSlip           *PlanProject
+	Projects            []PlanProject
+	Weeks               []PlanWeek
+}
+
+func NewPlannerService(projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, taskRepo repository.TaskRepository, calendarSvc *CalendarService) *PlannerService {
+	return &PlannerService{
+		projectRepo:   projectRepo,
+		milestoneRepo: milestoneRepo,
+		taskRepo:      taskRepo,
+		calendarSvc:   calendarSvc,
+	}
+}
+
+// PlanCapacity membagi kapasitas kerja user ke semua project per hari dengan EDF
+// (deadline terdekat dulu, project tanpa deadline terakhir), lalu merangkumnya per minggu.
+// EDF meminimalkan keterlambatan maksimum, sehingga project pertama yang kekurangan jam
+// dalam urutan ini adalah deadline yang paling dulu akan tergelincir.
+func (s *PlannerService) PlanCapacity(ctx context.Context, userID uuid.UUID) (*CapacityPlan, error) {
+	cal, err := s.calendarSvc.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projectRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// project yang diarsipkan tidak lagi membutuhkan kapasitas
+	projects = activeProjects(projects)
+
+	projectIDs := make([]uuid.UUID, 0, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID)
+	}
+
+	milestones, err := s.milestoneRepo.FindByProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.FindByProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	milestonesByProject := make(map[uuid.UUID][]model.Milestone)
+	for _, m := range milestones {
+		milestonesByProject[m.ProjectID] = append(milestonesByProject[m.ProjectID], m)
+	}
+	taskProgress := taskCompletion(tasks)
+
+	now := time.Now().In(cal.Location)
+	plan := &CapacityPlan{
+		GeneratedAt: now,
+		Timezone:    cal.Location.String(),
+		DailyHours:  cal.Calendar.DailyHours,
+		Projects:    make([]PlanProject, 0, len(projects)),
+		Weeks:       []PlanWeek{},
+	}
+
+	for _, p := range projects {
+		remaining := remainingWorkHours(milestonesByProject[p.ID], taskProgress, cal.Calendar.DailyHours)
+		plan.TotalRemainingHours += remaining
+		plan.Projects = append(plan.Projects, PlanProject{
+			Project:        p,
+			RemainingHours: remaining,
+		})
+	}
+
+	sort.SliceStable(plan.Projects, func(i, j int) bool {
+		a, b := plan.Projects[i].Project.Deadline, plan.Projects[j].Project.Deadline
+		switch {
+		case a == nil && b == nil:
+			return plan.Projects[i].Project.CreatedAt.Before(plan.Projects[j].Project.CreatedAt)
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		default:
+			return a.Before(*b)
+		}
+	})
+
+	left := make([]float64, len(plan.Projects))
+	outstanding := 0.0
+	for i := range plan.Projects {
+		left[i] = plan.Projects[i].RemainingHours
+		outstanding += left[i]
+	}
+
+	today := startOfDay(now, cal.Location)
+	horizonEnd := startOfWeek(now, cal.Location).AddDate(0, 0, planMaxWeeks*7)
+	var week *PlanWeek
+	weekAlloc := make(map[uuid.UUID]float64)
+
+	flushWeek := func() {
+		if week == nil {
+			return
+		}
+		for i := range plan.Projects {
+			if h := weekAlloc[plan.Projects[i].Project.ID]; h > 0 {
+				week.Allocations = append(week.Allocations, PlanAllocation{ProjectID: plan.Projects[i].Project.ID, Hours: h})
+			}
+		}
+		plan.Weeks = append(plan.Weeks, *week)
+		week = nil
+		weekAlloc = make(map[uuid.UUID]float64)
+	}
+
+	for day := today; outstanding > planEpsilon && day.Before(horizonEnd); day = day.AddDate(0, 0, 1) {
+		if week == nil || !startOfWeek(day, cal.Location).Equal(week.WeekStart) {
+			flushWeek()
+			week = &PlanWeek{WeekStart: startOfWeek(day, cal.Location), Allocations: []PlanAllocation{}}
+		}
+
+		capacity := cal.HoursOn(day)
+		week.CapacityHours += capacity
+
+		for i := range plan.Projects {
+			if capacity <= planEpsilon {
+				break
+			}
+			if left[i] <= planEpsilon {
+				continue
+			}
+
+			hours := math.Min(capacity, left[i])
+			capacity -= hours
+			left[i] -= hours
+			outstanding -= hours
+
+			pp := &plan.Projects[i]
+			pp.PlannedHours += hours
+			if pp.Project.Deadline != nil && !day.After(startOfDay(*pp.Project.Deadline, cal.Location)) {
+				pp.HoursByDeadline += hours
+			}
+			if left[i] <= planEpsilon {
+				outstanding -= left[i]
+				left[i] = 0
+				finish := day
+				pp.ProjectedFinish = &finish
+			}
+
+			week.PlannedHours += hours
+			weekAlloc[pp.Project.ID] += hours
+		}
+	}
+	flushWeek()
+
+	for i := range plan.Projects {
+		pp := &plan.Projects[i]
+		if pp.RemainingHours <= planEpsilon {
+			// tidak ada sisa pekerjaan, project dianggap selesai hari ini
+			finish := today
+			pp.ProjectedFinish = &finish
+		}
+
+		if pp.Project.Deadline == nil {
+			pp.Feasible = pp.ProjectedFinish != nil
+			continue
+		}
+
+		pp.ShortfallHours = math.Max(0, pp.RemainingHours-pp.HoursByDeadline)
+		if pp.ShortfallHours <= planEpsilon {
+			pp.ShortfallHours = 0
+		}
+		pp.Feasible = pp.ShortfallHours == 0
+
+		if !pp.Feasible {
+			plan.OverCommitted = true
+			if pp.ProjectedFinish != nil {
+				pp.SlipDays = dayOffset(startOfDay(*pp.Project.Deadline, cal.Location), *pp.ProjectedFinish)
+			}
+			if plan.FirstSlip == nil {
+				plan.FirstSlip = pp
+			}
+		}
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// everyDayCalendarRepo: semua hari adalah hari kerja 8 jam, supaya hasil tidak bergantung
+// pada hari saat test dijalankan.
+type everyDayCalendarRepo struct{ repository.CalendarRepository }
+
+func (everyDayCalendarRepo) FindCalendar(_ context.Context, userID uuid.UUID) (*model.WorkCalendar, error) {
+	return &model.WorkCalendar{UserID: userID, WorkDays: 1<<7 - 1, DailyHours: 8}, nil
+}
+
+func (everyDayCalendarRepo) FindHolidays(context.Context, uuid.UUID) ([]model.Holiday, error) {
+	return nil, nil
+}
+
+func (everyDayCalendarRepo) FindTimeOff(context.Context, uuid.UUID) ([]model.TimeOff, error) {
+	return nil, nil
+}
+
+type plannerProjectRepo struct {
+	repository.ProjectRepository
+	projects []model.Project
+}
+
+func (r plannerProjectRepo) FindByUser(context.Context, uuid.UUID) ([]model.Project, error) {
+	return r.projects, nil
+}
+
+type plannerMilestoneRepo struct {
+	repository.MilestoneRepository
+	milestones []model.Milestone
+}
+
+func (r plannerMilestoneRepo) FindByProjects(context.Context, []uuid.UUID) ([]model.Milestone, error) {
+	return r.milestones, nil
+}
+
+type plannerTaskRepo struct {
+	repository.TaskRepository
+	tasks []model.Task
+}
+
+func (r plannerTaskRepo) FindByProjects(context.Context, []uuid.UUID) ([]model.Task, error) {
+	return r.tasks, nil
+}
+
+func TestPlanCapacityEDF(t *testing.T) {
+	today := startOfDay(time.Now(), time.UTC)
+	deadline := func(days int) *time.Time {
+		d := today.AddDate(0, 0, days)
+		return &d
+	}
+	hours := func(h float64) *float64 { return &h }
+
+	late := model.Project{ID: uuid.New(), Name: "late", Deadline: deadline(2), CreatedAt: today.AddDate(0, 0, -3)}
+	urgent := model.Project{ID: uuid.New(), Name: "urgent", Deadline: deadline(1), CreatedAt: today.AddDate(0, 0, -2)}
+	open := model.Project{ID: uuid.New(), Name: "no deadline", CreatedAt: today.AddDate(0, 0, -1)}
+	archived := model.Project{ID: uuid.New(), Name: "archived", Deadline: deadline(0), ArchivedAt: &today}
+
+	halfDone := uuid.New()
+	milestones := []model.Milestone{
+		{ID: uuid.New(), ProjectID: urgent.ID, Status: model.StatusPending, EstimatedHours: hours(16)},
+		{ID: uuid.New(), ProjectID: late.ID, Status: model.StatusInProgress, EstimatedHours: hours(12)},
+		{ID: halfDone, ProjectID: late.ID, Status: model.StatusInProgress, EstimatedHours: hours(8)},
+		{ID: uuid.New(), ProjectID: late.ID, Status: model.StatusDone, EstimatedHours: hours(40)},
+		{ID: uuid.New(), ProjectID: open.ID, Status: model.StatusPending, EstimatedHours: hours(4)},
+		{ID: uuid.New(), ProjectID: archived.ID, Status: model.StatusPending, EstimatedHours: hours(100)},
+	}
+	tasks := []model.Task{{MilestoneID: halfDone, Done: true}, {MilestoneID: halfDone}}
+
+	s := NewPlannerService(
+		plannerProjectRepo{projects: []model.Project{late, urgent, open, archived}},
+		plannerMilestoneRepo{milestones: milestones},
+		plannerTaskRepo{tasks: tasks},
+		NewCalendarService(everyDayCalendarRepo{}, timezoneUserRepo{timezone: "UTC"}),
+	)
+
+	plan, err := s.PlanCapacity(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("PlanCapacity() err = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		remaining    float64
+		byDeadline   float64
+		shortfall    float64
+		finishInDays int
+		slipDays     int
+		feasible     bool
+	}{
+		// deadline terdekat dijadwalkan dulu: hari 0 dan 1
+		{"urgent", 16, 16, 0, 1, 0, true},
+		// 12 jam + separuh dari 8 jam; hanya hari 2 yang masih sebelum deadline
+		{"late", 16, 8, 8, 3, 1, false},
+		{"no deadline", 4, 0, 0, 4, 0, true},
+	}
+
+	if len(plan.Projects) != len(tests) {
+		t.Fatalf("plan has %d projects, want %d (archived excluded)", len(plan.Projects), len(tests))
+	}
+	for i, tt := range tests {
+		pp := plan.Projects[i]
+		if pp.Project.Name != tt.name {
+			t.Errorf("project %d = %q, want %q", i, pp.Project.Name, tt.name)
+			continue
+		}
+		if !near(pp.RemainingHours, tt.remaining) || !near(pp.HoursByDeadline, tt.byDeadline) || !near(pp.ShortfallHours, tt.shortfall) {
+			t.Errorf("%s: remaining/by deadline/shortfall = %v/%v/%v, want %v/%v/%v", tt.name,
+				pp.RemainingHours, pp.HoursByDeadline, pp.ShortfallHours, tt.remaining, tt.byDeadline, tt.shortfall)
+		}
+		if pp.ProjectedFinish == nil || !pp.ProjectedFinish.Equal(today.AddDate(0, 0, tt.finishInDays)) {
+			t.Errorf("%s: ProjectedFinish = %v, want today+%d", tt.name, pp.ProjectedFinish, tt.finishInDays)
+		}
+		if pp.SlipDays != tt.slipDays || pp.Feasible != tt.feasible {
+			t.Errorf("%s: slip/feasible = %d/%v, want %d/%v", tt.name, pp.SlipDays, pp.Feasible, tt.slipDays, tt.feasible)
+		}
+	}
+
+	if !plan.OverCommitted || plan.FirstSlip == nil || plan.FirstSlip.Project.ID != late.ID {
+		t.Errorf("OverCommitted = %v, FirstSlip = %v, want late project", plan.OverCommitted, plan.FirstSlip)
+	}
+	if !near(plan.TotalRemainingHours, 36) {
+		t.Errorf("TotalRemainingHours = %v, want 36", plan.TotalRemainingHours)
+	}
+
+	planned := 0.0
+	for _, w := range plan.Weeks {
+		if w.PlannedHours > w.CapacityHours+planEpsilon {
+			t.Errorf("week %v planned %v over capacity %v", w.WeekStart, w.PlannedHours, w.CapacityHours)
+		}
+		planned += w.PlannedHours
+	}
+	if !near(planned, 36) {
+		t.Errorf("weeks plan %v hours, want 36", planned)
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"log"
+	"math"
 	"time"
 
 	"devtracker/internal/domain/model"
@@ -9,15 +11,37 @@ import (
 	"devtracker/pkg/util"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type ProjectService struct {
 	repo repository.ProjectRepository
+	revisionRepo repository.ProjectRevisionRepository
+	scopeRepo repository.ScopeChangeRepository
+	uow repository.UnitOfWork
 }
 
-func NewProjectService(repo repository.ProjectRepository) *ProjectService {
+// DeadlineSlippage merangkum seberapa sering deadline project dimundurkan.
+type DeadlineSlippage struct {
+	DeadlineChanges int
+	Postponements   int
+	TotalSlipDays   int
+	LastChangedAt   *time.Time
+}
+
+type ProjectHistory struct {
+	Project      model.Project
+	Revisions    []model.ProjectRevision
+	ScopeChanges []model.ScopeChange
+	Slippage     DeadlineSlippage
+}
+
+func NewProjectService(repo repository.ProjectRepository, revisionRepo repository.ProjectRevisionRepository, scopeRepo repository.ScopeChangeRepository, uow repository.UnitOfWork) *ProjectService {
 	return &ProjectService{
+		uow: uow,
 		repo: repo,
+		revisionRepo: revisionRepo,
+		scopeRepo: scopeRepo,
 	}
 }
 
@@ -41,12 +65,18 @@ func (s *ProjectService) CreateProject(ctx context.Context, userID uuid.UUID, na
 		Deadline: deadline,
 	}
 
-	if err := s.repo.Create(ctx, project); err != nil {
-	if util.IsUniqueViolation(err) {
-		return nil, util.ErrConflict("project with this name already exists")
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, project); err != nil {
+			if util.IsUniqueViolation(err) {
+				return util.ErrConflict("project with this name already exists")
+			}
+			return err
+		}
+		return s.recordRevision(ctx, nil, project, userID, "")
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
-}
 	return project, nil
 }
 
@@ -55,21 +85,36 @@ func (s *ProjectService) GetProjectByID(ctx context.Context, id uuid.UUID) (*mod
 	if err != nil {
 		return nil, err
 	}
+	if proj == nil {
+		return nil, util.ErrNotFound("project not found")
+	}
 	return proj, nil
 
 }
 
-func (s *ProjectService) ListProjectByUser(ctx context.Context, userID uuid.UUID) ([]model.Project, error){
-	projects, err := s.repo.FindByUser(ctx, userID)
+// ListProjectByUser menyembunyikan project yang diarsipkan kecuali q.Status archived atau all.
+func (s *ProjectService) ListProjectByUser(ctx context.Context, userID uuid.UUID, q repository.ListQuery) (*repository.Page[model.Project], error){
+	switch q.Status {
+	case "":
+		q.Status = "active"
+	case "active", "archived", "all":
+	default:
+		return nil, util.ErrBadRequest("status must be active, archived or all")
+	}
+
+	q.UserID = &userID
+	page, err := s.repo.List(ctx, q)
 	if err != nil {
-		return nil, err
+		return nil, listError(err)
 	}
-	return projects, nil
+	return page, nil
 }
 
 
 
-func (s *ProjectService) UpdateProject(ctx context.Context, userID uuid.UUID, p *model.Project) error {
+// UpdateProject menyimpan perubahan project; perubahan nama/deadline dicatat sebagai revisi baru
+// beserta alasan (opsional).
+func (s *ProjectService) UpdateProject(ctx context.Context, userID uuid.UUID, p *model.Project, reason string) error {
 	if p.ID == uuid.Nil {
 		return util.ErrBadRequest("project ID is required")
 	}
@@ -83,7 +128,7 @@ func (s *ProjectService) UpdateProject(ctx context.Context, userID uuid.UUID, p
 	}
 
 	existingProject, err := s.repo.FindByID(ctx, p.ID)
-	if err != nil {
+	if err != nil || existingProject == nil {
 		return util.ErrNotFound("project not found")
 	}
 
@@ -91,14 +136,116 @@ func (s *ProjectService) UpdateProject(ctx context.Context, userID uuid.UUID, p
 		return util.ErrUnauthorized("you do not have permission to update this project")
 	}
 
+	if existingProject.Archived() {
+		return errProjectArchived
+	}
+
 	p.UserID = userID
+	p.ClientID = existingProject.ClientID
+	p.CreatedAt = existingProject.CreatedAt
 	p.UpdatedAt = time.Now()
 
-	return s.repo.Update(ctx, p)
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, p); err != nil {
+			if util.IsUniqueViolation(err) {
+				return util.ErrConflict("project with this name already exists")
+			}
+			return err
+		}
+
+		if existingProject.Name == p.Name && sameDeadline(existingProject.Deadline, p.Deadline) {
+			return nil
+		}
+		return s.recordRevision(ctx, existingProject, p, userID, reason)
+	})
+}
+
+// GetHistory mengembalikan riwayat revisi (nama/deadline) dan perubahan scope milestone.
+func (s *ProjectService) GetHistory(ctx context.Context, userID, projectID uuid.UUID) (*ProjectHistory, error) {
+	project, err := s.repo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, util.ErrNotFound("project not found")
+	}
+	if project.UserID != userID {
+		return nil, util.ErrUnauthorized("you do not have permission to view this project")
+	}
+
+	revisions, err := s.revisionRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeChanges, err := s.scopeRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectHistory{
+		Project:      *project,
+		Revisions:    revisions,
+		ScopeChanges: scopeChanges,
+		Slippage:     deadlineSlippage(revisions),
+	}, nil
+}
+
+func (s *ProjectService) recordRevision(ctx context.Context, prev, p *model.Project, userID uuid.UUID, reason string) error {
+	version, err := s.revisionRepo.LatestVersion(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+
+	rev := &model.ProjectRevision{
+		ID:        uuid.New(),
+		ProjectID: p.ID,
+		Version:   version + 1,
+		Name:      p.Name,
+		Deadline:  p.Deadline,
+		Reason:    reason,
+		ChangedBy: userID,
+		ChangedAt: time.Now(),
+	}
+	if prev != nil {
+		rev.PrevName = prev.Name
+		rev.PrevDeadline = prev.Deadline
+	}
+
+	return s.revisionRepo.Create(ctx, rev)
 }
 
+func sameDeadline(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// deadlineSlippage menghitung berapa kali deadline dipindah dan total hari mundurnya.
+// Menambah deadline pada project yang sebelumnya tanpa deadline tidak dihitung mundur.
+func deadlineSlippage(revisions []model.ProjectRevision) DeadlineSlippage {
+	var res DeadlineSlippage
+	for i := range revisions {
+		r := &revisions[i]
+		if !r.DeadlineChanged() {
+			continue
+		}
+		res.DeadlineChanges++
+		changedAt := r.ChangedAt
+		res.LastChangedAt = &changedAt
+
+		if r.Deadline != nil && r.PrevDeadline != nil && r.Deadline.After(*r.PrevDeadline) {
+			res.Postponements++
+			res.TotalSlipDays += int(math.Ceil(r.Deadline.Sub(*r.PrevDeadline).Hours() / 24))
+		}
+	}
+	return res
+}
+
+// DeleteProject memindahkan project ke trash; data dihapus permanen oleh PurgeTrash.
 func (s *ProjectService) DeleteProject(ctx context.Context, userID, id uuid.UUID) error {
-	proj, err := s.repo.FindByID(ctx, id); if err != nil {
+	proj, err := s.repo.FindByID(ctx, id); if err != nil || proj == nil {
 		return util.ErrNotFound("project not found")
 	}
 
@@ -108,4 +255,125 @@ func (s *ProjectService) DeleteProject(ctx context.Context, userID, id uuid.UUID
 
 
 	return s.repo.Delete(ctx, id)
+}
+
+func (s *ProjectService) ArchiveProject(ctx context.Context, userID, id uuid.UUID) (*model.Project, error) {
+	return s.setArchived(ctx, userID, id, true)
+}
+
+func (s *ProjectService) UnarchiveProject(ctx context.Context, userID, id uuid.UUID) (*model.Project, error) {
+	return s.setArchived(ctx, userID, id, false)
+}
+
+func (s *ProjectService) setArchived(ctx context.Context, userID, id uuid.UUID, archived bool) (*model.Project, error) {
+	proj, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if proj == nil {
+		return nil, util.ErrNotFound("project not found")
+	}
+	if proj.UserID != userID {
+		return nil, util.ErrUnauthorized("you do not have permission to update this project")
+	}
+
+	if proj.Archived() == archived {
+		return proj, nil
+	}
+
+	if archived {
+		now := time.Now()
+		proj.ArchivedAt = &now
+	} else {
+		proj.ArchivedAt = nil
+	}
+
+	if err := s.repo.Update(ctx, proj); err != nil {
+		return nil, err
+	}
+	return proj, nil
+}
+
+func (s *ProjectService) GetTrash(ctx context.Context, userID uuid.UUID) ([]model.Project, error) {
+	return s.repo.FindTrashedByUser(ctx, userID)
+}
+
+func (s *ProjectService) RestoreProject(ctx context.Context, userID, id uuid.UUID) (*model.Project, error) {
+	proj, err := s.repo.FindTrashedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if proj == nil {
+		return nil, util.ErrNotFound("project not found in trash")
+	}
+	if proj.UserID != userID {
+		return nil, util.ErrUnauthorized("you do not have permission to restore this project")
+	}
+
+	if err := s.repo.Restore(ctx, proj); err != nil {
+		if util.IsUniqueViolation(err) {
+			return nil, util.ErrConflict("an active project with this name already exists")
+		}
+		return nil, err
+	}
+
+	proj.DeletedAt = gorm.DeletedAt{}
+	return proj, nil
+}
+
+// PurgeTrash menghapus permanen project yang sudah berada di trash lebih lama dari retention.
+func (s *ProjectService) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.repo.PurgeTrashed(ctx, time.Now().Add(-retention))
+}
+
+// RunTrashPurger menjalankan PurgeTrash secara berkala sampai ctx dibatalkan.
+func (s *ProjectService) RunTrashPurger(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.PurgeTrash(ctx, retention)
+		if err != nil {
+			log.Printf("trash purge failed: %v", err)
+		} else if n > 0 {
+			log.Printf("trash purge removed %d project(s)", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+var errProjectArchived = util.ErrConflict("project is archived and read-only")
+
+func activeProjects(projects []model.Project) []model.Project {
+	res := make([]model.Project, 0, len(projects))
+	for _, p := range projects {
+		if !p.Archived() {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// projectGuard menolak perubahan pada project yang diarsipkan atau sudah masuk trash.
+type projectGuard struct {
+	repo repository.ProjectRepository
+}
+
+func (g projectGuard) ensureWritable(ctx context.Context, projectID uuid.UUID) error {
+	proj, err := g.repo.FindByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if proj == nil {
+		return util.ErrNotFound("project not found")
+	}
+	if proj.Archived() {
+		return errProjectArchived
+	}
+	return nil
 }
\ No newline at end of file
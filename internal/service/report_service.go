@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
 	"devtracker/internal/repository"
 	"devtracker/pkg/util"
 
@@ -12,12 +13,14 @@ import (
 )
 
 type ReportService struct {
-	repo repository.ReportRepository
+	repo      repository.ReportRepository
+	publisher events.Publisher
 }
 
-func NewReportService(repo repository.ReportRepository) *ReportService{
+func NewReportService(repo repository.ReportRepository, publisher events.Publisher) *ReportService{
 	return &ReportService{
-		repo: repo,
+		repo:      repo,
+		publisher: publisher,
 	}
 }
 
@@ -39,6 +42,8 @@ func (s *ReportService) CreateReport(ctx context.Context, projectID, userID uuid
 	if err := s.repo.Create(ctx, report); err != nil {
 		return nil, err
 	}
+
+	s.publish(events.ReportCreated, userID, report)
 	return report, nil
 }
 
@@ -54,16 +59,17 @@ func (s *ReportService) GetReportByID(ctx context.Context, id uuid.UUID) (*model
 	return report, nil
 }
 
-func (s *ReportService) GetReportsByProject(ctx context.Context, projectID uuid.UUID) ([]model.Report, error) {
+func (s *ReportService) GetReportsByProject(ctx context.Context, projectID uuid.UUID, q repository.ListQuery) (*repository.Page[model.Report], error) {
 	if projectID == uuid.Nil {
 		return nil, util.ErrBadRequest("project ID is required")
 	}
 
-	reports, err := s.repo.FindByProject(ctx, projectID)
+	q.ProjectID = &projectID
+	page, err := s.repo.List(ctx, q)
 	if err != nil {
-		return nil, err
+		return nil, listError(err)
 	}
-	return reports, nil
+	return page, nil
 }
 
 func (s *ReportService) UpdateReport(ctx context.Context, report *model.Report) (*model.Report, error) {
@@ -73,10 +79,23 @@ func (s *ReportService) UpdateReport(ctx context.Context, report *model.Report)
 	if report.URLPDF == "" {
 		return nil, util.ErrBadRequest("urlPDF is required")
 	}
-	if err := s.repo.Update(ctx, report); err != nil {
+
+	// hanya URLPDF yang boleh diubah; field lain diambil dari data tersimpan
+	existing, err := s.repo.FindByID(ctx, report.ID)
+	if err != nil {
 		return nil, err
 	}
-	return report, nil
+	if existing == nil {
+		return nil, util.ErrNotFound("report not found")
+	}
+	existing.URLPDF = report.URLPDF
+
+	if err := s.repo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	s.publish(events.ReportUpdated, uuid.Nil, existing)
+	return existing, nil
 }
 
 
@@ -85,9 +104,28 @@ func (s *ReportService) DeleteReport(ctx context.Context, id uuid.UUID) error {
 		return util.ErrBadRequest("report ID is required")
 	}
 
+	report, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return err
 	}
+
+	if report != nil {
+		s.publish(events.ReportDeleted, uuid.Nil, report)
+	}
 	return nil
 }
 
+// publish memberi tahu subscriber; userID kosong jika pelaku tidak diketahui handler.
+func (s *ReportService) publish(t events.Type, userID uuid.UUID, report *model.Report) {
+	s.publisher.Publish(events.Event{
+		Type:      t,
+		UserID:    userID,
+		ProjectID: report.ProjectID,
+		EntityID:  report.ID,
+	})
+}
+
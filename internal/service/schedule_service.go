@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+type ScheduleService struct {
+	projectRepo   repository.ProjectRepository
+	milestoneRepo repository.MilestoneRepository
+	depRepo       repository.MilestoneDependencyRepository
+	calendarSvc   *CalendarService
+	access        *AccessChecker
+}
+
+// MilestoneSchedule adalah hasil critical path untuk satu milestone.
+// Semua offset dihitung dalam hari kerja (kalender kerja pemilik project) sejak hari ini.
+type MilestoneSchedule struct {
+	Milestone      model.Milestone
+	DependsOn      []uuid.UUID
+	DurationDays   int
+	EarliestStart  time.Time
+	EarliestFinish time.Time
+	LatestStart    time.Time
+	LatestFinish   time.Time
+	SlackDays      int
+	Critical       bool
+	Warnings       []string
+}
+
+type ProjectSchedule struct {
+	ProjectID       uuid.UUID
+	Deadline        *time.Time
+	ProjectedFinish time.Time
+	Feasible        bool
+	CriticalPath    []uuid.UUID
+	Milestones      []MilestoneSchedule
+}
+
+func NewScheduleService(projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, depRepo repository.MilestoneDependencyRepository, calendarSvc *CalendarService, access *AccessChecker) *ScheduleService {
+	return &ScheduleService{
+		projectRepo:   projectRepo,
+		milestoneRepo: milestoneRepo,
+		depRepo:       depRepo,
+		calendarSvc:   calendarSvc,
+		access:        access,
+	}
+}
+
+// CalculateSchedule menjalankan critical path method (forward & backward pass)
+// di atas graf dependency milestone satu project. Hanya pemilik dan supervisor aktif yang boleh melihat.
+func (s *ScheduleService) CalculateSchedule(ctx context.Context, userID, projectID uuid.UUID) (*ProjectSchedule, error) {
+	project, _, err := s.access.CanView(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.milestoneRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	deps, err := s.depRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cal, err := s.calendarSvc.Load(ctx, project.UserID)
+	if err != nil {
+		return nil, err
+	}
+	days := &workdays{cal: cal, origin: startOfDay(time.Now(), cal.Location)}
+
+	byID := make(map[uuid.UUID]*model.Milestone, len(milestones))
+	for i := range milestones {
+		byID[milestones[i].ID] = &milestones[i]
+	}
+
+	prereqs := make(map[uuid.UUID][]uuid.UUID)
+	successors := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range deps {
+		if byID[d.MilestoneID] == nil || byID[d.DependsOnID] == nil {
+			continue
+		}
+		prereqs[d.MilestoneID] = append(prereqs[d.MilestoneID], d.DependsOnID)
+		successors[d.DependsOnID] = append(successors[d.DependsOnID], d.MilestoneID)
+	}
+
+	order, err := topoSortMilestones(milestones, prereqs)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := make(map[uuid.UUID]int, len(milestones))
+	for _, id := range order {
+		duration[id] = milestoneDurationDays(byID[id], cal.Calendar.DailyHours)
+	}
+
+	var deadline *int
+	if project.Deadline != nil {
+		offset := days.offset(*project.Deadline)
+		deadline = &offset
+	}
+
+	cpm := criticalPath(order, byID, prereqs, successors, duration, deadline, days.offset)
+	es, ef, ls, lf, finish := cpm.es, cpm.ef, cpm.ls, cpm.lf, cpm.finish
+
+	minSlack := math.MaxInt
+	for _, id := range order {
+		if byID[id].Status == model.StatusDone {
+			continue
+		}
+		if slack := ls[id] - es[id]; slack < minSlack {
+			minSlack = slack
+		}
+	}
+
+	feasible := finish <= cpm.deadline
+	schedule := &ProjectSchedule{
+		ProjectID:       projectID,
+		Deadline:        project.Deadline,
+		ProjectedFinish: days.finishDate(finish),
+		CriticalPath:    []uuid.UUID{},
+		Milestones:      make([]MilestoneSchedule, 0, len(order)),
+	}
+
+	for _, id := range order {
+		m := byID[id]
+		slack := ls[id] - es[id]
+		critical := m.Status != model.StatusDone && slack == minSlack
+		if slack < 0 && m.Status != model.StatusDone {
+			feasible = false
+		}
+
+		var warnings []string
+		if m.Status != model.StatusPending && m.Status != model.StatusDone {
+			for _, p := range prereqs[id] {
+				if byID[p].Status != model.StatusDone {
+					warnings = append(warnings, "started before prerequisite \""+byID[p].Name+"\" is done")
+				}
+			}
+		}
+		if slack < 0 && m.Status != model.StatusDone {
+			warnings = append(warnings, "cannot finish before its due date or the project deadline")
+		}
+
+		schedule.Milestones = append(schedule.Milestones, MilestoneSchedule{
+			Milestone:      *m,
+			DependsOn:      prereqs[id],
+			DurationDays:   duration[id],
+			EarliestStart:  days.date(es[id]),
+			EarliestFinish: days.finishDate(ef[id]),
+			LatestStart:    days.date(ls[id]),
+			LatestFinish:   days.finishDate(lf[id]),
+			SlackDays:      slack,
+			Critical:       critical,
+			Warnings:       warnings,
+		})
+		if critical {
+			schedule.CriticalPath = append(schedule.CriticalPath, id)
+		}
+	}
+
+	schedule.Feasible = feasible
+	return schedule, nil
+}
+
+// cpmTimes adalah hasil forward & backward pass dalam offset hari kerja sejak hari ini.
+type cpmTimes struct {
+	es, ef, ls, lf map[uuid.UUID]int
+	finish         int
+	deadline       int
+}
+
+// criticalPath menjalankan forward & backward pass di atas order (hasil topological sort).
+// deadline nil berarti project tanpa deadline, sehingga batas akhirnya adalah finish proyeksi;
+// dueOffset mengubah DueDate milestone menjadi offset hari kerja.
+func criticalPath(order []uuid.UUID, byID map[uuid.UUID]*model.Milestone, prereqs, successors map[uuid.UUID][]uuid.UUID, duration map[uuid.UUID]int, deadline *int, dueOffset func(time.Time) int) cpmTimes {
+	res := cpmTimes{
+		es: make(map[uuid.UUID]int, len(order)),
+		ef: make(map[uuid.UUID]int, len(order)),
+		ls: make(map[uuid.UUID]int, len(order)),
+		lf: make(map[uuid.UUID]int, len(order)),
+	}
+
+	// Forward pass
+	for _, id := range order {
+		start := 0
+		if byID[id].Status != model.StatusDone {
+			for _, p := range prereqs[id] {
+				if res.ef[p] > start {
+					start = res.ef[p]
+				}
+			}
+		}
+		res.es[id] = start
+		res.ef[id] = start + duration[id]
+		if res.ef[id] > res.finish {
+			res.finish = res.ef[id]
+		}
+	}
+
+	res.deadline = res.finish
+	if deadline != nil {
+		res.deadline = *deadline
+	}
+
+	// Backward pass: batas paling akhir adalah deadline project, due date milestone, dan successor.
+	for i := len(order) - 1; i >= 0; i-- {
+		id := order[i]
+		m := byID[id]
+
+		latest := res.deadline
+		if m.DueDate != nil && m.Status != model.StatusDone {
+			if due := dueOffset(*m.DueDate); due < latest {
+				latest = due
+			}
+		}
+		for _, succ := range successors[id] {
+			if res.ls[succ] < latest {
+				latest = res.ls[succ]
+			}
+		}
+		res.lf[id] = latest
+		res.ls[id] = latest - duration[id]
+	}
+
+	return res
+}
+
+// milestoneDurationDays: milestone selesai tidak lagi memakan waktu,
+// sisanya memakai estimasi jam dibagi kapasitas harian, minimal satu hari kerja.
+func milestoneDurationDays(m *model.Milestone, dailyHours float64) int {
+	if m.Status == model.StatusDone {
+		return 0
+	}
+	if m.EstimatedHours == nil || *m.EstimatedHours <= 0 {
+		return 1
+	}
+	return int(math.Ceil(*m.EstimatedHours / dailyHours))
+}
+
+func dayOffset(origin, t time.Time) int {
+	t = t.In(origin.Location())
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, origin.Location())
+	return int(math.Round(day.Sub(origin).Hours() / 24))
+}
+
+// workdays memetakan indeks hari kerja (0 = hari kerja pertama sejak origin) ke tanggal.
+type workdays struct {
+	cal    *WorkingCalendar
+	origin time.Time
+	dates  []time.Time
+}
+
+func (w *workdays) extend(n int) {
+	for len(w.dates) < n {
+		next := w.origin
+		if len(w.dates) > 0 {
+			next = w.dates[len(w.dates)-1].AddDate(0, 0, 1)
+		}
+		w.dates = append(w.dates, w.cal.NextWorkingDay(next))
+	}
+}
+
+// date mengembalikan tanggal mulai hari kerja ke-i; indeks negatif (lewat deadline)
+// dipetakan sebagai hari kalender sebelum origin.
+func (w *workdays) date(i int) time.Time {
+	if i < 0 {
+		return w.origin.AddDate(0, 0, i)
+	}
+	w.extend(i + 1)
+	return w.dates[i]
+}
+
+// finishDate mengembalikan batas akhir (eksklusif) setelah i hari kerja.
+func (w *workdays) finishDate(i int) time.Time {
+	if i <= 0 {
+		return w.date(i)
+	}
+	return w.date(i-1).AddDate(0, 0, 1)
+}
+
+// offset menghitung jumlah hari kerja dari origin sampai tanggal t (inklusif).
+func (w *workdays) offset(t time.Time) int {
+	day := startOfDay(t, w.cal.Location)
+	if day.Before(w.origin) {
+		return dayOffset(w.origin, day) + 1
+	}
+	n := 0
+	for {
+		w.extend(n + 1)
+		if w.dates[n].After(day) {
+			return n
+		}
+		n++
+	}
+}
+
+// topoSortMilestones mengurutkan milestone dengan algoritma Kahn, memakai OrderIdx sebagai tie-breaker.
+func topoSortMilestones(milestones []model.Milestone, prereqs map[uuid.UUID][]uuid.UUID) ([]uuid.UUID, error) {
+	sorted := make([]model.Milestone, len(milestones))
+	copy(sorted, milestones)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].OrderIdx < sorted[j].OrderIdx
+	})
+
+	indegree := make(map[uuid.UUID]int, len(sorted))
+	dependents := make(map[uuid.UUID][]uuid.UUID)
+	for _, m := range sorted {
+		indegree[m.ID] = len(prereqs[m.ID])
+		for _, p := range prereqs[m.ID] {
+			dependents[p] = append(dependents[p], m.ID)
+		}
+	}
+
+	order := make([]uuid.UUID, 0, len(sorted))
+	done := make(map[uuid.UUID]bool, len(sorted))
+	for len(order) < len(sorted) {
+		progressed := false
+		for _, m := range sorted {
+			if done[m.ID] || indegree[m.ID] > 0 {
+				continue
+			}
+			done[m.ID] = true
+			order = append(order, m.ID)
+			for _, d := range dependents[m.ID] {
+				indegree[d]--
+			}
+			progressed = true
+			break
+		}
+		if !progressed {
+			return nil, util.ErrConflict("milestone dependencies contain a cycle")
+		}
+	}
+
+	return order, nil
+}
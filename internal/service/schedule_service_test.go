@@ -0,0 +1,212 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func TestMilestoneDurationDays(t *testing.T) {
+	hours := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name string
+		m    model.Milestone
+		want int
+	}{
+		{"done takes no time", model.Milestone{Status: model.StatusDone, EstimatedHours: hours(40)}, 0},
+		{"no estimate is one day", model.Milestone{Status: model.StatusPending}, 1},
+		{"zero estimate is one day", model.Milestone{Status: model.StatusPending, EstimatedHours: hours(0)}, 1},
+		{"exact days", model.Milestone{Status: model.StatusInProgress, EstimatedHours: hours(16)}, 2},
+		{"partial day rounds up", model.Milestone{Status: model.StatusPending, EstimatedHours: hours(17)}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := milestoneDurationDays(&tt.m, 8); got != tt.want {
+				t.Errorf("milestoneDurationDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopoSortMilestones(t *testing.T) {
+	a := model.Milestone{ID: uuid.New(), Name: "a", OrderIdx: 3000}
+	b := model.Milestone{ID: uuid.New(), Name: "b", OrderIdx: 1000}
+	c := model.Milestone{ID: uuid.New(), Name: "c", OrderIdx: 2000}
+
+	tests := []struct {
+		name    string
+		prereqs map[uuid.UUID][]uuid.UUID
+		want    []uuid.UUID
+		wantErr bool
+	}{
+		{"order idx without dependencies", nil, []uuid.UUID{b.ID, c.ID, a.ID}, false},
+		{"prerequisite first", map[uuid.UUID][]uuid.UUID{b.ID: {a.ID}}, []uuid.UUID{c.ID, a.ID, b.ID}, false},
+		{"chain", map[uuid.UUID][]uuid.UUID{b.ID: {c.ID}, c.ID: {a.ID}}, []uuid.UUID{a.ID, c.ID, b.ID}, false},
+		{"cycle", map[uuid.UUID][]uuid.UUID{a.ID: {b.ID}, b.ID: {a.ID}}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := topoSortMilestones([]model.Milestone{a, b, c}, tt.prereqs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	due := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := &model.Milestone{ID: uuid.New(), Status: model.StatusPending}
+	b := &model.Milestone{ID: uuid.New(), Status: model.StatusPending}
+	c := &model.Milestone{ID: uuid.New(), Status: model.StatusPending}
+	bDue := &model.Milestone{ID: b.ID, Status: model.StatusPending, DueDate: &due}
+	aDone := &model.Milestone{ID: a.ID, Status: model.StatusDone}
+
+	// b bergantung pada a; c berdiri sendiri
+	order := []uuid.UUID{a.ID, b.ID, c.ID}
+	prereqs := map[uuid.UUID][]uuid.UUID{b.ID: {a.ID}}
+	successors := map[uuid.UUID][]uuid.UUID{a.ID: {b.ID}}
+	intPtr := func(v int) *int { return &v }
+	dueOffset := func(time.Time) int { return 4 }
+
+	type times struct{ es, ef, ls, lf int }
+	tests := []struct {
+		name         string
+		milestones   []*model.Milestone
+		duration     map[uuid.UUID]int
+		deadline     *int
+		wantFinish   int
+		wantDeadline int
+		want         map[uuid.UUID]times
+	}{
+		{
+			name:         "no deadline uses projected finish",
+			milestones:   []*model.Milestone{a, b, c},
+			duration:     map[uuid.UUID]int{a.ID: 2, b.ID: 3, c.ID: 1},
+			wantFinish:   5,
+			wantDeadline: 5,
+			want: map[uuid.UUID]times{
+				a.ID: {0, 2, 0, 2},
+				b.ID: {2, 5, 2, 5},
+				c.ID: {0, 1, 4, 5},
+			},
+		},
+		{
+			name:         "deadline adds slack",
+			milestones:   []*model.Milestone{a, b, c},
+			duration:     map[uuid.UUID]int{a.ID: 2, b.ID: 3, c.ID: 1},
+			deadline:     intPtr(7),
+			wantFinish:   5,
+			wantDeadline: 7,
+			want: map[uuid.UUID]times{
+				a.ID: {0, 2, 2, 4},
+				b.ID: {2, 5, 4, 7},
+				c.ID: {0, 1, 6, 7},
+			},
+		},
+		{
+			name:         "due date before deadline gives negative slack upstream",
+			milestones:   []*model.Milestone{a, bDue, c},
+			duration:     map[uuid.UUID]int{a.ID: 2, b.ID: 3, c.ID: 1},
+			deadline:     intPtr(7),
+			wantFinish:   5,
+			wantDeadline: 7,
+			want: map[uuid.UUID]times{
+				a.ID: {0, 2, -1, 1},
+				b.ID: {2, 5, 1, 4},
+			},
+		},
+		{
+			name:         "done prerequisite does not delay successor",
+			milestones:   []*model.Milestone{aDone, b, c},
+			duration:     map[uuid.UUID]int{a.ID: 0, b.ID: 3, c.ID: 1},
+			wantFinish:   3,
+			wantDeadline: 3,
+			want: map[uuid.UUID]times{
+				b.ID: {0, 3, 0, 3},
+				c.ID: {0, 1, 2, 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byID := make(map[uuid.UUID]*model.Milestone, len(tt.milestones))
+			for _, m := range tt.milestones {
+				byID[m.ID] = m
+			}
+
+			got := criticalPath(order, byID, prereqs, successors, tt.duration, tt.deadline, dueOffset)
+			if got.finish != tt.wantFinish || got.deadline != tt.wantDeadline {
+				t.Errorf("finish/deadline = %d/%d, want %d/%d", got.finish, got.deadline, tt.wantFinish, tt.wantDeadline)
+			}
+			for id, w := range tt.want {
+				g := times{got.es[id], got.ef[id], got.ls[id], got.lf[id]}
+				if g != w {
+					t.Errorf("milestone %s: es/ef/ls/lf = %v, want %v", id, g, w)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkdays(t *testing.T) {
+	// Senin 1 Jan 2024; Rabu 3 Jan libur, Jumat 5 Jan cuti
+	origin := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cal := &WorkingCalendar{
+		Calendar: model.WorkCalendar{WorkDays: model.DefaultWorkDays, DailyHours: model.DefaultDailyHours},
+		Location: time.UTC,
+		holidays: map[string]string{"2024-01-03": "libur"},
+		timeOff:  map[string]bool{"2024-01-05": true},
+	}
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	dateTests := []struct {
+		index int
+		want  time.Time
+	}{
+		{0, day(1)},
+		{1, day(2)},
+		{2, day(4)},
+		{3, day(8)},
+		{-2, time.Date(2023, 12, 30, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range dateTests {
+		w := &workdays{cal: cal, origin: origin}
+		if got := w.date(tt.index); !got.Equal(tt.want) {
+			t.Errorf("date(%d) = %s, want %s", tt.index, got.Format(dateLayout), tt.want.Format(dateLayout))
+		}
+	}
+
+	offsetTests := []struct {
+		t    time.Time
+		want int
+	}{
+		{day(1), 1},
+		{day(3), 2},
+		{day(7), 3},
+		{day(8), 4},
+		{time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range offsetTests {
+		w := &workdays{cal: cal, origin: origin}
+		if got := w.offset(tt.t); got != tt.want {
+			t.Errorf("offset(%s) = %d, want %d", tt.t.Format(dateLayout), got, tt.want)
+		}
+	}
+
+	w := &workdays{cal: cal, origin: origin}
+	if got := w.finishDate(3); !got.Equal(day(5)) {
+		t.Errorf("finishDate(3) = %s, want 2024-01-05", got.Format(dateLayout))
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	searchDefaultLimit = 20
+	searchMaxLimit     = 50
+	searchMaxTerms     = 8
+)
+
+type SearchService struct {
+	repo repository.SearchRepository
+}
+
+func NewSearchService(repo repository.SearchRepository) *SearchService {
+	return &SearchService{repo: repo}
+}
+
+type SearchOptions struct {
+	ProjectID *uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	Kinds     []repository.SearchKind
+	Limit     int
+}
+
+// Search mencari log, milestone dan project milik user dan project yang ia supervisi. Setiap kata diperlakukan sebagai
+// prefix ("auth" cocok dengan "authentication") dan semua kata harus muncul.
+func (s *SearchService) Search(ctx context.Context, userID uuid.UUID, text string, opts SearchOptions) ([]repository.SearchHit, error) {
+	tsQuery := buildPrefixQuery(text)
+	if tsQuery == "" {
+		return nil, util.ErrBadRequest("q must contain at least one word")
+	}
+
+	for _, kind := range opts.Kinds {
+		switch kind {
+		case repository.SearchLog, repository.SearchMilestone, repository.SearchProject:
+		default:
+			return nil, util.ErrBadRequest("type must be log, milestone or project")
+		}
+	}
+
+	if opts.From != nil && opts.To != nil && !opts.From.Before(*opts.To) {
+		return nil, util.ErrBadRequest("from must be before to")
+	}
+
+	limit := opts.Limit
+	switch {
+	case limit <= 0:
+		limit = searchDefaultLimit
+	case limit > searchMaxLimit:
+		limit = searchMaxLimit
+	}
+
+	return s.repo.Search(ctx, repository.SearchQuery{
+		UserID:    userID,
+		TSQuery:   tsQuery,
+		ProjectID: opts.ProjectID,
+		From:      opts.From,
+		To:        opts.To,
+		Kinds:     opts.Kinds,
+		Limit:     limit,
+	})
+}
+
+// buildPrefixQuery mengubah input bebas menjadi tsquery "kata1:* & kata2:*". Hanya huruf dan
+// angka yang dipertahankan sehingga operator tsquery dari user tidak bisa menyebabkan syntax error.
+func buildPrefixQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > searchMaxTerms {
+		words = words[:searchMaxTerms]
+	}
+
+	terms := make([]string, len(words))
+	for i, w := range words {
+		terms[i] = w + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildPrefixQuery(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"auth", "auth:*"},
+		{"  Login Page ", "login:* & page:*"},
+		{"fix: bug #42", "fix:* & bug:* & 42:*"},
+		{"a & b | !c:*", "a:* & b:* & c:*"},
+		{"perbaikan-API", "perbaikan:* & api:*"},
+		{"café über", "café:* & über:*"},
+		{"!!! ---", ""},
+		{"one two three four five six seven eight nine ten", "one:* & two:* & three:* & four:* & five:* & six:* & seven:* & eight:*"},
+	}
+
+	for _, tt := range tests {
+		if got := buildPrefixQuery(tt.text); got != tt.want {
+			t.Errorf("buildPrefixQuery(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+type recordingSearchRepo struct {
+	repository.SearchRepository
+	query repository.SearchQuery
+}
+
+func (r *recordingSearchRepo) Search(_ context.Context, q repository.SearchQuery) ([]repository.SearchHit, error) {
+	r.query = q
+	return nil, nil
+}
+
+func TestSearchOptions(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name      string
+		text      string
+		opts      SearchOptions
+		status    int
+		wantLimit int
+	}{
+		{"default limit", "deploy", SearchOptions{}, http.StatusOK, searchDefaultLimit},
+		{"limit is capped", "deploy", SearchOptions{Limit: 500}, http.StatusOK, searchMaxLimit},
+		{"explicit limit", "deploy", SearchOptions{Limit: 5, Kinds: []repository.SearchKind{repository.SearchLog}}, http.StatusOK, 5},
+		{"no words", "?!", SearchOptions{}, http.StatusBadRequest, 0},
+		{"unknown kind", "deploy", SearchOptions{Kinds: []repository.SearchKind{"invoice"}}, http.StatusBadRequest, 0},
+		{"from after to", "deploy", SearchOptions{From: &to, To: &from}, http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		repo := &recordingSearchRepo{}
+		_, err := NewSearchService(repo).Search(context.Background(), uuid.New(), tt.text, tt.opts)
+		if got := errStatus(err); got != tt.status {
+			t.Errorf("%s: Search() status = %d, want %d (err %v)", tt.name, got, tt.status, err)
+			continue
+		}
+		if tt.status == http.StatusOK && (repo.query.Limit != tt.wantLimit || repo.query.TSQuery != "deploy:*") {
+			t.Errorf("%s: query = %+v, want limit %d", tt.name, repo.query, tt.wantLimit)
+		}
+	}
+}
@@ -0,0 +1,497 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+type SprintService struct {
+	repo          repository.SprintRepository
+	projectRepo   repository.ProjectRepository
+	milestoneRepo repository.MilestoneRepository
+	taskRepo      repository.TaskRepository
+	logRepo       repository.LogRepository
+	access        *AccessChecker
+	guard         projectGuard
+}
+
+// SprintReportItem adalah satu milestone/task di sprint beserta status penyelesaiannya.
+type SprintReportItem struct {
+	Item           model.SprintItem
+	Title          string
+	Done           bool
+	Committed      bool // sudah ada di sprint sebelum sprint dimulai
+	EstimatedHours float64
+	LoggedHours    float64
+}
+
+type SprintReport struct {
+	Sprint              model.Sprint
+	Items               []SprintReportItem
+	CommittedCount      int
+	CompletedCount      int
+	AddedMidSprint      int
+	CarriedIn           int
+	CarriedOver         int
+	CommittedHours      float64
+	CompletedHours      float64
+	HoursLoggedInSprint float64
+}
+
+func NewSprintService(repo repository.SprintRepository, projectRepo repository.ProjectRepository, milestoneRepo repository.MilestoneRepository, taskRepo repository.TaskRepository, logRepo repository.LogRepository, access *AccessChecker) *SprintService {
+	return &SprintService{
+		repo:          repo,
+		projectRepo:   projectRepo,
+		milestoneRepo: milestoneRepo,
+		taskRepo:      taskRepo,
+		logRepo:       logRepo,
+		access:        access,
+		guard:         projectGuard{projectRepo},
+	}
+}
+
+func (s *SprintService) CreateSprint(ctx context.Context, userID, projectID uuid.UUID, name, goal string, start, end time.Time) (*model.Sprint, error) {
+	if name == "" {
+		return nil, util.ErrBadRequest("name is required")
+	}
+	if !end.After(start) {
+		return nil, util.ErrBadRequest("end date must be after start date")
+	}
+
+	project, err := s.access.CanEdit(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Archived() {
+		return nil, errProjectArchived
+	}
+
+	if err := s.ensureNoOverlap(ctx, projectID, uuid.Nil, start, end); err != nil {
+		return nil, err
+	}
+
+	sprint := &model.Sprint{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: start,
+		EndDate:   end,
+		Status:    model.SprintPlanned,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repo.Create(ctx, sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+func (s *SprintService) GetSprintsByProject(ctx context.Context, userID, projectID uuid.UUID) ([]model.Sprint, error) {
+	if projectID == uuid.Nil {
+		return nil, util.ErrBadRequest("project ID is required")
+	}
+	if _, _, err := s.access.CanView(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByProject(ctx, projectID)
+}
+
+func (s *SprintService) GetSprintByID(ctx context.Context, userID, id uuid.UUID) (*model.Sprint, error) {
+	sprint, err := s.findSprint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := s.access.CanView(ctx, userID, sprint.ProjectID); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+func (s *SprintService) UpdateSprint(ctx context.Context, userID uuid.UUID, sprint *model.Sprint) error {
+	if sprint.Name == "" {
+		return util.ErrBadRequest("name is required")
+	}
+	if !sprint.EndDate.After(sprint.StartDate) {
+		return util.ErrBadRequest("end date must be after start date")
+	}
+
+	// project dan status diambil dari data tersimpan, bukan dari input
+	orig, err := s.editableSprint(ctx, userID, sprint.ID)
+	if err != nil {
+		return err
+	}
+	if orig.Status == model.SprintCompleted {
+		return util.ErrConflict("completed sprints cannot be changed")
+	}
+	sprint.ProjectID = orig.ProjectID
+	sprint.Status = orig.Status
+
+	if err := s.ensureNoOverlap(ctx, sprint.ProjectID, sprint.ID, sprint.StartDate, sprint.EndDate); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, sprint)
+}
+
+func (s *SprintService) DeleteSprint(ctx context.Context, userID, id uuid.UUID) error {
+	sprint, err := s.editableSprint(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if sprint.Status == model.SprintCompleted {
+		return util.ErrConflict("completed sprints cannot be deleted")
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// StartSprint mengaktifkan sprint; hanya boleh ada satu sprint aktif per project.
+func (s *SprintService) StartSprint(ctx context.Context, userID, id uuid.UUID) (*model.Sprint, error) {
+	sprint, err := s.editableSprint(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.Status != model.SprintPlanned {
+		return nil, util.ErrConflict("only planned sprints can be started")
+	}
+
+	sprints, err := s.repo.FindByProject(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range sprints {
+		if other.Status == model.SprintActive {
+			return nil, util.ErrConflict(fmt.Sprintf("sprint %q is still active", other.Name))
+		}
+	}
+
+	sprint.Status = model.SprintActive
+	if err := s.repo.Update(ctx, sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// AssignItems memasukkan milestone/task milik project ke sprint.
+func (s *SprintService) AssignItems(ctx context.Context, userID, sprintID uuid.UUID, milestoneIDs, taskIDs []uuid.UUID) error {
+	sprint, err := s.editableSprint(ctx, userID, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.Status == model.SprintCompleted {
+		return util.ErrConflict("cannot add items to a completed sprint")
+	}
+
+	milestones, tasks, err := s.projectItems(ctx, sprint.ProjectID)
+	if err != nil {
+		return err
+	}
+	for _, id := range milestoneIDs {
+		if _, ok := milestones[id]; !ok {
+			return util.ErrBadRequest(fmt.Sprintf("milestone %s does not belong to the project", id))
+		}
+	}
+	for _, id := range taskIDs {
+		if _, ok := tasks[id]; !ok {
+			return util.ErrBadRequest(fmt.Sprintf("task %s does not belong to the project", id))
+		}
+	}
+
+	open, err := s.repo.FindOpenItems(ctx, milestoneIDs, taskIDs)
+	if err != nil {
+		return err
+	}
+	if len(open) > 0 {
+		return util.ErrConflict("some items are already planned in an open sprint")
+	}
+
+	now := time.Now()
+	var items []model.SprintItem
+	for _, id := range milestoneIDs {
+		id := id
+		items = append(items, model.SprintItem{ID: uuid.New(), SprintID: sprintID, MilestoneID: &id, AddedAt: now})
+	}
+	for _, id := range taskIDs {
+		id := id
+		items = append(items, model.SprintItem{ID: uuid.New(), SprintID: sprintID, TaskID: &id, AddedAt: now})
+	}
+
+	return s.repo.AddItems(ctx, items)
+}
+
+func (s *SprintService) UnassignItems(ctx context.Context, userID, sprintID uuid.UUID, milestoneIDs, taskIDs []uuid.UUID) error {
+	sprint, err := s.editableSprint(ctx, userID, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.Status == model.SprintCompleted {
+		return util.ErrConflict("cannot remove items from a completed sprint")
+	}
+	return s.repo.DeleteOpenItems(ctx, sprintID, milestoneIDs, taskIDs)
+}
+
+// CompleteSprint menutup sprint. Item yang belum selesai dipindah ke nextSprintID,
+// atau ke sprint planned berikutnya; jika tidak ada, item kembali ke backlog.
+func (s *SprintService) CompleteSprint(ctx context.Context, userID, sprintID uuid.UUID, nextSprintID *uuid.UUID) (*SprintReport, error) {
+	sprint, err := s.editableSprint(ctx, userID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.Status == model.SprintCompleted {
+		return nil, util.ErrConflict("sprint is already completed")
+	}
+
+	next, err := s.nextSprint(ctx, sprint, nextSprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.FindItems(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, tasks, err := s.projectItems(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var closed, carried []model.SprintItem
+	for _, item := range items {
+		if item.Outcome != model.OutcomeOpen {
+			continue
+		}
+
+		item.ClosedAt = &now
+		switch {
+		case itemDone(item, milestones, tasks):
+			item.Outcome = model.OutcomeCompleted
+		case next != nil:
+			item.Outcome = model.OutcomeCarriedOver
+			carried = append(carried, model.SprintItem{
+				ID:            uuid.New(),
+				SprintID:      next.ID,
+				MilestoneID:   item.MilestoneID,
+				TaskID:        item.TaskID,
+				CarriedFromID: &sprint.ID,
+				AddedAt:       now,
+			})
+		default:
+			item.Outcome = model.OutcomeReturned
+		}
+		closed = append(closed, item)
+	}
+
+	sprint.Status = model.SprintCompleted
+	sprint.CompletedAt = &now
+
+	if err := s.repo.Complete(ctx, sprint, closed, carried); err != nil {
+		return nil, err
+	}
+
+	return s.sprintReport(ctx, sprint)
+}
+
+// GetSprintReport membandingkan pekerjaan yang di-commit dengan yang selesai,
+// plus jam log di dalam rentang sprint berdasarkan LoggedAt.
+func (s *SprintService) GetSprintReport(ctx context.Context, userID, sprintID uuid.UUID) (*SprintReport, error) {
+	sprint, err := s.GetSprintByID(ctx, userID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return s.sprintReport(ctx, sprint)
+}
+
+func (s *SprintService) sprintReport(ctx context.Context, sprint *model.Sprint) (*SprintReport, error) {
+	items, err := s.repo.FindItems(ctx, sprint.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, tasks, err := s.projectItems(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := s.logRepo.FindByProject(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	hoursByMilestone := make(map[uuid.UUID]float64)
+	hoursByTask := make(map[uuid.UUID]float64)
+	report := &SprintReport{Sprint: *sprint, Items: []SprintReportItem{}}
+
+	for _, l := range logs {
+		if l.LoggedAt.Before(sprint.StartDate) || l.LoggedAt.After(sprint.EndDate) {
+			continue
+		}
+		hours := float64(l.DurationMinutes) / 60.0
+		report.HoursLoggedInSprint += hours
+		if l.MilestoneID != nil {
+			hoursByMilestone[*l.MilestoneID] += hours
+		}
+		if l.TaskID != nil {
+			hoursByTask[*l.TaskID] += hours
+		}
+	}
+
+	for _, item := range items {
+		entry := SprintReportItem{
+			Item:      item,
+			Done:      item.Outcome == model.OutcomeCompleted || (item.Outcome == model.OutcomeOpen && itemDone(item, milestones, tasks)),
+			Committed: !item.AddedAt.After(sprint.StartDate) || item.CarriedFromID != nil,
+		}
+
+		switch {
+		case item.MilestoneID != nil:
+			if m, ok := milestones[*item.MilestoneID]; ok {
+				entry.Title = m.Name
+				entry.EstimatedHours = milestoneEstimateHours(&m)
+			}
+			entry.LoggedHours = hoursByMilestone[*item.MilestoneID]
+		case item.TaskID != nil:
+			if t, ok := tasks[*item.TaskID]; ok {
+				entry.Title = t.Title
+			}
+			entry.LoggedHours = hoursByTask[*item.TaskID]
+		}
+
+		if entry.Committed {
+			report.CommittedCount++
+			report.CommittedHours += entry.EstimatedHours
+		} else {
+			report.AddedMidSprint++
+		}
+		if entry.Done {
+			report.CompletedCount++
+			report.CompletedHours += entry.EstimatedHours
+		}
+		if item.CarriedFromID != nil {
+			report.CarriedIn++
+		}
+		if item.Outcome == model.OutcomeCarriedOver {
+			report.CarriedOver++
+		}
+
+		report.Items = append(report.Items, entry)
+	}
+
+	return report, nil
+}
+
+func (s *SprintService) findSprint(ctx context.Context, id uuid.UUID) (*model.Sprint, error) {
+	sprint, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sprint == nil {
+		return nil, util.ErrNotFound("sprint not found")
+	}
+	return sprint, nil
+}
+
+// editableSprint memuat sprint dan memastikan userID adalah pemilik project-nya
+// dan project tersebut tidak diarsipkan.
+func (s *SprintService) editableSprint(ctx context.Context, userID, id uuid.UUID) (*model.Sprint, error) {
+	sprint, err := s.findSprint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.access.CanEdit(ctx, userID, sprint.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := s.guard.ensureWritable(ctx, sprint.ProjectID); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+func (s *SprintService) ensureNoOverlap(ctx context.Context, projectID, sprintID uuid.UUID, start, end time.Time) error {
+	sprints, err := s.repo.FindByProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, other := range sprints {
+		if other.ID == sprintID || other.Status == model.SprintCompleted {
+			continue
+		}
+		if start.Before(other.EndDate) && other.StartDate.Before(end) {
+			return util.ErrConflict(fmt.Sprintf("sprint overlaps with %q", other.Name))
+		}
+	}
+	return nil
+}
+
+func (s *SprintService) nextSprint(ctx context.Context, sprint *model.Sprint, nextSprintID *uuid.UUID) (*model.Sprint, error) {
+	if nextSprintID != nil {
+		next, err := s.findSprint(ctx, *nextSprintID)
+		if err != nil {
+			return nil, err
+		}
+		if next.ProjectID != sprint.ProjectID || next.ID == sprint.ID || next.Status == model.SprintCompleted {
+			return nil, util.ErrBadRequest("next sprint must be another open sprint of the same project")
+		}
+		return next, nil
+	}
+
+	sprints, err := s.repo.FindByProject(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sprints {
+		if sprints[i].Status == model.SprintPlanned && sprints[i].ID != sprint.ID && !sprints[i].StartDate.Before(sprint.StartDate) {
+			return &sprints[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *SprintService) projectItems(ctx context.Context, projectID uuid.UUID) (map[uuid.UUID]model.Milestone, map[uuid.UUID]model.Task, error) {
+	milestones, err := s.milestoneRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := s.taskRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ms := make(map[uuid.UUID]model.Milestone, len(milestones))
+	for _, m := range milestones {
+		ms[m.ID] = m
+	}
+	ts := make(map[uuid.UUID]model.Task, len(tasks))
+	for _, t := range tasks {
+		ts[t.ID] = t
+	}
+	return ms, ts, nil
+}
+
+func itemDone(item model.SprintItem, milestones map[uuid.UUID]model.Milestone, tasks map[uuid.UUID]model.Task) bool {
+	if item.MilestoneID != nil {
+		m, ok := milestones[*item.MilestoneID]
+		return ok && m.Status == model.StatusDone
+	}
+	if item.TaskID != nil {
+		t, ok := tasks[*item.TaskID]
+		return ok && t.Done
+	}
+	return false
+}
+
+// milestoneEstimateHours hanya menghitung estimasi jam eksplisit untuk laporan sprint.
+func milestoneEstimateHours(m *model.Milestone) float64 {
+	if m.EstimatedHours == nil {
+		return 0
+	}
+	return *m.EstimatedHours
+}
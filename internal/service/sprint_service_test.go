@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type memorySprintRepo struct {
+	repository.SprintRepository
+	sprints []model.Sprint
+	items   map[uuid.UUID][]model.SprintItem
+}
+
+func (r *memorySprintRepo) FindByID(_ context.Context, id uuid.UUID) (*model.Sprint, error) {
+	for i := range r.sprints {
+		if r.sprints[i].ID == id {
+			sp := r.sprints[i]
+			return &sp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memorySprintRepo) FindByProject(context.Context, uuid.UUID) ([]model.Sprint, error) {
+	return append([]model.Sprint(nil), r.sprints...), nil
+}
+
+func (r *memorySprintRepo) FindItems(_ context.Context, sprintID uuid.UUID) ([]model.SprintItem, error) {
+	return append([]model.SprintItem(nil), r.items[sprintID]...), nil
+}
+
+func (r *memorySprintRepo) Complete(_ context.Context, s *model.Sprint, closed, carried []model.SprintItem) error {
+	for i := range r.sprints {
+		if r.sprints[i].ID == s.ID {
+			r.sprints[i] = *s
+		}
+	}
+	items := r.items[s.ID]
+	for _, c := range closed {
+		for i := range items {
+			if items[i].ID == c.ID {
+				items[i] = c
+			}
+		}
+	}
+	for _, c := range carried {
+		r.items[c.SprintID] = append(r.items[c.SprintID], c)
+	}
+	return nil
+}
+
+type sprintMilestoneRepo struct {
+	repository.MilestoneRepository
+	milestones []model.Milestone
+}
+
+func (r sprintMilestoneRepo) FindByProject(context.Context, uuid.UUID) ([]model.Milestone, error) {
+	return r.milestones, nil
+}
+
+type sprintTaskRepo struct {
+	repository.TaskRepository
+	tasks []model.Task
+}
+
+func (r sprintTaskRepo) FindByProject(context.Context, uuid.UUID) ([]model.Task, error) {
+	return r.tasks, nil
+}
+
+type sprintLogRepo struct{ repository.LogRepository }
+
+func (sprintLogRepo) FindByProject(context.Context, uuid.UUID) ([]model.Log, error) {
+	return nil, nil
+}
+
+func TestCompleteSprintCarryOver(t *testing.T) {
+	ownerID := uuid.New()
+	project := &model.Project{ID: uuid.New(), UserID: ownerID}
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	done := model.Milestone{ID: uuid.New(), ProjectID: project.ID, Name: "done", Status: model.StatusDone}
+	open := model.Milestone{ID: uuid.New(), ProjectID: project.ID, Name: "open", Status: model.StatusInProgress}
+	openTask := model.Task{ID: uuid.New(), MilestoneID: open.ID, Title: "open task"}
+	earlier := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		withNext    bool
+		openOutcome model.SprintItemOutcome
+		carriedOver int
+	}{
+		{"carries unfinished items to the next planned sprint", true, model.OutcomeCarriedOver, 2},
+		{"returns unfinished items to backlog without a next sprint", false, model.OutcomeReturned, 0},
+	}
+
+	for _, tt := range tests {
+		current := model.Sprint{ID: uuid.New(), ProjectID: project.ID, Name: "Sprint 1", StartDate: start, EndDate: start.AddDate(0, 0, 14), Status: model.SprintActive}
+		next := model.Sprint{ID: uuid.New(), ProjectID: project.ID, Name: "Sprint 2", StartDate: start.AddDate(0, 0, 14), EndDate: start.AddDate(0, 0, 28), Status: model.SprintPlanned}
+		sprints := []model.Sprint{current}
+		if tt.withNext {
+			sprints = append(sprints, next)
+		}
+
+		items := []model.SprintItem{
+			{ID: uuid.New(), SprintID: current.ID, MilestoneID: &done.ID, AddedAt: start},
+			{ID: uuid.New(), SprintID: current.ID, MilestoneID: &open.ID, AddedAt: start},
+			{ID: uuid.New(), SprintID: current.ID, TaskID: &openTask.ID, AddedAt: start.AddDate(0, 0, 2)},
+			// item yang sudah ditutup sebelumnya tidak disentuh lagi
+			{ID: uuid.New(), SprintID: current.ID, MilestoneID: &open.ID, Outcome: model.OutcomeReturned, ClosedAt: &earlier},
+		}
+		repo := &memorySprintRepo{sprints: sprints, items: map[uuid.UUID][]model.SprintItem{current.ID: items}}
+		projects := singleProjectRepo{project: project}
+		svc := NewSprintService(repo, projects, sprintMilestoneRepo{milestones: []model.Milestone{done, open}},
+			sprintTaskRepo{tasks: []model.Task{openTask}}, sprintLogRepo{}, NewAccessChecker(projects, &memorySupervisorRepo{}))
+
+		report, err := svc.CompleteSprint(context.Background(), ownerID, current.ID, nil)
+		if err != nil {
+			t.Fatalf("%s: CompleteSprint() err = %v", tt.name, err)
+		}
+
+		closed := repo.items[current.ID]
+		wantOutcomes := []model.SprintItemOutcome{model.OutcomeCompleted, tt.openOutcome, tt.openOutcome, model.OutcomeReturned}
+		for i, item := range closed {
+			if item.Outcome != wantOutcomes[i] {
+				t.Errorf("%s: item %d outcome = %q, want %q", tt.name, i, item.Outcome, wantOutcomes[i])
+			}
+		}
+		if !closed[3].ClosedAt.Equal(earlier) {
+			t.Errorf("%s: previously closed item ClosedAt changed to %v", tt.name, closed[3].ClosedAt)
+		}
+
+		carried := repo.items[next.ID]
+		if len(carried) != tt.carriedOver {
+			t.Fatalf("%s: next sprint has %d items, want %d", tt.name, len(carried), tt.carriedOver)
+		}
+		for _, item := range carried {
+			if item.CarriedFromID == nil || *item.CarriedFromID != current.ID || item.Outcome != model.OutcomeOpen {
+				t.Errorf("%s: carried item = %+v, want open item carried from %s", tt.name, item, current.ID)
+			}
+		}
+		if tt.withNext && (carried[0].MilestoneID == nil || *carried[0].MilestoneID != open.ID || carried[1].TaskID == nil || *carried[1].TaskID != openTask.ID) {
+			t.Errorf("%s: carried items = %+v, want open milestone then open task", tt.name, carried)
+		}
+
+		if report.Sprint.Status != model.SprintCompleted || report.Sprint.CompletedAt == nil {
+			t.Errorf("%s: sprint status = %q, CompletedAt = %v", tt.name, report.Sprint.Status, report.Sprint.CompletedAt)
+		}
+		if report.CarriedOver != tt.carriedOver || report.CompletedCount != 1 {
+			t.Errorf("%s: report CarriedOver = %d, CompletedCount = %d, want %d, 1", tt.name, report.CarriedOver, report.CompletedCount, tt.carriedOver)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"devtracker/internal/realtime"
+	"devtracker/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// StreamService menghubungkan koneksi SSE ke hub realtime dan memastikan user hanya
+// menerima event project yang boleh dilihatnya (pemilik atau supervisor aktif).
+type StreamService struct {
+	hub            *realtime.Hub
+	projectRepo    repository.ProjectRepository
+	supervisorRepo repository.SupervisorRepository
+	access         *AccessChecker
+}
+
+func NewStreamService(hub *realtime.Hub, projectRepo repository.ProjectRepository, supervisorRepo repository.SupervisorRepository, access *AccessChecker) *StreamService {
+	return &StreamService{
+		hub:            hub,
+		projectRepo:    projectRepo,
+		supervisorRepo: supervisorRepo,
+		access:         access,
+	}
+}
+
+// Open membuka stream satu project (projectID diisi) atau semua project user (projectID nil).
+// Lihat realtime.Hub.Listen untuk arti replay dan complete.
+func (s *StreamService) Open(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID, lastEventID string) (*realtime.Subscription, []realtime.Message, bool, error) {
+	filter, err := s.scope(ctx, userID, projectID)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	sub, replay, complete := s.hub.Listen(filter, lastEventID)
+	return sub, replay, complete, nil
+}
+
+// Refresh menghitung ulang cakupan stream yang sedang terbuka, sehingga project baru ikut
+// masuk dan akses supervisor yang dicabut berhenti menerima event. Error berarti stream
+// harus ditutup.
+func (s *StreamService) Refresh(ctx context.Context, sub *realtime.Subscription, userID uuid.UUID, projectID *uuid.UUID) error {
+	filter, err := s.scope(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+	s.hub.SetFilter(sub, filter)
+	return nil
+}
+
+func (s *StreamService) Close(sub *realtime.Subscription) {
+	s.hub.Unsubscribe(sub)
+}
+
+func (s *StreamService) scope(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID) (realtime.Filter, error) {
+	if projectID != nil {
+		if _, _, err := s.access.CanView(ctx, userID, *projectID); err != nil {
+			return nil, err
+		}
+		id := *projectID
+		return func(m realtime.Message) bool { return m.ProjectID == id }, nil
+	}
+
+	owned, err := s.projectRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	supervised, err := s.supervisorRepo.FindSupervisedProjects(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[uuid.UUID]bool, len(owned)+len(supervised))
+	for _, p := range owned {
+		ids[p.ID] = true
+	}
+	for _, p := range supervised {
+		ids[p.ID] = true
+	}
+	return func(m realtime.Message) bool { return ids[m.ProjectID] }, nil
+}
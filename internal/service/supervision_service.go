@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// SupervisionService mengelola undangan supervisor (mentor/dosen) dan ringkasan cohort
+// project yang mereka supervisi.
+type SupervisionService struct {
+	repo          repository.SupervisorRepository
+	projectRepo   repository.ProjectRepository
+	userRepo      repository.UserRepository
+	milestoneRepo repository.MilestoneRepository
+	insightRepo   repository.AIInsightRepository
+	access        *AccessChecker
+	publisher     events.Publisher
+}
+
+func NewSupervisionService(repo repository.SupervisorRepository, projectRepo repository.ProjectRepository, userRepo repository.UserRepository, milestoneRepo repository.MilestoneRepository, insightRepo repository.AIInsightRepository, access *AccessChecker, publisher events.Publisher) *SupervisionService {
+	return &SupervisionService{
+		repo:          repo,
+		projectRepo:   projectRepo,
+		userRepo:      userRepo,
+		milestoneRepo: milestoneRepo,
+		insightRepo:   insightRepo,
+		access:        access,
+		publisher:     publisher,
+	}
+}
+
+// CohortProject adalah satu baris di overview supervisor.
+type CohortProject struct {
+	Project             model.Project
+	OwnerName           string
+	OwnerEmail          string
+	LatestInsight       *model.AIInsight
+	MilestonesTotal     int
+	MilestonesCompleted int
+}
+
+// Invite mengundang email sebagai supervisor project. Email yang pernah menolak atau dicabut
+// aksesnya bisa diundang lagi.
+func (s *SupervisionService) Invite(ctx context.Context, ownerID, projectID uuid.UUID, email string) (*model.ProjectSupervisor, error) {
+	project, err := s.ownedProject(ctx, ownerID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return nil, util.ErrBadRequest("invalid email")
+	}
+	email = strings.ToLower(addr.Address)
+
+	owner, err := s.userRepo.FindByID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if owner != nil && strings.EqualFold(owner.Email, email) {
+		return nil, util.ErrBadRequest("you cannot invite yourself as supervisor")
+	}
+
+	existing, err := s.repo.FindByProjectAndEmail(ctx, project.ID, email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		switch existing.Status {
+		case model.SupervisorPending:
+			return nil, util.ErrConflict("invitation already pending")
+		case model.SupervisorActive:
+			return nil, util.ErrConflict("user is already a supervisor of this project")
+		}
+
+		existing.Status = model.SupervisorPending
+		existing.SupervisorID = nil
+		existing.InvitedBy = ownerID
+		existing.CreatedAt = time.Now()
+		existing.RespondedAt = nil
+		if err := s.repo.Update(ctx, existing); err != nil {
+			return nil, err
+		}
+		s.publish(events.SupervisorInvited, ownerID, existing)
+		return existing, nil
+	}
+
+	inv := &model.ProjectSupervisor{
+		ID:        uuid.New(),
+		ProjectID: project.ID,
+		Email:     email,
+		InvitedBy: ownerID,
+		Status:    model.SupervisorPending,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.Create(ctx, inv); err != nil {
+		if util.IsUniqueViolation(err) {
+			return nil, util.ErrConflict("invitation already exists")
+		}
+		return nil, err
+	}
+	s.publish(events.SupervisorInvited, ownerID, inv)
+	return inv, nil
+}
+
+// ListSupervisors mengembalikan semua undangan dan supervisor project (khusus pemilik).
+func (s *SupervisionService) ListSupervisors(ctx context.Context, ownerID, projectID uuid.UUID) ([]model.ProjectSupervisor, error) {
+	if _, err := s.ownedProject(ctx, ownerID, projectID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByProject(ctx, projectID)
+}
+
+// Revoke mencabut undangan atau akses supervisor. Pemilik project bisa mencabut siapa pun;
+// supervisor bisa mengundurkan diri.
+func (s *SupervisionService) Revoke(ctx context.Context, userID, projectID, id uuid.UUID) error {
+	sup, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sup == nil || sup.ProjectID != projectID {
+		return util.ErrNotFound("supervisor not found")
+	}
+
+	self := sup.SupervisorID != nil && *sup.SupervisorID == userID
+	if !self {
+		if _, err := s.ownedProject(ctx, userID, projectID); err != nil {
+			return err
+		}
+	}
+
+	if sup.Status == model.SupervisorRevoked {
+		return nil
+	}
+	now := time.Now()
+	sup.Status = model.SupervisorRevoked
+	sup.RespondedAt = &now
+	return s.repo.Update(ctx, sup)
+}
+
+// PendingInvites mengembalikan undangan untuk email user yang belum dijawab.
+func (s *SupervisionService) PendingInvites(ctx context.Context, userID uuid.UUID) ([]model.ProjectSupervisor, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+	return s.repo.FindPendingByEmail(ctx, strings.ToLower(user.Email))
+}
+
+// RespondInvite menerima atau menolak undangan milik email user.
+func (s *SupervisionService) RespondInvite(ctx context.Context, userID, id uuid.UUID, accept bool) (*model.ProjectSupervisor, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+
+	inv, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil || !strings.EqualFold(inv.Email, user.Email) {
+		return nil, util.ErrNotFound("invitation not found")
+	}
+	if inv.Status != model.SupervisorPending {
+		return nil, util.ErrConflict("invitation is " + string(inv.Status))
+	}
+
+	now := time.Now()
+	inv.RespondedAt = &now
+	event := events.SupervisorDeclined
+	if accept {
+		inv.Status = model.SupervisorActive
+		inv.SupervisorID = &userID
+		event = events.SupervisorAccepted
+	} else {
+		inv.Status = model.SupervisorDeclined
+	}
+
+	if err := s.repo.Update(ctx, inv); err != nil {
+		return nil, err
+	}
+	s.publish(event, userID, inv)
+	return inv, nil
+}
+
+// ViewProject mengembalikan project jika user pemilik atau supervisor aktif.
+func (s *SupervisionService) ViewProject(ctx context.Context, userID, projectID uuid.UUID) (*model.Project, ProjectRole, error) {
+	return s.access.CanView(ctx, userID, projectID)
+}
+
+// Cohort merangkum semua project yang disupervisi user beserta status insight terbarunya.
+func (s *SupervisionService) Cohort(ctx context.Context, supervisorID uuid.UUID) ([]CohortProject, error) {
+	projects, err := s.repo.FindSupervisedProjects(ctx, supervisorID)
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return []CohortProject{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(projects))
+	for i := range projects {
+		ids[i] = projects[i].ID
+	}
+	latest, err := s.insightRepo.FindLatestByProjects(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	insights := make(map[uuid.UUID]*model.AIInsight, len(latest))
+	for i := range latest {
+		insights[latest[i].ProjectID] = &latest[i]
+	}
+
+	counts, err := s.milestoneRepo.CountByProjects(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	countByProject := make(map[uuid.UUID]repository.MilestoneCount, len(counts))
+	for _, c := range counts {
+		countByProject[c.ProjectID] = c
+	}
+
+	ownerIDs := make([]uuid.UUID, 0, len(projects))
+	seen := make(map[uuid.UUID]bool, len(projects))
+	for _, p := range projects {
+		if !seen[p.UserID] {
+			seen[p.UserID] = true
+			ownerIDs = append(ownerIDs, p.UserID)
+		}
+	}
+	users, err := s.userRepo.FindByIDs(ctx, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[uuid.UUID]*model.User, len(users))
+	for i := range users {
+		owners[users[i].ID] = &users[i]
+	}
+
+	res := make([]CohortProject, 0, len(projects))
+	for _, p := range projects {
+		count := countByProject[p.ID]
+		item := CohortProject{
+			Project:             p,
+			LatestInsight:       insights[p.ID],
+			MilestonesTotal:     count.Total,
+			MilestonesCompleted: count.Done,
+		}
+		if owner, ok := owners[p.UserID]; ok {
+			item.OwnerName = owner.Name
+			item.OwnerEmail = owner.Email
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+func (s *SupervisionService) ownedProject(ctx context.Context, ownerID, projectID uuid.UUID) (*model.Project, error) {
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, util.ErrNotFound("project not found")
+	}
+	if project.UserID != ownerID {
+		return nil, util.ErrUnauthorized("only the project owner can manage supervisors")
+	}
+	return project, nil
+}
+
+func (s *SupervisionService) publish(t events.Type, userID uuid.UUID, inv *model.ProjectSupervisor) {
+	s.publisher.Publish(events.Event{
+		Type:      t,
+		UserID:    userID,
+		ProjectID: inv.ProjectID,
+		EntityID:  inv.ID,
+	})
+}
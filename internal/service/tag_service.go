@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	tagMaxLength = 50
+	tagMaxPerLog = 20
+)
+
+// hashtagPattern: '#' harus di awal teks atau setelah karakter non-kata supaya
+// fragment URL seperti "page#section" tidak ikut terambil.
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&/])#([\p{L}\p{N}_][\p{L}\p{N}_-]*)`)
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type TagService struct {
+	repo repository.TagRepository
+}
+
+func NewTagService(repo repository.TagRepository) *TagService {
+	return &TagService{repo: repo}
+}
+
+func (s *TagService) ListTags(ctx context.Context, userID uuid.UUID) ([]repository.TagUsage, error) {
+	return s.repo.FindByUser(ctx, userID)
+}
+
+func (s *TagService) CreateTag(ctx context.Context, userID uuid.UUID, name, color string) (*model.Tag, error) {
+	normalized, ok := normalizeTagName(name)
+	if !ok {
+		return nil, errInvalidTagName
+	}
+	if color != "" && !tagColorPattern.MatchString(color) {
+		return nil, util.ErrBadRequest("color must be a hex value like #22c55e")
+	}
+
+	tag := &model.Tag{ID: uuid.New(), UserID: userID, Name: normalized, Color: color}
+	if err := s.repo.Create(ctx, tag); err != nil {
+		if util.IsUniqueViolation(err) {
+			return nil, util.ErrConflict("tag already exists")
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+// UpdateTag mengganti nama dan/atau warna. Rename berlaku untuk semua log yang memakai tag ini.
+func (s *TagService) UpdateTag(ctx context.Context, userID, id uuid.UUID, name, color *string) (*model.Tag, error) {
+	tag, err := s.findOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		normalized, ok := normalizeTagName(*name)
+		if !ok {
+			return nil, errInvalidTagName
+		}
+		tag.Name = normalized
+	}
+	if color != nil {
+		if *color != "" && !tagColorPattern.MatchString(*color) {
+			return nil, util.ErrBadRequest("color must be a hex value like #22c55e")
+		}
+		tag.Color = *color
+	}
+
+	if err := s.repo.Update(ctx, tag); err != nil {
+		if util.IsUniqueViolation(err) {
+			return nil, util.ErrConflict("tag already exists")
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *TagService) DeleteTag(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.findOwned(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *TagService) findOwned(ctx context.Context, userID, id uuid.UUID) (*model.Tag, error) {
+	tag, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil || tag.UserID != userID {
+		return nil, util.ErrNotFound("tag not found")
+	}
+	return tag, nil
+}
+
+var errInvalidTagName = util.ErrBadRequest("tag name must be 1-50 letters, digits, '_' or '-'")
+
+// normalizeTagName membuang '#' di depan dan mengubah ke lowercase.
+func normalizeTagName(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "#"))
+	if name == "" || len([]rune(name)) > tagMaxLength {
+		return "", false
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+// extractHashtags mengambil #hashtag dari deskripsi log (sudah dinormalisasi).
+func extractHashtags(description string) []string {
+	var tags []string
+	for _, m := range hashtagPattern.FindAllStringSubmatch(description, -1) {
+		if name, ok := normalizeTagName(m[1]); ok {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// logTagNames menggabungkan tag eksplisit dengan hashtag dari deskripsi, tanpa duplikat.
+func logTagNames(explicit []string, description string) ([]string, error) {
+	seen := make(map[string]bool)
+	var names []string
+	for _, t := range explicit {
+		name, ok := normalizeTagName(t)
+		if !ok {
+			return nil, errInvalidTagName
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, name := range extractHashtags(description) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	if len(names) > tagMaxPerLog {
+		return nil, util.ErrBadRequest("a log can have at most 20 tags")
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// duplicateTagRepo mengembalikan error persis seperti driver GORM postgres saat nama tag bentrok.
+type duplicateTagRepo struct {
+	repository.TagRepository
+}
+
+func (duplicateTagRepo) Create(context.Context, *model.Tag) error {
+	return &pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "uniq_user_tag_name"}
+}
+
+func TestCreateTagDuplicateIsConflict(t *testing.T) {
+	svc := NewTagService(duplicateTagRepo{})
+
+	_, err := svc.CreateTag(context.Background(), uuid.New(), "backend", "")
+	var appErr *util.AppError
+	if !errors.As(err, &appErr) || appErr.Status != http.StatusConflict {
+		t.Fatalf("CreateTag() err = %v, want 409 conflict", err)
+	}
+}
+
+func TestExtractHashtags(t *testing.T) {
+	tests := []struct {
+		description string
+		want        []string
+	}{
+		{"#backend fix login", []string{"backend"}},
+		{"review #Frontend, #bug-fix dan #api_v2.", []string{"frontend", "bug-fix", "api_v2"}},
+		{"#a #b", []string{"a", "b"}},
+		{"(#rapat)", []string{"rapat"}},
+		{"#perbaikan-ui selesai #日本", []string{"perbaikan-ui", "日本"}},
+		{"lihat https://example.com/page#section dan docs/#intro", nil},
+		{"R&D #1 isu, Q&A#faq", []string{"1"}},
+		{"user#admin email@x#y", nil},
+		{"# kosong dan #-dash", nil},
+		{"#" + strings.Repeat("x", tagMaxLength+1), nil},
+	}
+
+	for _, tt := range tests {
+		if got := extractHashtags(tt.description); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("extractHashtags(%q) = %q, want %q", tt.description, got, tt.want)
+		}
+	}
+}
+
+func TestLogTagNames(t *testing.T) {
+	many := make([]string, tagMaxPerLog)
+	for i := range many {
+		many[i] = "t" + strings.Repeat("x", i)
+	}
+
+	tests := []struct {
+		name        string
+		explicit    []string
+		description string
+		want        []string
+		status      int
+	}{
+		{"merged, deduplicated and sorted", []string{"#Backend", "api"}, "fix #backend #deploy", []string{"api", "backend", "deploy"}, http.StatusOK},
+		{"description only", nil, "#rapat mingguan", []string{"rapat"}, http.StatusOK},
+		{"no tags", nil, "tanpa tag", nil, http.StatusOK},
+		{"invalid explicit tag", []string{"bad tag"}, "", nil, http.StatusBadRequest},
+		{"too many tags", many, "#extra", nil, http.StatusBadRequest},
+		{"limit counts unique tags", append(many, many[0]), "#" + many[1], many, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		got, err := logTagNames(tt.explicit, tt.description)
+		if status := errStatus(err); status != tt.status {
+			t.Errorf("%s: logTagNames() status = %d, want %d (err %v)", tt.name, status, tt.status, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: logTagNames() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+type TaskService struct {
+	repo          repository.TaskRepository
+	milestoneRepo repository.MilestoneRepository
+	access        *AccessChecker
+	guard         projectGuard
+}
+
+// TaskSummary meringkas checklist milestone. SuggestComplete bernilai true
+// ketika semua task sudah selesai tetapi milestone belum berstatus done.
+type TaskSummary struct {
+	Total           int
+	Done            int
+	SuggestComplete bool
+}
+
+func (s TaskSummary) ProgressPercent() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Done) / float64(s.Total) * 100
+}
+
+func NewTaskService(repo repository.TaskRepository, milestoneRepo repository.MilestoneRepository, projectRepo repository.ProjectRepository, access *AccessChecker) *TaskService {
+	return &TaskService{
+		repo:          repo,
+		milestoneRepo: milestoneRepo,
+		access:        access,
+		guard:         projectGuard{projectRepo},
+	}
+}
+
+func (s *TaskService) CreateTask(ctx context.Context, userID, milestoneID uuid.UUID, title string, assigneeID *uuid.UUID, orderIdx int) (*model.Task, error) {
+	if title == "" {
+		return nil, util.ErrBadRequest("title is required")
+	}
+
+	if err := s.ensureMilestoneWritable(ctx, userID, milestoneID); err != nil {
+		return nil, err
+	}
+
+	if orderIdx == 0 {
+		tasks, err := s.repo.FindByMilestone(ctx, milestoneID)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tasks {
+			if t.OrderIdx > orderIdx {
+				orderIdx = t.OrderIdx
+			}
+		}
+		orderIdx += milestoneRankGap
+	}
+
+	task := &model.Task{
+		ID:          uuid.New(),
+		MilestoneID: milestoneID,
+		Title:       title,
+		AssigneeID:  assigneeID,
+		OrderIdx:    orderIdx,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.repo.Create(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (s *TaskService) GetTasksByMilestone(ctx context.Context, userID, milestoneID uuid.UUID) ([]model.Task, *TaskSummary, error) {
+	milestone, err := s.viewableMilestone(ctx, userID, milestoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tasks, err := s.repo.FindByMilestone(ctx, milestoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tasks, summarizeTasks(milestone, tasks), nil
+}
+
+// GetTask memastikan task memang milik milestone pada URL.
+func (s *TaskService) GetTask(ctx context.Context, userID, milestoneID, taskID uuid.UUID) (*model.Task, error) {
+	if _, err := s.viewableMilestone(ctx, userID, milestoneID); err != nil {
+		return nil, err
+	}
+	return s.findTask(ctx, milestoneID, taskID)
+}
+
+func (s *TaskService) findTask(ctx context.Context, milestoneID, taskID uuid.UUID) (*model.Task, error) {
+	task, err := s.repo.FindByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil || task.MilestoneID != milestoneID {
+		return nil, util.ErrNotFound("task not found")
+	}
+	return task, nil
+}
+
+// UpdateTask menyimpan perubahan task dan mengembalikan ringkasan checklist terbaru,
+// termasuk saran untuk menyelesaikan milestone bila semua task sudah ditutup.
+func (s *TaskService) UpdateTask(ctx context.Context, userID uuid.UUID, t *model.Task) (*TaskSummary, error) {
+	if t.Title == "" {
+		return nil, util.ErrBadRequest("title is required")
+	}
+
+	if err := s.ensureMilestoneWritable(ctx, userID, t.MilestoneID); err != nil {
+		return nil, err
+	}
+
+	orig, err := s.findTask(ctx, t.MilestoneID, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case t.Done && !orig.Done:
+		now := time.Now()
+		t.CompletedAt = &now
+	case !t.Done:
+		t.CompletedAt = nil
+	default:
+		t.CompletedAt = orig.CompletedAt
+	}
+
+	if err := s.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	milestone, err := s.findMilestone(ctx, t.MilestoneID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.repo.FindByMilestone(ctx, t.MilestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return summarizeTasks(milestone, tasks), nil
+}
+
+func (s *TaskService) DeleteTask(ctx context.Context, userID, milestoneID, taskID uuid.UUID) error {
+	if err := s.ensureMilestoneWritable(ctx, userID, milestoneID); err != nil {
+		return err
+	}
+	if _, err := s.findTask(ctx, milestoneID, taskID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, taskID)
+}
+
+func (s *TaskService) findMilestone(ctx context.Context, milestoneID uuid.UUID) (*model.Milestone, error) {
+	milestone, err := s.milestoneRepo.FindByID(ctx, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if milestone == nil {
+		return nil, util.ErrNotFound("milestone not found")
+	}
+	return milestone, nil
+}
+
+// viewableMilestone memuat milestone yang project-nya bisa dilihat userID.
+func (s *TaskService) viewableMilestone(ctx context.Context, userID, milestoneID uuid.UUID) (*model.Milestone, error) {
+	milestone, err := s.findMilestone(ctx, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := s.access.CanView(ctx, userID, milestone.ProjectID); err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+// ensureMilestoneWritable memastikan userID pemilik project milestone dan project belum diarsipkan.
+func (s *TaskService) ensureMilestoneWritable(ctx context.Context, userID, milestoneID uuid.UUID) error {
+	milestone, err := s.findMilestone(ctx, milestoneID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.access.CanEdit(ctx, userID, milestone.ProjectID); err != nil {
+		return err
+	}
+	return s.guard.ensureWritable(ctx, milestone.ProjectID)
+}
+
+func summarizeTasks(milestone *model.Milestone, tasks []model.Task) *TaskSummary {
+	summary := &TaskSummary{Total: len(tasks)}
+	for _, t := range tasks {
+		if t.Done {
+			summary.Done++
+		}
+	}
+	summary.SuggestComplete = summary.Total > 0 &&
+		summary.Done == summary.Total &&
+		milestone.Status != model.StatusDone
+	return summary
+}
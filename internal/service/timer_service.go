@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/events"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+type TimerService struct {
+	repo       repository.TimerRepository
+	logService *LogService
+	uow        repository.UnitOfWork
+	publisher  events.Publisher
+}
+
+func NewTimerService(repo repository.TimerRepository, logService *LogService, uow repository.UnitOfWork, publisher events.Publisher) *TimerService {
+	return &TimerService{
+		repo:       repo,
+		logService: logService,
+		uow:        uow,
+		publisher:  publisher,
+	}
+}
+
+func (s *TimerService) StartTimer(ctx context.Context, userID, projectID uuid.UUID, milestoneID, taskID *uuid.UUID, description string) (*model.Timer, error) {
+	if projectID == uuid.Nil {
+		return nil, util.ErrBadRequest("project ID is required")
+	}
+
+	if _, err := s.logService.access.CanEdit(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+	if err := s.logService.guard.ensureWritable(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	milestoneID, err := s.logService.resolveTaskMilestone(ctx, projectID, milestoneID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := s.repo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if running != nil {
+		return nil, util.ErrConflict("a timer is already running")
+	}
+
+	timer := &model.Timer{
+		ID:          uuid.New(),
+		UserID:      userID,
+		ProjectID:   projectID,
+		MilestoneID: milestoneID,
+		TaskID:      taskID,
+		Description: description,
+		StartedAt:   time.Now(),
+	}
+
+	if err := s.repo.Create(ctx, timer); err != nil {
+		if util.IsUniqueViolation(err) {
+			return nil, util.ErrConflict("a timer is already running")
+		}
+		return nil, err
+	}
+
+	s.publisher.Publish(events.Event{Type: events.TimerStarted, UserID: userID, ProjectID: projectID, EntityID: timer.ID})
+	return timer, nil
+}
+
+func (s *TimerService) GetRunningTimer(ctx context.Context, userID uuid.UUID) (*model.Timer, error) {
+	return s.repo.FindByUser(ctx, userID)
+}
+
+// StopTimer menghentikan timer dan menyimpannya sebagai log (dibulatkan ke atas, minimal 1 menit).
+func (s *TimerService) StopTimer(ctx context.Context, userID uuid.UUID, description string) (*model.Log, error) {
+	timer, err := s.repo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if timer == nil {
+		return nil, util.ErrNotFound("no running timer")
+	}
+
+	if description == "" {
+		description = timer.Description
+	}
+
+	minutes := int(math.Ceil(time.Since(timer.StartedAt).Minutes()))
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	// log dan penghapusan timer harus atomik supaya durasi tidak tercatat dua kali
+	var log *model.Log
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		log, err = s.logService.createLog(ctx, timer.ProjectID, userID, timer.MilestoneID, timer.TaskID, description, minutes, timer.StartedAt, "", nil, false)
+		if err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, timer.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logService.publish(events.LogCreated, log)
+	s.publisher.Publish(events.Event{Type: events.TimerStopped, UserID: userID, ProjectID: timer.ProjectID, EntityID: timer.ID})
+	return log, nil
+}
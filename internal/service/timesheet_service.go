@@ -0,0 +1,451 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+// TimesheetService mengelola pengajuan dan persetujuan timesheet mingguan. Minggu dihitung
+// Senin-Minggu di timezone user pemilik log. Reviewer adalah pemilik project atau supervisor
+// aktif; user tidak bisa menyetujui timesheet-nya sendiri.
+type TimesheetService struct {
+	repo        repository.TimesheetRepository
+	logRepo     repository.LogRepository
+	projectRepo repository.ProjectRepository
+	userRepo    repository.UserRepository
+	access      *AccessChecker
+	uow         repository.UnitOfWork
+}
+
+func NewTimesheetService(repo repository.TimesheetRepository, logRepo repository.LogRepository, projectRepo repository.ProjectRepository, userRepo repository.UserRepository, access *AccessChecker, uow repository.UnitOfWork) *TimesheetService {
+	return &TimesheetService{
+		repo:        repo,
+		logRepo:     logRepo,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+		access:      access,
+		uow:         uow,
+	}
+}
+
+// TimesheetWeek adalah isi satu minggu beserta status pengajuannya (Timesheet nil = belum diajukan).
+type TimesheetWeek struct {
+	ProjectID    uuid.UUID
+	UserID       uuid.UUID
+	WeekStart    time.Time
+	Timesheet    *model.Timesheet
+	Logs         []model.Log
+	DailyMinutes [7]int // Senin..Minggu
+	TotalMinutes int
+	Comments     []model.TimesheetComment
+}
+
+// TimesheetInboxItem adalah timesheet yang menunggu keputusan reviewer.
+type TimesheetInboxItem struct {
+	Timesheet   model.Timesheet
+	UserName    string
+	ProjectName string
+}
+
+// GetWeek mengembalikan timesheet minggu yang memuat date untuk user di project.
+// Dapat dilihat oleh user itu sendiri dan reviewer project.
+func (s *TimesheetService) GetWeek(ctx context.Context, viewerID, projectID, userID uuid.UUID, date time.Time) (*TimesheetWeek, error) {
+	project, err := s.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if viewerID != userID {
+		ok, err := s.isReviewer(ctx, project, viewerID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, util.ErrUnauthorized("you do not have permission to view this timesheet")
+		}
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.loadWeek(ctx, projectID, user, date)
+}
+
+// GetTimesheet mengembalikan timesheet yang sudah diajukan berdasarkan ID.
+func (s *TimesheetService) GetTimesheet(ctx context.Context, viewerID, id uuid.UUID) (*TimesheetWeek, error) {
+	ts, err := s.findTimesheet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetWeek(ctx, viewerID, ts.ProjectID, ts.UserID, ts.WeekStart)
+}
+
+func (s *TimesheetService) ListMine(ctx context.Context, userID uuid.UUID) ([]model.Timesheet, error) {
+	return s.repo.FindByUser(ctx, userID)
+}
+
+// Submit mengajukan minggu yang memuat date. Minggu yang pernah ditolak bisa diajukan ulang.
+// Sejak diajukan, log di minggu itu terkunci (lihat timesheetGuard) sampai ditolak.
+func (s *TimesheetService) Submit(ctx context.Context, userID, projectID uuid.UUID, date time.Time, comment string) (*model.Timesheet, error) {
+	if _, err := s.access.CanEdit(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ts *model.Timesheet
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		// kunci yang sama dengan timesheetGuard: total dihitung dari log yang tidak bisa berubah lagi
+		if err := s.projectRepo.Lock(ctx, projectID); err != nil {
+			return err
+		}
+
+		week, err := s.loadWeek(ctx, projectID, user, date)
+		if err != nil {
+			return err
+		}
+
+		loc := user.Location()
+		if time.Date(week.WeekStart.Year(), week.WeekStart.Month(), week.WeekStart.Day(), 0, 0, 0, 0, loc).After(time.Now()) {
+			return util.ErrBadRequest("cannot submit a future week")
+		}
+		if len(week.Logs) == 0 {
+			return util.ErrBadRequest("no logs in this week")
+		}
+
+		ts = week.Timesheet
+		if ts != nil && ts.Status != model.TimesheetRejected {
+			return util.ErrConflict("timesheet for this week is already " + string(ts.Status))
+		}
+
+		if ts == nil {
+			ts = &model.Timesheet{
+				ID:        uuid.New(),
+				ProjectID: projectID,
+				UserID:    userID,
+				WeekStart: week.WeekStart,
+			}
+		}
+		ts.Status = model.TimesheetSubmitted
+		ts.LogCount = len(week.Logs)
+		ts.TotalMinutes = week.TotalMinutes
+		ts.SubmittedAt = time.Now()
+		ts.ReviewedBy = nil
+		ts.ReviewedAt = nil
+
+		if week.Timesheet == nil {
+			if err := s.repo.Create(ctx, ts); err != nil {
+				if util.IsUniqueViolation(err) {
+					return util.ErrConflict("timesheet for this week is already submitted")
+				}
+				return err
+			}
+		} else if err := s.repo.Update(ctx, ts); err != nil {
+			return err
+		}
+		return s.addComment(ctx, ts.ID, userID, model.TimesheetActionSubmit, comment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// Approve menyetujui timesheet; log di minggu tersebut tetap terkunci. Total dihitung ulang
+// sebagai pengaman, tetapi log sudah tidak bisa berubah sejak diajukan.
+func (s *TimesheetService) Approve(ctx context.Context, reviewerID, id uuid.UUID, comment string) (*model.Timesheet, error) {
+	return s.review(ctx, reviewerID, id, model.TimesheetApproved, comment)
+}
+
+// Reject mengembalikan timesheet ke user; alasan wajib diisi.
+func (s *TimesheetService) Reject(ctx context.Context, reviewerID, id uuid.UUID, comment string) (*model.Timesheet, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, util.ErrBadRequest("comment is required when rejecting a timesheet")
+	}
+	return s.review(ctx, reviewerID, id, model.TimesheetRejected, comment)
+}
+
+func (s *TimesheetService) review(ctx context.Context, reviewerID, id uuid.UUID, status model.TimesheetStatus, comment string) (*model.Timesheet, error) {
+	ts, err := s.findTimesheet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(ctx, ts.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if ts.UserID == reviewerID {
+		return nil, util.ErrUnauthorized("you cannot review your own timesheet")
+	}
+	ok, err := s.isReviewer(ctx, project, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, util.ErrUnauthorized("you do not have permission to review this timesheet")
+	}
+	if ts.Status != model.TimesheetSubmitted {
+		return nil, util.ErrConflict("timesheet is " + string(ts.Status) + ", expected submitted")
+	}
+
+	action := model.TimesheetActionReject
+	if status == model.TimesheetApproved {
+		action = model.TimesheetActionApprove
+
+		user, err := s.findUser(ctx, ts.UserID)
+		if err != nil {
+			return nil, err
+		}
+		week, err := s.loadWeek(ctx, ts.ProjectID, user, ts.WeekStart)
+		if err != nil {
+			return nil, err
+		}
+		ts.LogCount = len(week.Logs)
+		ts.TotalMinutes = week.TotalMinutes
+	}
+
+	now := time.Now()
+	ts.Status = status
+	ts.ReviewedBy = &reviewerID
+	ts.ReviewedAt = &now
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, ts); err != nil {
+			return err
+		}
+		return s.addComment(ctx, ts.ID, reviewerID, action, comment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// AddComment menambah komentar oleh pemilik timesheet atau reviewer.
+func (s *TimesheetService) AddComment(ctx context.Context, authorID, id uuid.UUID, body string) (*model.TimesheetComment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, util.ErrBadRequest("comment body is required")
+	}
+
+	ts, err := s.findTimesheet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(ctx, ts.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if authorID != ts.UserID {
+		ok, err := s.isReviewer(ctx, project, authorID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, util.ErrUnauthorized("you do not have permission to comment on this timesheet")
+		}
+	}
+
+	c := &model.TimesheetComment{
+		ID:          uuid.New(),
+		TimesheetID: id,
+		AuthorID:    authorID,
+		Action:      model.TimesheetActionComment,
+		Body:        body,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.repo.CreateComment(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Inbox mengembalikan timesheet di project yang bisa di-review user, default yang menunggu
+// keputusan (submitted), terlama dulu. Timesheet milik reviewer sendiri tidak ditampilkan.
+func (s *TimesheetService) Inbox(ctx context.Context, reviewerID uuid.UUID, status model.TimesheetStatus) ([]TimesheetInboxItem, error) {
+	switch status {
+	case "":
+		status = model.TimesheetSubmitted
+	case model.TimesheetSubmitted, model.TimesheetApproved, model.TimesheetRejected:
+	default:
+		return nil, util.ErrBadRequest("status must be submitted, approved or rejected")
+	}
+
+	projects, err := s.projectRepo.FindByUser(ctx, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	supervised, err := s.access.supervisorRepo.FindSupervisedProjects(ctx, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	projects = append(projects, supervised...)
+	names := make(map[uuid.UUID]string, len(projects))
+	ids := make([]uuid.UUID, 0, len(projects))
+	for _, p := range projects {
+		names[p.ID] = p.Name
+		ids = append(ids, p.ID)
+	}
+
+	sheets, err := s.repo.FindByProjects(ctx, ids, status)
+	if err != nil {
+		return nil, err
+	}
+
+	userNames := make(map[uuid.UUID]string)
+	items := make([]TimesheetInboxItem, 0, len(sheets))
+	for _, ts := range sheets {
+		if ts.UserID == reviewerID {
+			continue
+		}
+		name, ok := userNames[ts.UserID]
+		if !ok {
+			u, err := s.userRepo.FindByID(ctx, ts.UserID)
+			if err != nil {
+				return nil, err
+			}
+			if u != nil {
+				name = u.Name
+			}
+			userNames[ts.UserID] = name
+		}
+		items = append(items, TimesheetInboxItem{Timesheet: ts, UserName: name, ProjectName: names[ts.ProjectID]})
+	}
+	return items, nil
+}
+
+// loadWeek mengumpulkan log user di project untuk minggu yang memuat date (timezone user).
+func (s *TimesheetService) loadWeek(ctx context.Context, projectID uuid.UUID, user *model.User, date time.Time) (*TimesheetWeek, error) {
+	loc := user.Location()
+	from := startOfWeek(time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, loc), loc)
+	to := from.AddDate(0, 0, 7)
+
+	week := &TimesheetWeek{
+		ProjectID: projectID,
+		UserID:    user.ID,
+		WeekStart: calendarDate(from),
+	}
+
+	logs, err := s.logRepo.FindByUserBetween(ctx, user.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range logs {
+		if l.ProjectID != projectID {
+			continue
+		}
+		week.Logs = append(week.Logs, l)
+		week.TotalMinutes += l.DurationMinutes
+		day := (int(l.LoggedAt.In(loc).Weekday()) + 6) % 7
+		week.DailyMinutes[day] += l.DurationMinutes
+	}
+
+	week.Timesheet, err = s.repo.FindByWeek(ctx, projectID, user.ID, week.WeekStart)
+	if err != nil {
+		return nil, err
+	}
+	if week.Timesheet != nil {
+		week.Comments, err = s.repo.FindComments(ctx, week.Timesheet.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return week, nil
+}
+
+func (s *TimesheetService) addComment(ctx context.Context, timesheetID, authorID uuid.UUID, action model.TimesheetAction, body string) error {
+	return s.repo.CreateComment(ctx, &model.TimesheetComment{
+		ID:          uuid.New(),
+		TimesheetID: timesheetID,
+		AuthorID:    authorID,
+		Action:      action,
+		Body:        strings.TrimSpace(body),
+		CreatedAt:   time.Now(),
+	})
+}
+
+func (s *TimesheetService) isReviewer(ctx context.Context, project *model.Project, userID uuid.UUID) (bool, error) {
+	role, err := s.access.Role(ctx, userID, project)
+	return role != "", err
+}
+
+func (s *TimesheetService) findProject(ctx context.Context, id uuid.UUID) (*model.Project, error) {
+	project, err := s.projectRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, util.ErrNotFound("project not found")
+	}
+	return project, nil
+}
+
+func (s *TimesheetService) findUser(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	user, err := s.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, util.ErrNotFound("user not found")
+	}
+	return user, nil
+}
+
+func (s *TimesheetService) findTimesheet(ctx context.Context, id uuid.UUID) (*model.Timesheet, error) {
+	ts, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ts == nil {
+		return nil, util.ErrNotFound("timesheet not found")
+	}
+	return ts, nil
+}
+
+// timesheetGuard menolak perubahan log yang jatuh di minggu yang sudah diajukan (submitted)
+// atau disetujui (approved); minggu yang ditolak terbuka lagi untuk diperbaiki.
+type timesheetGuard struct {
+	projectRepo repository.ProjectRepository
+	repo        repository.TimesheetRepository
+	userRepo    repository.UserRepository
+}
+
+// ensureOpen harus dipanggil di dalam UnitOfWork yang sama dengan penulisan log. Baris
+// project dikunci supaya pengecekan ini dan Submit tidak bisa saling mendahului.
+func (g timesheetGuard) ensureOpen(ctx context.Context, projectID, userID uuid.UUID, loggedAt time.Time) error {
+	if err := g.projectRepo.Lock(ctx, projectID); err != nil {
+		return err
+	}
+
+	user, err := g.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	loc := time.UTC
+	if user != nil {
+		loc = user.Location()
+	}
+
+	ts, err := g.repo.FindByWeek(ctx, projectID, userID, calendarDate(startOfWeek(loggedAt, loc)))
+	if err != nil {
+		return err
+	}
+	return weekLockError(ts)
+}
+
+// weekLockError mengembalikan 409 jika timesheet minggu tersebut mengunci log-nya.
+func weekLockError(ts *model.Timesheet) error {
+	if ts == nil || ts.Status == model.TimesheetRejected {
+		return nil
+	}
+	return util.ErrConflict("timesheet for this week is " + string(ts.Status) + "; its logs are read-only")
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"devtracker/internal/domain/model"
+	"devtracker/internal/repository"
+	"devtracker/pkg/util"
+
+	"github.com/google/uuid"
+)
+
+func TestStartOfWeek(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{"monday stays", time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC), time.UTC, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"sunday goes back six days", time.Date(2025, 3, 16, 23, 59, 0, 0, time.UTC), time.UTC, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"wednesday", time.Date(2025, 3, 12, 8, 0, 0, 0, time.UTC), time.UTC, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"sunday UTC is monday in Jakarta", time.Date(2025, 3, 16, 18, 0, 0, 0, time.UTC), jakarta, time.Date(2025, 3, 17, 0, 0, 0, 0, jakarta)},
+		{"across month boundary", time.Date(2025, 4, 2, 10, 0, 0, 0, time.UTC), time.UTC, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := startOfWeek(tt.t, tt.loc); !got.Equal(tt.want) {
+			t.Errorf("%s: startOfWeek() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+type weekTimesheetRepo struct {
+	repository.TimesheetRepository
+	ts        *model.Timesheet
+	weekStart time.Time
+}
+
+func (r *weekTimesheetRepo) FindByWeek(_ context.Context, _, _ uuid.UUID, weekStart time.Time) (*model.Timesheet, error) {
+	r.weekStart = weekStart
+	return r.ts, nil
+}
+
+type lockingProjectRepo struct {
+	repository.ProjectRepository
+	locked []uuid.UUID
+}
+
+func (r *lockingProjectRepo) Lock(_ context.Context, id uuid.UUID) error {
+	r.locked = append(r.locked, id)
+	return nil
+}
+
+type timezoneUserRepo struct {
+	repository.UserRepository
+	timezone string
+}
+
+func (r timezoneUserRepo) FindByID(_ context.Context, id uuid.UUID) (*model.User, error) {
+	return &model.User{ID: id, Timezone: r.timezone}, nil
+}
+
+func TestTimesheetGuardEnsureOpen(t *testing.T) {
+	loggedAt := time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		ts       *model.Timesheet
+		conflict bool
+	}{
+		{"no timesheet", nil, false},
+		{"submitted", &model.Timesheet{Status: model.TimesheetSubmitted}, true},
+		{"approved", &model.Timesheet{Status: model.TimesheetApproved}, true},
+		{"rejected reopens the week", &model.Timesheet{Status: model.TimesheetRejected}, false},
+	}
+
+	for _, tt := range tests {
+		projectID := uuid.New()
+		projects := &lockingProjectRepo{}
+		timesheets := &weekTimesheetRepo{ts: tt.ts}
+		g := timesheetGuard{projectRepo: projects, repo: timesheets, userRepo: timezoneUserRepo{timezone: "UTC"}}
+
+		err := g.ensureOpen(context.Background(), projectID, uuid.New(), loggedAt)
+		var appErr *util.AppError
+		if tt.conflict {
+			if !errors.As(err, &appErr) || appErr.Status != http.StatusConflict {
+				t.Errorf("%s: ensureOpen() err = %v, want 409 conflict", tt.name, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s: ensureOpen() err = %v, want nil", tt.name, err)
+		}
+
+		if len(projects.locked) != 1 || projects.locked[0] != projectID {
+			t.Errorf("%s: project lock = %v, want [%s]", tt.name, projects.locked, projectID)
+		}
+		if want := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC); !timesheets.weekStart.Equal(want) {
+			t.Errorf("%s: FindByWeek weekStart = %v, want %v", tt.name, timesheets.weekStart, want)
+		}
+	}
+}
@@ -43,6 +43,7 @@ func (s *UserService) Register(ctx context.Context, name, email, password string
         Name:         name,
         Email:        email,
         PasswordHash: string(hashedPassword),
+        Timezone:     model.DefaultTimezone,
         CreatedAt:    time.Now(),
         UpdatedAt:    time.Now(),
     }
@@ -101,7 +102,7 @@ func (s *UserService) GetCurrentUser(ctx context.Context, id uuid.UUID) (*model.
 }
 
 // UpdateProfile - Update user profile
-func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, name string) (*model.User, error) {
+func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, name string, timezone *string) (*model.User, error) {
     // 1. Get existing user
     user, err := s.repo.FindByID(ctx, userID)
     if err != nil {
@@ -112,7 +113,16 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, name
     }
 
     // 2. Update fields
-    user.Name = name
+    // nama kosong berarti hanya field lain yang diubah
+    if name != "" {
+        user.Name = name
+    }
+    if timezone != nil {
+        if _, err := time.LoadLocation(*timezone); err != nil || *timezone == "" {
+            return nil, util.ErrBadRequest("invalid timezone")
+        }
+        user.Timezone = *timezone
+    }
     user.UpdatedAt = time.Now()
 
     // 3. Save
@@ -24,10 +24,12 @@ func NewConnection() *gorm.DB{
 	user := getEnv("DB_USER", "postgres")
 	password := getEnv("DB_PASSWORD", "tracker123")
 	dbname := getEnv("DB_NAME", "tracker_db")
+	// timestamp disimpan sebagai timestamptz; bucketing per hari dilakukan di aplikasi dengan timezone user
+	timezone := getEnv("DB_TIMEZONE", "UTC")
 
 	 dsn := fmt.Sprintf(
-        "host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Jakarta",
-        host, user, password, dbname, port,
+        "host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=%s",
+        host, user, password, dbname, port, timezone,
     )
 
     log.Printf("Connecting to database: host=%s port=%s dbname=%s user=%s", host, port, dbname, user)
@@ -35,7 +37,6 @@ func NewConnection() *gorm.DB{
     db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
         Logger: logger.Default.LogMode(logger.Info),
     })
-    fmt.Println("isi dari db:", db)
 
     if err != nil {
         log.Fatalf("Failed to connect to database: %v", err)
@@ -56,8 +57,34 @@ func NewConnection() *gorm.DB{
     err = db.AutoMigrate(
         &model.User{},
         &model.Project{},
+        &model.ProjectRevision{},
         &model.Milestone{},
+        &model.MilestoneStatusHistory{},
+        &model.MilestoneDependency{},
+        &model.BoardColumnLimit{},
+        &model.Task{},
+        &model.Sprint{},
+        &model.SprintItem{},
+        &model.ScopeChange{},
         &model.Log{},
+        &model.Tag{},
+        &model.LogTag{},
+        &model.Timer{},
+        &model.Goal{},
+        &model.WorkCalendar{},
+        &model.Holiday{},
+        &model.TimeOff{},
+        &model.Client{},
+        &model.HourlyRate{},
+        &model.Invoice{},
+        &model.InvoiceLine{},
+        &model.Timesheet{},
+        &model.TimesheetComment{},
+        &model.ProjectSupervisor{},
+        &model.Comment{},
+        &model.CommentMention{},
+        &model.Notification{},
+        &model.NotificationPreference{},
         &model.AIInsight{},
         &model.Report{},
     )
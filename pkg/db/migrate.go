@@ -0,0 +1,91 @@
+package db
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// schemaMigration mencatat file migrasi SQL yang sudah dijalankan.
+type schemaMigration struct {
+	Version   string `gorm:"primaryKey;size:255"`
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// RunMigrations menjalankan file *.sql di dir yang belum tercatat di schema_migrations,
+// berurutan berdasarkan nama file, masing-masing dalam satu transaksi. Dijalankan setelah
+// AutoMigrate karena migrasi SQL (mis. foreign key) butuh tabelnya sudah ada.
+func RunMigrations(db *gorm.DB, dir string) error {
+	done, err := appliedMigrations(db)
+	if err != nil {
+		return err
+	}
+
+	files := os.DirFS(dir)
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		version := strings.TrimSuffix(name, ".sql")
+		if done[version] {
+			continue
+		}
+
+		script, err := fs.ReadFile(files, name)
+		if err != nil {
+			return err
+		}
+
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(string(script)).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: version, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		log.Printf("Applied migration %s", version)
+	}
+
+	return nil
+}
+
+// MarkMigrationApplied mencatat migrasi sebagai sudah dijalankan tanpa mengeksekusinya,
+// untuk database yang migrasinya pernah dijalankan manual.
+func MarkMigrationApplied(db *gorm.DB, version string) error {
+	if _, err := appliedMigrations(db); err != nil {
+		return err
+	}
+	return db.Create(&schemaMigration{Version: version, AppliedAt: time.Now()}).Error
+}
+
+func appliedMigrations(db *gorm.DB) (map[string]bool, error) {
+	if err := db.AutoMigrate(&schemaMigration{}); err != nil {
+		return nil, err
+	}
+
+	var applied []string
+	if err := db.Model(&schemaMigration{}).Pluck("version", &applied).Error; err != nil {
+		return nil, err
+	}
+
+	done := make(map[string]bool, len(applied))
+	for _, v := range applied {
+		done[v] = true
+	}
+	return done, nil
+}
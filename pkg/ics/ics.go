@@ -0,0 +1,181 @@
+// Package ics membaca VEVENT dari file iCalendar (RFC 5545) secukupnya untuk
+// daftar hari libur: UID, SUMMARY, DTSTART dan DTEND.
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	// End eksklusif. Untuk event tanpa DTEND bernilai Start + 1 hari.
+	End    time.Time
+	AllDay bool
+}
+
+// Days mengembalikan setiap tanggal yang dicakup event (tanggal kalender, UTC).
+func (e Event) Days() []time.Time {
+	start := dateOf(e.Start)
+	end := dateOf(e.End)
+	if !e.AllDay && (e.End.Hour() != 0 || e.End.Minute() != 0 || e.End.Second() != 0) {
+		// event dengan jam yang selesai di tengah hari tetap mencakup hari terakhirnya
+		end = end.AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		end = start.AddDate(0, 0, 1)
+	}
+
+	var days []time.Time
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// Parse membaca semua VEVENT. Komponen lain (VTIMEZONE, VALARM, ...) diabaikan.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []Event
+		current *Event
+		hasEnd  bool
+		depth   int
+	)
+
+	for i, line := range lines {
+		name, params, value, ok := splitLine(line)
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case "BEGIN":
+			if value == "VEVENT" {
+				current = &Event{}
+				hasEnd = false
+				depth = 0
+			} else if current != nil {
+				depth++
+			}
+			continue
+		case "END":
+			if value == "VEVENT" && current != nil {
+				if current.Start.IsZero() {
+					return nil, fmt.Errorf("ics: line %d: VEVENT without DTSTART", i+1)
+				}
+				if !hasEnd {
+					current.End = current.Start.AddDate(0, 0, 1)
+				}
+				events = append(events, *current)
+				current = nil
+			} else if current != nil && depth > 0 {
+				depth--
+			}
+			continue
+		}
+
+		// properti di dalam sub-komponen (mis. VALARM) bukan milik event
+		if current == nil || depth > 0 {
+			continue
+		}
+
+		switch name {
+		case "UID":
+			current.UID = value
+		case "SUMMARY":
+			current.Summary = unescape(value)
+		case "DTSTART":
+			t, allDay, err := parseTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("ics: line %d: %w", i+1, err)
+			}
+			current.Start = t
+			current.AllDay = allDay
+		case "DTEND":
+			t, _, err := parseTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("ics: line %d: %w", i+1, err)
+			}
+			current.End = t
+			hasEnd = true
+		}
+	}
+
+	return events, nil
+}
+
+// unfold menggabungkan baris lanjutan (diawali spasi/tab) sesuai RFC 5545 3.1.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func splitLine(line string) (name string, params map[string]string, value string, ok bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	head := strings.Split(line[:colon], ";")
+	params = make(map[string]string, len(head)-1)
+	for _, p := range head[1:] {
+		if eq := strings.Index(p, "="); eq > 0 {
+			params[strings.ToUpper(p[:eq])] = strings.Trim(p[eq+1:], `"`)
+		}
+	}
+	return strings.ToUpper(head[0]), params, line[colon+1:], true
+}
+
+func parseTime(value string, params map[string]string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.Parse("20060102", value)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func unescape(s string) string {
+	r := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return r.Replace(s)
+}
@@ -0,0 +1,127 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []Event
+	}{
+		{
+			name: "all-day event without DTEND",
+			input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:nyepi\r\nSUMMARY:Hari Suci Nyepi\r\n" +
+				"DTSTART;VALUE=DATE:20250329\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			want: []Event{{UID: "nyepi", Summary: "Hari Suci Nyepi", Start: date(2025, 3, 29), End: date(2025, 3, 30), AllDay: true}},
+		},
+		{
+			name: "folded and escaped summary",
+			input: "BEGIN:VEVENT\nUID:lebaran\nSUMMARY:Idul Fitri\\, cuti\n  bersama\\nhari 1\n" +
+				"DTSTART:20250331\nDTEND:20250402\nEND:VEVENT\n",
+			want: []Event{{UID: "lebaran", Summary: "Idul Fitri, cuti bersama\nhari 1", Start: date(2025, 3, 31), End: date(2025, 4, 2), AllDay: true}},
+		},
+		{
+			name: "timed event in UTC and TZID",
+			input: "BEGIN:VEVENT\nUID:a\nDTSTART:20250101T090000Z\nDTEND:20250101T100000Z\nEND:VEVENT\n" +
+				"BEGIN:VEVENT\nUID:b\nDTSTART;TZID=Asia/Jakarta:20250102T090000\nDTEND;TZID=\"Asia/Jakarta\":20250102T170000\nEND:VEVENT\n",
+			want: []Event{
+				{UID: "a", Start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC), End: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)},
+				{UID: "b", Start: time.Date(2025, 1, 2, 9, 0, 0, 0, jakarta), End: time.Date(2025, 1, 2, 17, 0, 0, 0, jakarta)},
+			},
+		},
+		{
+			name: "alarm properties are ignored",
+			input: "BEGIN:VEVENT\nUID:x\nSUMMARY:Event\nDTSTART:20250105\n" +
+				"BEGIN:VALARM\nSUMMARY:Alarm\nDTSTART:20250101\nEND:VALARM\nEND:VEVENT\n",
+			want: []Event{{UID: "x", Summary: "Event", Start: date(2025, 1, 5), End: date(2025, 1, 6), AllDay: true}},
+		},
+		{
+			name:  "no events",
+			input: "BEGIN:VCALENDAR\nBEGIN:VTIMEZONE\nTZID:Asia/Jakarta\nEND:VTIMEZONE\nEND:VCALENDAR\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: Parse() err = %v", tt.name, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: Parse() returned %d events, want %d", tt.name, len(got), len(tt.want))
+			continue
+		}
+		for i, e := range got {
+			w := tt.want[i]
+			if e.UID != w.UID || e.Summary != w.Summary || !e.Start.Equal(w.Start) || !e.End.Equal(w.End) || e.AllDay != w.AllDay {
+				t.Errorf("%s: event %d = %+v, want %+v", tt.name, i, e, w)
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"missing DTSTART", "BEGIN:VEVENT\nUID:x\nEND:VEVENT\n", "line 3: VEVENT without DTSTART"},
+		{"invalid date", "BEGIN:VEVENT\nDTSTART:2025-01-01\nEND:VEVENT\n", "line 2"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: Parse() err = %v, want containing %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestEventDays(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+		want  []time.Time
+	}{
+		{"single all-day", Event{Start: date(2025, 3, 29), End: date(2025, 3, 30), AllDay: true}, []time.Time{date(2025, 3, 29)}},
+		{"multi-day end is exclusive", Event{Start: date(2025, 3, 31), End: date(2025, 4, 2), AllDay: true}, []time.Time{date(2025, 3, 31), date(2025, 4, 1)}},
+		{"timed event ending midday covers last day", Event{
+			Start: time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC),
+			End:   time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC),
+		}, []time.Time{date(2025, 1, 1), date(2025, 1, 2)}},
+		{"timed event ending at midnight", Event{
+			Start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			End:   date(2025, 1, 2),
+		}, []time.Time{date(2025, 1, 1)}},
+		{"end before start gives one day", Event{Start: date(2025, 1, 5), End: date(2025, 1, 5), AllDay: true}, []time.Time{date(2025, 1, 5)}},
+	}
+
+	for _, tt := range tests {
+		got := tt.event.Days()
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: Days() = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].Equal(tt.want[i]) {
+				t.Errorf("%s: Days() = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
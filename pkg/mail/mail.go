@@ -0,0 +1,170 @@
+// Package mail mengirim email teks dan HTML. SMTPMailer cocok untuk server SMTP lokal
+// pengganti (mis. MailHog di localhost:1025) maupun relay sungguhan; FileDropMailer
+// menulis email ke folder untuk development dan pengujian.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Message berisi versi teks dan (opsional) HTML; jika HTML diisi email dikirim sebagai
+// multipart/alternative.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPMailer mengirim lewat SMTP. Auth boleh nil untuk server lokal tanpa autentikasi.
+type SMTPMailer struct {
+	Addr string // host:port
+	From string
+	Auth smtp.Auth
+}
+
+func NewSMTPMailer(addr, from string, auth smtp.Auth) *SMTPMailer {
+	return &SMTPMailer{Addr: addr, From: from, Auth: auth}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	raw, err := compose(m.From, msg)
+	if err != nil {
+		return err
+	}
+
+	// smtp.SendMail tidak menerima context; batas waktu dijaga lewat deadline koneksi
+	dialer := net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", m.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(m.Addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if m.Auth != nil {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(m.Auth); err != nil {
+				return err
+			}
+		}
+	}
+	if err := c.Mail(m.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(raw); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// FileDropMailer menulis setiap email sebagai file .eml di Dir alih-alih mengirimnya.
+type FileDropMailer struct {
+	Dir  string
+	From string
+}
+
+func NewFileDropMailer(dir, from string) *FileDropMailer {
+	return &FileDropMailer{Dir: dir, From: from}
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func (m *FileDropMailer) Send(ctx context.Context, msg Message) error {
+	raw, err := compose(m.From, msg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), unsafeFileChars.ReplaceAllString(msg.To, "_"))
+	return os.WriteFile(filepath.Join(m.Dir, name), raw, 0o644)
+}
+
+// compose menyusun email RFC 5322 lengkap dengan header dan body quoted-printable.
+func compose(from string, msg Message) ([]byte, error) {
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return nil, fmt.Errorf("mail: invalid header value")
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+
+	if msg.HTML == "" {
+		writePart(&b, "text/plain", msg.Text)
+		return b.Bytes(), nil
+	}
+
+	boundary := randomBoundary()
+	fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&b, "--%s\r\n", boundary)
+	writePart(&b, "text/plain", msg.Text)
+	fmt.Fprintf(&b, "\r\n--%s\r\n", boundary)
+	writePart(&b, "text/html", msg.HTML)
+	fmt.Fprintf(&b, "\r\n--%s--\r\n", boundary)
+	return b.Bytes(), nil
+}
+
+// writePart menulis header Content-Type dan body quoted-printable (aman untuk baris panjang).
+func writePart(b *bytes.Buffer, contentType, body string) {
+	fmt.Fprintf(b, "Content-Type: %s; charset=utf-8\r\n", contentType)
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	w := quotedprintable.NewWriter(b)
+	w.Write([]byte(normalizeNewlines(body)))
+	w.Close()
+}
+
+func randomBoundary() string {
+	var buf [16]byte
+	rand.Read(buf[:])
+	return "devtracker-" + hex.EncodeToString(buf[:])
+}
+
+// normalizeNewlines mengubah semua akhir baris menjadi CRLF sesuai RFC 5322.
+func normalizeNewlines(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
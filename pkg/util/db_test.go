@@ -0,0 +1,32 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	unique := &pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "uniq_user_project_name_active"}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"pgx v5 unique violation", unique, true},
+		{"wrapped unique violation", fmt.Errorf("create project: %w", unique), true},
+		{"foreign key violation", &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation}, false},
+		{"plain error", errors.New("duplicate key value violates unique constraint"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsUniqueViolation(tt.err); got != tt.want {
+			t.Errorf("%s: IsUniqueViolation() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+-- Foreign key untuk seluruh relasi antar tabel.
+-- Data yatim (parent sudah tidak ada) dibersihkan dulu supaya constraint bisa dibuat.
+--
+-- ON DELETE:
+--   CASCADE  : data milik parent (milestone milik project, log milik user, dst.)
+--   SET NULL : referensi opsional yang tetap bermakna tanpa parent (log -> milestone/task)
+-- scope_changes.milestone_id sengaja tanpa FK: snapshot tetap disimpan setelah milestone dihapus.
+
+DELETE FROM projects WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM milestones WHERE project_id NOT IN (SELECT id FROM projects);
+DELETE FROM tasks WHERE milestone_id NOT IN (SELECT id FROM milestones);
+UPDATE tasks SET assignee_id = NULL WHERE assignee_id IS NOT NULL AND assignee_id NOT IN (SELECT id FROM users);
+
+DELETE FROM logs WHERE project_id NOT IN (SELECT id FROM projects) OR user_id NOT IN (SELECT id FROM users);
+UPDATE logs SET milestone_id = NULL WHERE milestone_id IS NOT NULL AND milestone_id NOT IN (SELECT id FROM milestones);
+UPDATE logs SET task_id = NULL WHERE task_id IS NOT NULL AND task_id NOT IN (SELECT id FROM tasks);
+-- log yang menunjuk milestone dari project lain dilepas dari milestone-nya
+UPDATE logs SET milestone_id = NULL, task_id = NULL
+WHERE milestone_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM milestones m WHERE m.id = logs.milestone_id AND m.project_id = logs.project_id);
+
+DELETE FROM ai_insights WHERE project_id NOT IN (SELECT id FROM projects);
+DELETE FROM reports WHERE project_id NOT IN (SELECT id FROM projects);
+
+DELETE FROM milestone_status_histories WHERE milestone_id NOT IN (SELECT id FROM milestones);
+UPDATE milestone_status_histories SET changed_by = NULL WHERE changed_by IS NOT NULL AND changed_by NOT IN (SELECT id FROM users);
+DELETE FROM milestone_dependencies
+WHERE project_id NOT IN (SELECT id FROM projects)
+   OR milestone_id NOT IN (SELECT id FROM milestones)
+   OR depends_on_id NOT IN (SELECT id FROM milestones);
+DELETE FROM board_column_limits WHERE project_id NOT IN (SELECT id FROM projects);
+
+DELETE FROM sprints WHERE project_id NOT IN (SELECT id FROM projects);
+DELETE FROM sprint_items
+WHERE sprint_id NOT IN (SELECT id FROM sprints)
+   OR (milestone_id IS NOT NULL AND milestone_id NOT IN (SELECT id FROM milestones))
+   OR (task_id IS NOT NULL AND task_id NOT IN (SELECT id FROM tasks));
+UPDATE sprint_items SET carried_from_id = NULL WHERE carried_from_id IS NOT NULL AND carried_from_id NOT IN (SELECT id FROM sprint_items);
+
+DELETE FROM scope_changes WHERE project_id NOT IN (SELECT id FROM projects);
+DELETE FROM project_revisions WHERE project_id NOT IN (SELECT id FROM projects);
+
+DELETE FROM timers WHERE user_id NOT IN (SELECT id FROM users) OR project_id NOT IN (SELECT id FROM projects);
+UPDATE timers SET milestone_id = NULL WHERE milestone_id IS NOT NULL AND milestone_id NOT IN (SELECT id FROM milestones);
+UPDATE timers SET task_id = NULL WHERE task_id IS NOT NULL AND task_id NOT IN (SELECT id FROM tasks);
+
+DELETE FROM goals WHERE user_id NOT IN (SELECT id FROM users) OR (project_id IS NOT NULL AND project_id NOT IN (SELECT id FROM projects));
+DELETE FROM work_calendars WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM holidays WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM time_offs WHERE user_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE projects ADD CONSTRAINT fk_projects_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE milestones ADD CONSTRAINT fk_milestones_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE tasks ADD CONSTRAINT fk_tasks_milestone FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE CASCADE;
+ALTER TABLE tasks ADD CONSTRAINT fk_tasks_assignee FOREIGN KEY (assignee_id) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE logs ADD CONSTRAINT fk_logs_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE logs ADD CONSTRAINT fk_logs_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE logs ADD CONSTRAINT fk_logs_milestone FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE SET NULL;
+ALTER TABLE logs ADD CONSTRAINT fk_logs_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL;
+
+ALTER TABLE ai_insights ADD CONSTRAINT fk_ai_insights_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE reports ADD CONSTRAINT fk_reports_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+
+ALTER TABLE milestone_status_histories ADD CONSTRAINT fk_milestone_status_histories_milestone FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE CASCADE;
+ALTER TABLE milestone_status_histories ADD CONSTRAINT fk_milestone_status_histories_changed_by FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE milestone_dependencies ADD CONSTRAINT fk_milestone_dependencies_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE milestone_dependencies ADD CONSTRAINT fk_milestone_dependencies_milestone FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE CASCADE;
+ALTER TABLE milestone_dependencies ADD CONSTRAINT fk_milestone_dependencies_depends_on FOREIGN KEY (depends_on_id) REFERENCES milestones(id) ON DELETE CASCADE;
+ALTER TABLE board_column_limits ADD CONSTRAINT fk_board_column_limits_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+
+ALTER TABLE sprints ADD CONSTRAINT fk_sprints_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE sprint_items ADD CONSTRAINT fk_sprint_items_sprint FOREIGN KEY (sprint_id) REFERENCES sprints(id) ON DELETE CASCADE;
+ALTER TABLE sprint_items ADD CONSTRAINT fk_sprint_items_milestone FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE CASCADE;
+ALTER TABLE sprint_items ADD CONSTRAINT fk_sprint_items_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE;
+ALTER TABLE sprint_items ADD CONSTRAINT fk_sprint_items_carried_from FOREIGN KEY (carried_from_id) REFERENCES sprint_items(id) ON DELETE SET NULL;
+
+ALTER TABLE scope_changes ADD CONSTRAINT fk_scope_changes_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE project_revisions ADD CONSTRAINT fk_project_revisions_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+
+ALTER TABLE timers ADD CONSTRAINT fk_timers_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE timers ADD CONSTRAINT fk_timers_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE timers ADD CONSTRAINT fk_timers_milestone FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE SET NULL;
+ALTER TABLE timers ADD CONSTRAINT fk_timers_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL;
+
+ALTER TABLE goals ADD CONSTRAINT fk_goals_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE work_calendars ADD CONSTRAINT fk_work_calendars_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE holidays ADD CONSTRAINT fk_holidays_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE time_offs ADD CONSTRAINT fk_time_offs_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
@@ -0,0 +1,12 @@
+-- Index full-text search untuk GET /api/v1/search.
+-- Ekspresi harus identik dengan yang dipakai postgres.SearchPG supaya index terpakai.
+-- Config 'simple' dipakai karena catatan bercampur bahasa Indonesia dan Inggris (tanpa stemming).
+
+CREATE INDEX IF NOT EXISTS idx_logs_search
+    ON logs USING GIN (to_tsvector('simple', coalesce(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_milestones_search
+    ON milestones USING GIN (to_tsvector('simple', coalesce(name, '')));
+
+CREATE INDEX IF NOT EXISTS idx_projects_search
+    ON projects USING GIN (to_tsvector('simple', coalesce(name, '')));
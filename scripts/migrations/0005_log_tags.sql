@@ -0,0 +1,9 @@
+-- Foreign key untuk tag log (tabel dibuat oleh AutoMigrate).
+DELETE FROM tags WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM log_tags
+WHERE log_id NOT IN (SELECT id FROM logs)
+   OR tag_id NOT IN (SELECT id FROM tags);
+
+ALTER TABLE tags ADD CONSTRAINT fk_tags_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE log_tags ADD CONSTRAINT fk_log_tags_log FOREIGN KEY (log_id) REFERENCES logs(id) ON DELETE CASCADE;
+ALTER TABLE log_tags ADD CONSTRAINT fk_log_tags_tag FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE;
@@ -0,0 +1,23 @@
+-- Foreign key untuk billing (tabel dibuat oleh AutoMigrate).
+-- invoices.project_id sengaja tanpa FK: invoice adalah dokumen keuangan dan tetap
+-- disimpan (dengan snapshot nama project) setelah project di-purge dari trash.
+
+DELETE FROM clients WHERE user_id NOT IN (SELECT id FROM users);
+UPDATE projects SET client_id = NULL WHERE client_id IS NOT NULL AND client_id NOT IN (SELECT id FROM clients);
+DELETE FROM hourly_rates
+WHERE owner_id NOT IN (SELECT id FROM users)
+   OR (project_id IS NOT NULL AND project_id NOT IN (SELECT id FROM projects))
+   OR (user_id IS NOT NULL AND user_id NOT IN (SELECT id FROM users));
+DELETE FROM invoice_lines WHERE invoice_id NOT IN (SELECT id FROM invoices);
+UPDATE logs SET invoice_id = NULL WHERE invoice_id IS NOT NULL AND invoice_id NOT IN (SELECT id FROM invoices);
+
+ALTER TABLE clients ADD CONSTRAINT fk_clients_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE projects ADD CONSTRAINT fk_projects_client FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE SET NULL;
+ALTER TABLE hourly_rates ADD CONSTRAINT fk_hourly_rates_owner FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE hourly_rates ADD CONSTRAINT fk_hourly_rates_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE hourly_rates ADD CONSTRAINT fk_hourly_rates_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE invoices ADD CONSTRAINT fk_invoices_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+-- client yang sudah punya invoice tidak boleh dihapus
+ALTER TABLE invoices ADD CONSTRAINT fk_invoices_client FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE RESTRICT;
+ALTER TABLE invoice_lines ADD CONSTRAINT fk_invoice_lines_invoice FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE CASCADE;
+ALTER TABLE logs ADD CONSTRAINT fk_logs_invoice FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE SET NULL;
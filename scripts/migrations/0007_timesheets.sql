@@ -0,0 +1,12 @@
+-- Foreign key untuk timesheet (tabel dibuat oleh AutoMigrate).
+
+DELETE FROM timesheet_comments WHERE timesheet_id NOT IN (SELECT id FROM timesheets);
+DELETE FROM timesheets
+WHERE project_id NOT IN (SELECT id FROM projects)
+   OR user_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE timesheets ADD CONSTRAINT fk_timesheets_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE timesheets ADD CONSTRAINT fk_timesheets_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE timesheets ADD CONSTRAINT fk_timesheets_reviewer FOREIGN KEY (reviewed_by) REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE timesheet_comments ADD CONSTRAINT fk_timesheet_comments_timesheet FOREIGN KEY (timesheet_id) REFERENCES timesheets(id) ON DELETE CASCADE;
+ALTER TABLE timesheet_comments ADD CONSTRAINT fk_timesheet_comments_author FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE;
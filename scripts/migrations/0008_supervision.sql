@@ -0,0 +1,13 @@
+-- Foreign key untuk supervisor dan komentar review (tabel dibuat oleh AutoMigrate).
+-- comments.target_id polimorfik (milestone/insight) sehingga tidak punya FK.
+
+DELETE FROM project_supervisors WHERE project_id NOT IN (SELECT id FROM projects);
+UPDATE project_supervisors SET supervisor_id = NULL, status = 'revoked'
+WHERE supervisor_id IS NOT NULL AND supervisor_id NOT IN (SELECT id FROM users);
+DELETE FROM comments WHERE project_id NOT IN (SELECT id FROM projects) OR author_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE project_supervisors ADD CONSTRAINT fk_project_supervisors_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE project_supervisors ADD CONSTRAINT fk_project_supervisors_user FOREIGN KEY (supervisor_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE;
@@ -0,0 +1,12 @@
+-- Foreign key untuk @mention komentar (tabel dibuat oleh AutoMigrate).
+-- Target komentar kini juga project dan log; target_id tetap polimorfik tanpa FK.
+
+DELETE FROM comment_mentions
+WHERE comment_id NOT IN (SELECT id FROM comments) OR user_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE comment_mentions ADD CONSTRAINT fk_comment_mentions_comment FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE;
+ALTER TABLE comment_mentions ADD CONSTRAINT fk_comment_mentions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- feed aktivitas mengurutkan riwayat status per milestone berdasarkan waktu
+CREATE INDEX IF NOT EXISTS idx_milestone_status_histories_milestone_changed
+    ON milestone_status_histories (milestone_id, changed_at);
@@ -0,0 +1,12 @@
+-- Foreign key dan index untuk notification center (tabel dibuat oleh AutoMigrate).
+
+DELETE FROM notifications WHERE user_id NOT IN (SELECT id FROM users);
+UPDATE notifications SET project_id = NULL WHERE project_id IS NOT NULL AND project_id NOT IN (SELECT id FROM projects);
+DELETE FROM notification_preferences WHERE user_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE notifications ADD CONSTRAINT fk_notifications_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE notifications ADD CONSTRAINT fk_notifications_project FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE notification_preferences ADD CONSTRAINT fk_notification_preferences_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- badge unread dan daftar GET /notifications?unread=true
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread ON notifications (user_id, created_at) WHERE read_at IS NULL;
@@ -0,0 +1,21 @@
+-- scope_changes sekarang menyimpan Weight dan EstimatedHours mentah (kolom estimated_hours
+-- dibuat oleh AutoMigrate). Nilai weight lama adalah hasil fallback Weight > EstimatedHours > 1
+-- yang satuannya tercampur, jadi tidak bisa dipakai langsung.
+--
+-- Nilai lama disalin dulu ke scope_changes_weight_backup supaya bisa dikembalikan manual:
+--   UPDATE scope_changes s SET weight = b.weight FROM scope_changes_weight_backup b WHERE b.id = s.id;
+-- Lalu nilai mentah diambil dari milestone-nya (milestone di-soft delete, jadi barisnya masih ada).
+-- Hanya scope change yang milestone-nya sudah tidak ada yang dikosongkan; burndown memakai
+-- rata-rata basis project untuk baris itu.
+
+CREATE TABLE IF NOT EXISTS scope_changes_weight_backup AS
+    SELECT id, weight FROM scope_changes WHERE weight IS NOT NULL;
+
+UPDATE scope_changes s
+SET weight = m.weight, estimated_hours = m.estimated_hours
+FROM milestones m
+WHERE m.id = s.milestone_id;
+
+UPDATE scope_changes s
+SET weight = NULL
+WHERE NOT EXISTS (SELECT 1 FROM milestones m WHERE m.id = s.milestone_id);
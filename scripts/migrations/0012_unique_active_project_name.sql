@@ -0,0 +1,6 @@
+-- Nama project cukup unik di antara project yang belum masuk trash, supaya nama
+-- project di trash bisa dipakai lagi; restore ditolak bila namanya sudah dipakai project aktif.
+
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS uniq_user_project_name;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_user_project_name_active ON projects (user_id, name) WHERE deleted_at IS NULL;
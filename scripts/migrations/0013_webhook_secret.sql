@@ -0,0 +1,7 @@
+-- Secret webhook per user (kolom dibuat oleh AutoMigrate). Webhook yang sudah aktif mendapat
+-- secret acak dari dua gen_random_uuid(); user melihatnya lewat
+-- POST /notifications/preferences/webhook-secret, yang sekaligus merotasinya.
+
+UPDATE notification_preferences
+SET webhook_secret = replace(gen_random_uuid()::text || gen_random_uuid()::text, '-', '')
+WHERE webhook_url <> '' AND (webhook_secret IS NULL OR webhook_secret = '');